@@ -11,6 +11,7 @@ import (
 
 	"backoffice/internal/constants"
 	"backoffice/internal/container"
+	"backoffice/internal/services"
 	"backoffice/internal/transport/http"
 	"backoffice/internal/transport/queue"
 	"backoffice/internal/transport/rpc"
@@ -41,12 +42,29 @@ func main() {
 	server := app.Get(constants.HTTPServerName).(*http.Server)
 	binding.Validator = app.Get(constants.ValidatorName).(*validator.Validator)
 
+	permissionRegistryService := app.Get(constants.PermissionRegistryServiceName).(*services.PermissionRegistryService)
+	if err := permissionRegistryService.Verify(ctx); err != nil {
+		zap.S().Error(err)
+	}
+
 	go server.Run()
 
 	rpcHandler := app.Get(constants.RPCName).(*rpc.Handler)
 
 	go rpc.StartUnsecureRPCServer(rpcHandler, tr)
 
+	reportScheduleService := app.Get(constants.ReportScheduleServiceName).(*services.ReportScheduleService)
+
+	go reportScheduleService.Run(ctx)
+
+	roleGrantService := app.Get(constants.RoleGrantServiceName).(*services.RoleGrantService)
+
+	go roleGrantService.Run(ctx)
+
+	fileService := app.Get(constants.FileDownloadingServiceName).(*services.FileDownloadingService)
+
+	go fileService.RunCleanup(ctx)
+
 	zap.S().Infof("Up and running (%s)", time.Since(now))
 	zap.S().Infof("Got %s signal. Shutting down...", <-utils.WaitTermSignal())
 
@@ -13,10 +13,12 @@ import (
 	"backoffice/pkg/file"
 	"backoffice/pkg/history"
 	"backoffice/pkg/mailgun"
+	"backoffice/pkg/oidc"
 	"backoffice/pkg/overlord"
 	"backoffice/pkg/pgsql"
 	"backoffice/pkg/redis"
 	"backoffice/pkg/totp"
+	"backoffice/pkg/webauthn"
 	"bitbucket.org/play-workspace/gocommon/tracer"
 	"github.com/spf13/viper"
 )
@@ -49,6 +51,17 @@ type Config struct {
 	OverlordConfig   *overlord.Config
 	ExchangeConfig   *exchange.Config
 	ClientInfoConfig *services.ClientInfoConfig
+	OIDCConfig       *oidc.Config
+	SSOConfig        *services.SSOConfig
+	LockoutConfig    *services.LockoutConfig
+	WebAuthnConfig   *webauthn.Config
+	InviteConfig     *services.InviteConfig
+	PasswordConfig   *services.PasswordConfig
+
+	ImpersonationConfig *services.ImpersonationConfig
+	PermissionsConfig   *services.PermissionsConfig
+	RoleGrantConfig     *services.RoleGrantConfig
+	PublicReportConfig  *services.PublicReportConfig
 }
 
 func New() (*Config, error) {
@@ -77,6 +90,16 @@ func New() (*Config, error) {
 		overlordConfig := viper.Sub("overlord")
 		exchangeConfig := viper.Sub("exchange")
 		clientInfoConfig := viper.Sub("client")
+		oidcConfig := viper.Sub("oidc")
+		ssoConfig := viper.Sub("sso")
+		lockoutConfig := viper.Sub("lockout")
+		webAuthnConfig := viper.Sub("webauthn")
+		inviteConfig := viper.Sub("invite")
+		passwordConfig := viper.Sub("password")
+		impersonationConfig := viper.Sub("impersonation")
+		permissionsConfig := viper.Sub("permissions")
+		roleGrantConfig := viper.Sub("roleGrants")
+		publicReportConfig := viper.Sub("publicReports")
 
 		config.Env = viper.Get("env").(string)
 		config.LogLevel = viper.Get("logLevel").(string)
@@ -148,6 +171,86 @@ func New() (*Config, error) {
 			return
 		}
 
+		if oidcConfig != nil {
+			if err = parseSubConfig(oidcConfig, &config.OIDCConfig); err != nil {
+				return
+			}
+		} else {
+			config.OIDCConfig = &oidc.Config{}
+		}
+
+		if ssoConfig != nil {
+			if err = parseSubConfig(ssoConfig, &config.SSOConfig); err != nil {
+				return
+			}
+		} else {
+			config.SSOConfig = &services.SSOConfig{}
+		}
+
+		if lockoutConfig != nil {
+			if err = parseSubConfig(lockoutConfig, &config.LockoutConfig); err != nil {
+				return
+			}
+		} else {
+			config.LockoutConfig = &services.LockoutConfig{}
+		}
+
+		if webAuthnConfig != nil {
+			if err = parseSubConfig(webAuthnConfig, &config.WebAuthnConfig); err != nil {
+				return
+			}
+		} else {
+			config.WebAuthnConfig = &webauthn.Config{}
+		}
+
+		if inviteConfig != nil {
+			if err = parseSubConfig(inviteConfig, &config.InviteConfig); err != nil {
+				return
+			}
+		} else {
+			config.InviteConfig = &services.InviteConfig{}
+		}
+
+		if passwordConfig != nil {
+			if err = parseSubConfig(passwordConfig, &config.PasswordConfig); err != nil {
+				return
+			}
+		} else {
+			config.PasswordConfig = &services.PasswordConfig{}
+		}
+
+		if impersonationConfig != nil {
+			if err = parseSubConfig(impersonationConfig, &config.ImpersonationConfig); err != nil {
+				return
+			}
+		} else {
+			config.ImpersonationConfig = &services.ImpersonationConfig{}
+		}
+
+		if permissionsConfig != nil {
+			if err = parseSubConfig(permissionsConfig, &config.PermissionsConfig); err != nil {
+				return
+			}
+		} else {
+			config.PermissionsConfig = &services.PermissionsConfig{}
+		}
+
+		if roleGrantConfig != nil {
+			if err = parseSubConfig(roleGrantConfig, &config.RoleGrantConfig); err != nil {
+				return
+			}
+		} else {
+			config.RoleGrantConfig = &services.RoleGrantConfig{}
+		}
+
+		if publicReportConfig != nil {
+			if err = parseSubConfig(publicReportConfig, &config.PublicReportConfig); err != nil {
+				return
+			}
+		} else {
+			config.PublicReportConfig = &services.PublicReportConfig{}
+		}
+
 	})
 
 	return config, err
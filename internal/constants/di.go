@@ -16,35 +16,71 @@ const (
 	HistoryName         = "History"
 	OverlordClientName  = "OverlordClient"
 
-	AuthenticationServiceName  = "AuthenticateService"
-	AuthorizationServiceName   = "AuthorizationService"
-	AccountServiceName         = "AccountService"
-	SessionServiceName         = "SessionService"
-	OrganizationServiceName    = "OrganizationService"
-	GameServiceName            = "GameService"
-	SpinServiceName            = "SpinService"
-	CurrencyServiceName        = "CurrencyService"
-	MailingServiceName         = "MailingService"
-	WagerSetServiceName        = "WagerSetService"
-	CurrencySetServiceName     = "CurrencySetService"
-	ConfigSenderServiceName    = "ConfigSenderService"
-	DebugServiceName           = "DebugService"
-	FileDownloadingServiceName = "FileDownloadingService"
-	LobbyServiceName           = "LobbyService"
-	ClientInfoServiceName      = "ClientInfoService"
+	AuthenticationServiceName     = "AuthenticateService"
+	AuthorizationServiceName      = "AuthorizationService"
+	AccountServiceName            = "AccountService"
+	SessionServiceName            = "SessionService"
+	OrganizationServiceName       = "OrganizationService"
+	GameServiceName               = "GameService"
+	SpinServiceName               = "SpinService"
+	CurrencyServiceName           = "CurrencyService"
+	MailingServiceName            = "MailingService"
+	WagerSetServiceName           = "WagerSetService"
+	CurrencySetServiceName        = "CurrencySetService"
+	ConfigSenderServiceName       = "ConfigSenderService"
+	DebugServiceName              = "DebugService"
+	FileDownloadingServiceName    = "FileDownloadingService"
+	LobbyServiceName              = "LobbyService"
+	ClientInfoServiceName         = "ClientInfoService"
+	CampaignServiceName           = "CampaignService"
+	AuditServiceName              = "AuditService"
+	ReportScheduleServiceName     = "ReportScheduleService"
+	APIKeyServiceName             = "APIKeyService"
+	SSOServiceName                = "SSOService"
+	LockoutServiceName            = "LockoutService"
+	TwoFactorServiceName          = "TwoFactorService"
+	WebAuthnServiceName           = "WebAuthnService"
+	InviteServiceName             = "InviteService"
+	PasswordPolicyServiceName     = "PasswordPolicyService"
+	ImpersonationServiceName      = "ImpersonationService"
+	PermissionRegistryServiceName = "PermissionRegistryService"
+	RoleGrantServiceName          = "RoleGrantService"
+	PublicReportServiceName       = "PublicReportService"
+	GameHistoryServiceName        = "GameHistoryService"
+	GameCatalogServiceName        = "GameCatalogService"
+	WagerConstraintServiceName    = "WagerConstraintService"
+	WagerSetGeneratorServiceName  = "WagerSetGeneratorService"
 
-	AccountRepositoryName      = "AccountRepository"
-	SessionRepositoryName      = "SessionRepository"
-	FileRepositoryName         = "FileRepository"
-	RefreshTokenRepositoryName = "RefreshTokenRepository"
-	RoleRepositoryName         = "RoleRepository"
-	PermissionRepositoryName   = "PermissionRepository"
-	GameRepositoryName         = "GameRepository"
-	OrganizationRepositoryName = "OrganizationRepository"
-	CurrencyRepositoryName     = "CurrencyRepository"
-	WagerSetRepositoryName     = "WagerSetRepository"
-	CurrencySetRepositoryName  = "CurrencySetRepository"
-	DebugRepositoryName        = "DebugRepository"
+	AccountRepositoryName            = "AccountRepository"
+	SessionRepositoryName            = "SessionRepository"
+	FileRepositoryName               = "FileRepository"
+	FileEventRepositoryName          = "FileEventRepository"
+	RefreshTokenRepositoryName       = "RefreshTokenRepository"
+	RoleRepositoryName               = "RoleRepository"
+	PermissionRepositoryName         = "PermissionRepository"
+	GameRepositoryName               = "GameRepository"
+	OrganizationRepositoryName       = "OrganizationRepository"
+	CurrencyRepositoryName           = "CurrencyRepository"
+	WagerSetRepositoryName           = "WagerSetRepository"
+	CurrencySetRepositoryName        = "CurrencySetRepository"
+	DebugRepositoryName              = "DebugRepository"
+	CampaignRepositoryName           = "CampaignRepository"
+	CampaignUserRepositoryName       = "CampaignUserRepository"
+	AuditRepositoryName              = "AuditRepository"
+	ReportScheduleRepositoryName     = "ReportScheduleRepository"
+	APIKeyRepositoryName             = "APIKeyRepository"
+	SSOStateRepositoryName           = "SSOStateRepository"
+	AccountIdentityRepositoryName    = "AccountIdentityRepository"
+	LoginAttemptRepositoryName       = "LoginAttemptRepository"
+	SecurityEventRepositoryName      = "SecurityEventRepository"
+	RecoveryCodeRepositoryName       = "RecoveryCodeRepository"
+	WebAuthnCredentialRepositoryName = "WebAuthnCredentialRepository"
+	WebAuthnSessionRepositoryName    = "WebAuthnSessionRepository"
+	PasswordPolicyRepositoryName     = "PasswordPolicyRepository"
+	PasswordHistoryRepositoryName    = "PasswordHistoryRepository"
+	RoleGrantRepositoryName          = "RoleGrantRepository"
+	GameVersionRepositoryName        = "GameVersionRepository"
+	WagerConstraintRepositoryName    = "WagerConstraintRepository"
 
 	MetaHTTPHandlerName         = "MetaHTTPHandler"
 	AuthHTTPHandlerName         = "AuthHTTPHandler"
@@ -61,10 +97,19 @@ const (
 	CurrencySetHTTPHandlerName  = "CurrencySetHTTPHandler"
 	FileSetHTTPHandlerName      = "FileSetHTTPHandler"
 
-	CurrencyQueueHandlerName    = "CurrencyQueueHandler"
-	PublicReportHTTPHandlerName = "PublicReportHTTPHandler"
-	LobbyHTTPHandlerName        = "LobbyHTTPHandler"
-	ClientInfoHTTPHandlerName   = "ClientInfoHTTPHandler"
+	CurrencyQueueHandlerName      = "CurrencyQueueHandler"
+	PublicReportHTTPHandlerName   = "PublicReportHTTPHandler"
+	LobbyHTTPHandlerName          = "LobbyHTTPHandler"
+	ClientInfoHTTPHandlerName     = "ClientInfoHTTPHandler"
+	CampaignHTTPHandlerName       = "CampaignHTTPHandler"
+	AuditHTTPHandlerName          = "AuditHTTPHandler"
+	ReportScheduleHTTPHandlerName = "ReportScheduleHTTPHandler"
+	APIKeyHTTPHandlerName         = "APIKeyHTTPHandler"
+	SSOHTTPHandlerName            = "SSOHTTPHandler"
+	SecurityEventHTTPHandlerName  = "SecurityEventHTTPHandler"
+	InviteHTTPHandlerName         = "InviteHTTPHandler"
+	PasswordPolicyHTTPHandlerName = "PasswordPolicyHTTPHandler"
 
-	ExchangeName = "Exchange"
+	ExchangeName    = "Exchange"
+	FileStorageName = "FileStorage"
 )
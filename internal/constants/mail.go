@@ -3,25 +3,60 @@ package constants
 import "text/template"
 
 const (
-	MailNotifyUserSubject     = "Backoffice account"
-	MailNotifyUserTemplateRaw = `Welcome to: {{.FrontURL}}
+	MailNotifyUserSubject      = "Backoffice account"
+	MailScheduledReportSubject = "Backoffice report: "
+	MailAccountLockedSubject   = "Backoffice account locked"
+	MailTwoFactorResetSubject  = "Backoffice two factor reset"
+	MailInviteSubject          = "Backoffice invitation"
+	MailNotifyUserTemplateRaw  = `Welcome to: {{.FrontURL}}
 Your login: {{.Login}}
-Your password: {{.Password}}`
+Ask your administrator for the password or reset it on the sign in page.`
 	MailResetUserPasswordRaw = `Password reset page: {{.ResetPasswordURL}}
 Your token: {{.Token}}`
+	MailScheduledReportRaw = `Scheduled report "{{.Name}}" is attached.
+Period: {{.From}} - {{.To}}
+Manage schedules: {{.FrontURL}}`
+	MailAccountLockedRaw = `Your account {{.Login}} was locked after {{.Failures}} failed sign in attempts, the last one from {{.IP}}.
+Ask an administrator of {{.FrontURL}} to unlock it.`
+	MailTwoFactorResetRaw = `Two factor authentication of your account {{.Login}} was reset by an administrator.
+Sign in to {{.FrontURL}} and set it up again. If you did not ask for the reset, contact your administrator.`
+	MailInviteRaw = `You are invited to {{.FrontURL}}, your login is {{.Login}}.
+Set your password: {{.URL}}
+The link works once and expires at {{.ExpiresAt}}.`
 )
 
 var MailNotifyUserTemplate *template.Template
 var MailResetPasswordTemplate *template.Template
+var MailScheduledReportTemplate *template.Template
+var MailAccountLockedTemplate *template.Template
+var MailTwoFactorResetTemplate *template.Template
+var MailInviteTemplate *template.Template
 
 type MailNotifyUserContent struct {
-	FrontURL, Login, Password string
+	FrontURL, Login string
 }
 
 type MailResetPasswordContent struct {
 	ResetPasswordURL, Token string
 }
 
+type MailScheduledReportContent struct {
+	FrontURL, Name, From, To string
+}
+
+type MailAccountLockedContent struct {
+	FrontURL, Login, IP string
+	Failures            int64
+}
+
+type MailTwoFactorResetContent struct {
+	FrontURL, Login string
+}
+
+type MailInviteContent struct {
+	FrontURL, Login, URL, ExpiresAt string
+}
+
 func init() {
 	var err error
 	if MailNotifyUserTemplate, err = template.New("simulation-txt").Parse(MailNotifyUserTemplateRaw); err != nil {
@@ -30,4 +65,16 @@ func init() {
 	if MailResetPasswordTemplate, err = template.New("reset-txt").Parse(MailResetUserPasswordRaw); err != nil {
 		panic(err)
 	}
+	if MailScheduledReportTemplate, err = template.New("scheduled-report-txt").Parse(MailScheduledReportRaw); err != nil {
+		panic(err)
+	}
+	if MailAccountLockedTemplate, err = template.New("account-locked-txt").Parse(MailAccountLockedRaw); err != nil {
+		panic(err)
+	}
+	if MailTwoFactorResetTemplate, err = template.New("two-factor-reset-txt").Parse(MailTwoFactorResetRaw); err != nil {
+		panic(err)
+	}
+	if MailInviteTemplate, err = template.New("invite-txt").Parse(MailInviteRaw); err != nil {
+		panic(err)
+	}
 }
@@ -12,6 +12,7 @@ import (
 	"backoffice/internal/transport/rpc"
 	"backoffice/pkg/auth/jwt"
 	"backoffice/pkg/exchange"
+	"backoffice/pkg/file"
 	"backoffice/pkg/history"
 	"backoffice/pkg/mailgun"
 	"backoffice/pkg/overlord"
@@ -97,9 +98,12 @@ func Build(ctx context.Context, wg *sync.WaitGroup) di.Container {
 					handlers := []http.Handler{
 						ctn.Get(constants.MetaHTTPHandlerName).(http.Handler),
 						ctn.Get(constants.PublicReportHTTPHandlerName).(http.Handler),
+						ctn.Get(constants.SSOHTTPHandlerName).(http.Handler),
 						ctn.Get(constants.AuthHTTPHandlerName).(http.Handler),
 						ctn.Get(constants.DashboardHTTPHandlerName).(http.Handler),
 						ctn.Get(constants.AccountHTTPHandlerName).(http.Handler),
+						ctn.Get(constants.InviteHTTPHandlerName).(http.Handler),
+						ctn.Get(constants.PasswordPolicyHTTPHandlerName).(http.Handler),
 						ctn.Get(constants.RoleHTTPHandlerName).(http.Handler),
 						ctn.Get(constants.PermissionHTTPHandlerName).(http.Handler),
 						ctn.Get(constants.GameHTTPHandlerName).(http.Handler),
@@ -112,9 +116,17 @@ func Build(ctx context.Context, wg *sync.WaitGroup) di.Container {
 						ctn.Get(constants.FileSetHTTPHandlerName).(http.Handler),
 						ctn.Get(constants.LobbyHTTPHandlerName).(http.Handler),
 						ctn.Get(constants.ClientInfoHTTPHandlerName).(http.Handler),
+						ctn.Get(constants.CampaignHTTPHandlerName).(http.Handler),
+						ctn.Get(constants.AuditHTTPHandlerName).(http.Handler),
+						ctn.Get(constants.ReportScheduleHTTPHandlerName).(http.Handler),
+						ctn.Get(constants.APIKeyHTTPHandlerName).(http.Handler),
+						ctn.Get(constants.SecurityEventHTTPHandlerName).(http.Handler),
 					}
 
-					return http.New(ctx, wg, cfg.HTTPConfig, handlers), nil
+					server := http.New(ctx, wg, cfg.HTTPConfig, handlers)
+					ctn.Get(constants.PermissionRegistryServiceName).(*services.PermissionRegistryService).SetRoutes(server.Routes())
+
+					return server, nil
 				},
 				Close: func(obj interface{}) error {
 					if err := obj.(*http.Server).Shutdown(); err != nil {
@@ -186,6 +198,14 @@ func Build(ctx context.Context, wg *sync.WaitGroup) di.Container {
 					return exchange.NewClient(cfg.ExchangeConfig)
 				},
 			},
+			{
+				Name: constants.FileStorageName,
+				Build: func(ctn di.Container) (interface{}, error) {
+					cfg := ctn.Get(constants.ConfigName).(*config.Config)
+
+					return file.NewLocalStorage(cfg.FileConfig)
+				},
+			},
 		}
 
 		defs = append(defs, BuildRepositories()...)
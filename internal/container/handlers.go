@@ -27,8 +27,16 @@ func BuildHandlers() []di.Def {
 				authService := ctn.Get(constants.AuthenticationServiceName).(*services.AuthenticationService)
 				accountService := ctn.Get(constants.AccountServiceName).(*services.AccountService)
 				sessionService := ctn.Get(constants.SessionServiceName).(*services.SessionService)
+				auditService := ctn.Get(constants.AuditServiceName).(*services.AuditService)
+				apiKeyService := ctn.Get(constants.APIKeyServiceName).(*services.APIKeyService)
+				lockoutService := ctn.Get(constants.LockoutServiceName).(*services.LockoutService)
+				twoFactorService := ctn.Get(constants.TwoFactorServiceName).(*services.TwoFactorService)
+				webAuthnService := ctn.Get(constants.WebAuthnServiceName).(*services.WebAuthnService)
+				inviteService := ctn.Get(constants.InviteServiceName).(*services.InviteService)
+				impersonationService := ctn.Get(constants.ImpersonationServiceName).(*services.ImpersonationService)
 
-				return httpHandlers.NewAuthHandler(authz, authService, accountService, sessionService), nil
+				return httpHandlers.NewAuthHandler(authz, authService, accountService, sessionService, auditService, apiKeyService,
+					lockoutService, twoFactorService, webAuthnService, inviteService, impersonationService), nil
 			},
 		},
 		{
@@ -43,8 +51,14 @@ func BuildHandlers() []di.Def {
 				accountService := ctn.Get(constants.AccountServiceName).(*services.AccountService)
 				authorizationService := ctn.Get(constants.AuthorizationServiceName).(*services.AuthorizationService)
 				organizationService := ctn.Get(constants.OrganizationServiceName).(*services.OrganizationService)
+				authenticationService := ctn.Get(constants.AuthenticationServiceName).(*services.AuthenticationService)
+				lockoutService := ctn.Get(constants.LockoutServiceName).(*services.LockoutService)
+				twoFactorService := ctn.Get(constants.TwoFactorServiceName).(*services.TwoFactorService)
+				impersonationService := ctn.Get(constants.ImpersonationServiceName).(*services.ImpersonationService)
+				roleGrantService := ctn.Get(constants.RoleGrantServiceName).(*services.RoleGrantService)
 
-				return httpHandlers.NewAccountHandler(accountService, authorizationService, organizationService), nil
+				return httpHandlers.NewAccountHandler(accountService, authorizationService, organizationService, authenticationService,
+					lockoutService, twoFactorService, impersonationService, roleGrantService), nil
 			},
 		},
 		{
@@ -52,25 +66,31 @@ func BuildHandlers() []di.Def {
 			Build: func(ctn di.Container) (interface{}, error) {
 				authorizationService := ctn.Get(constants.AuthorizationServiceName).(*services.AuthorizationService)
 				authenticationService := ctn.Get(constants.AuthenticationServiceName).(*services.AuthenticationService)
+				roleGrantService := ctn.Get(constants.RoleGrantServiceName).(*services.RoleGrantService)
 
-				return httpHandlers.NewRoleHandler(authorizationService, authenticationService), nil
+				return httpHandlers.NewRoleHandler(authorizationService, authenticationService, roleGrantService), nil
 			},
 		},
 		{
 			Name: constants.PermissionHTTPHandlerName,
 			Build: func(ctn di.Container) (interface{}, error) {
 				authorizationService := ctn.Get(constants.AuthorizationServiceName).(*services.AuthorizationService)
+				permissionRegistryService := ctn.Get(constants.PermissionRegistryServiceName).(*services.PermissionRegistryService)
 
-				return httpHandlers.NewPermissionHandler(authorizationService), nil
+				return httpHandlers.NewPermissionHandler(authorizationService, permissionRegistryService), nil
 			},
 		},
 		{
 			Name: constants.GameHTTPHandlerName,
 			Build: func(ctn di.Container) (interface{}, error) {
 				gameService := ctn.Get(constants.GameServiceName).(*services.GameService)
+				gameHistoryService := ctn.Get(constants.GameHistoryServiceName).(*services.GameHistoryService)
+				gameCatalogService := ctn.Get(constants.GameCatalogServiceName).(*services.GameCatalogService)
+				wagerConstraintService := ctn.Get(constants.WagerConstraintServiceName).(*services.WagerConstraintService)
 				cfgSender := ctn.Get(constants.ConfigSenderServiceName).(*services.ConfigSenderService)
 
-				return httpHandlers.NewGameHTTPHandler(gameService, cfgSender), nil
+				return httpHandlers.NewGameHTTPHandler(gameService, gameHistoryService, gameCatalogService, wagerConstraintService,
+					cfgSender), nil
 			},
 		},
 		{
@@ -78,8 +98,9 @@ func BuildHandlers() []di.Def {
 			Build: func(ctn di.Container) (interface{}, error) {
 				spinService := ctn.Get(constants.SpinServiceName).(*services.SpinService)
 				fileService := ctn.Get(constants.FileDownloadingServiceName).(*services.FileDownloadingService)
+				publicReportService := ctn.Get(constants.PublicReportServiceName).(*services.PublicReportService)
 
-				return httpHandlers.NewReportHandler(spinService, fileService), nil
+				return httpHandlers.NewReportHandler(spinService, fileService, publicReportService), nil
 			},
 		},
 		{
@@ -116,8 +137,10 @@ func BuildHandlers() []di.Def {
 			Name: constants.WagerSetHTTPHandlerName,
 			Build: func(ctn di.Container) (interface{}, error) {
 				wagerSetService := ctn.Get(constants.WagerSetServiceName).(*services.WagerSetService)
+				wagerSetGeneratorService := ctn.Get(constants.WagerSetGeneratorServiceName).(*services.WagerSetGeneratorService)
+				cfgSender := ctn.Get(constants.ConfigSenderServiceName).(*services.ConfigSenderService)
 
-				return httpHandlers.NewWagerSetHandler(wagerSetService), nil
+				return httpHandlers.NewWagerSetHandler(wagerSetService, wagerSetGeneratorService, cfgSender), nil
 			},
 		},
 		{
@@ -147,9 +170,9 @@ func BuildHandlers() []di.Def {
 		{
 			Name: constants.PublicReportHTTPHandlerName,
 			Build: func(ctn di.Container) (interface{}, error) {
-				spinService := ctn.Get(constants.SpinServiceName).(*services.SpinService)
+				publicReportService := ctn.Get(constants.PublicReportServiceName).(*services.PublicReportService)
 
-				return httpHandlers.NewPublicReportHandler(spinService), nil
+				return httpHandlers.NewPublicReportHandler(publicReportService), nil
 			},
 		},
 		{
@@ -168,5 +191,69 @@ func BuildHandlers() []di.Def {
 				return httpHandlers.NewClientInfoHTTPHandler(clientInfoService), nil
 			},
 		},
+		{
+			Name: constants.CampaignHTTPHandlerName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				campaignService := ctn.Get(constants.CampaignServiceName).(*services.FreeSpinCampaignService)
+
+				return httpHandlers.NewCampaignHandler(campaignService), nil
+			},
+		},
+		{
+			Name: constants.ReportScheduleHTTPHandlerName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				reportScheduleService := ctn.Get(constants.ReportScheduleServiceName).(*services.ReportScheduleService)
+
+				return httpHandlers.NewReportScheduleHandler(reportScheduleService), nil
+			},
+		},
+		{
+			Name: constants.APIKeyHTTPHandlerName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				apiKeyService := ctn.Get(constants.APIKeyServiceName).(*services.APIKeyService)
+
+				return httpHandlers.NewAPIKeyHandler(apiKeyService), nil
+			},
+		},
+		{
+			Name: constants.SSOHTTPHandlerName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				ssoService := ctn.Get(constants.SSOServiceName).(*services.SSOService)
+
+				return httpHandlers.NewSSOHandler(ssoService), nil
+			},
+		},
+		{
+			Name: constants.SecurityEventHTTPHandlerName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				lockoutService := ctn.Get(constants.LockoutServiceName).(*services.LockoutService)
+
+				return httpHandlers.NewSecurityEventHandler(lockoutService), nil
+			},
+		},
+		{
+			Name: constants.PasswordPolicyHTTPHandlerName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				passwordPolicyService := ctn.Get(constants.PasswordPolicyServiceName).(*services.PasswordPolicyService)
+
+				return httpHandlers.NewPasswordPolicyHandler(passwordPolicyService), nil
+			},
+		},
+		{
+			Name: constants.InviteHTTPHandlerName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				inviteService := ctn.Get(constants.InviteServiceName).(*services.InviteService)
+
+				return httpHandlers.NewInviteHandler(inviteService), nil
+			},
+		},
+		{
+			Name: constants.AuditHTTPHandlerName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				auditService := ctn.Get(constants.AuditServiceName).(*services.AuditService)
+
+				return httpHandlers.NewAuditHandler(auditService), nil
+			},
+		},
 	}
 }
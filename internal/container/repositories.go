@@ -78,6 +78,14 @@ func BuildRepositories() []di.Def {
 				return redis.NewFileRepository(conn), nil
 			},
 		},
+		{
+			Name: constants.FileEventRepositoryName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				conn := ctn.Get(constants.RedisName).(*r.Client)
+
+				return redis.NewFileEventRepository(conn), nil
+			},
+		},
 		{
 			Name: constants.CurrencyRepositoryName,
 			Build: func(ctn di.Container) (interface{}, error) {
@@ -102,6 +110,142 @@ func BuildRepositories() []di.Def {
 				return pgsql.NewBaseRepository[entities.CurrencySet](conn), nil
 			},
 		},
+		{
+			Name: constants.CampaignRepositoryName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				conn := ctn.Get(constants.PgSQLConnectionName).(*gorm.DB)
+
+				return pgsql.NewBaseRepository[entities.FreeSpinCampaign](conn), nil
+			},
+		},
+		{
+			Name: constants.CampaignUserRepositoryName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				conn := ctn.Get(constants.PgSQLConnectionName).(*gorm.DB)
+
+				return pgsql.NewBaseRepository[entities.FreeSpinUser](conn), nil
+			},
+		},
+		{
+			Name: constants.AuditRepositoryName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				conn := ctn.Get(constants.PgSQLConnectionName).(*gorm.DB)
+
+				return pgsql.NewAuditRepository(conn), nil
+			},
+		},
+		{
+			Name: constants.WagerConstraintRepositoryName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				conn := ctn.Get(constants.PgSQLConnectionName).(*gorm.DB)
+
+				return pgsql.NewGameWagerConstraintRepository(conn), nil
+			},
+		},
+		{
+			Name: constants.GameVersionRepositoryName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				conn := ctn.Get(constants.PgSQLConnectionName).(*gorm.DB)
+
+				return pgsql.NewGameVersionRepository(conn), nil
+			},
+		},
+		{
+			Name: constants.RoleGrantRepositoryName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				conn := ctn.Get(constants.PgSQLConnectionName).(*gorm.DB)
+
+				return pgsql.NewRoleGrantRepository(conn), nil
+			},
+		},
+		{
+			Name: constants.ReportScheduleRepositoryName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				conn := ctn.Get(constants.PgSQLConnectionName).(*gorm.DB)
+
+				return pgsql.NewReportScheduleRepository(conn), nil
+			},
+		},
+		{
+			Name: constants.APIKeyRepositoryName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				conn := ctn.Get(constants.PgSQLConnectionName).(*gorm.DB)
+
+				return pgsql.NewAPIKeyRepository(conn), nil
+			},
+		},
+		{
+			Name: constants.AccountIdentityRepositoryName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				conn := ctn.Get(constants.PgSQLConnectionName).(*gorm.DB)
+
+				return pgsql.NewBaseRepository[entities.AccountIdentity](conn), nil
+			},
+		},
+		{
+			Name: constants.SSOStateRepositoryName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				conn := ctn.Get(constants.RedisName).(*r.Client)
+
+				return redis.NewSSOStateRepository(conn), nil
+			},
+		},
+		{
+			Name: constants.LoginAttemptRepositoryName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				conn := ctn.Get(constants.RedisName).(*r.Client)
+
+				return redis.NewLoginAttemptRepository(conn), nil
+			},
+		},
+		{
+			Name: constants.SecurityEventRepositoryName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				conn := ctn.Get(constants.PgSQLConnectionName).(*gorm.DB)
+
+				return pgsql.NewSecurityEventRepository(conn), nil
+			},
+		},
+		{
+			Name: constants.PasswordPolicyRepositoryName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				conn := ctn.Get(constants.PgSQLConnectionName).(*gorm.DB)
+
+				return pgsql.NewBaseRepository[entities.PasswordPolicy](conn), nil
+			},
+		},
+		{
+			Name: constants.PasswordHistoryRepositoryName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				conn := ctn.Get(constants.PgSQLConnectionName).(*gorm.DB)
+
+				return pgsql.NewPasswordHistoryRepository(conn), nil
+			},
+		},
+		{
+			Name: constants.RecoveryCodeRepositoryName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				conn := ctn.Get(constants.PgSQLConnectionName).(*gorm.DB)
+
+				return pgsql.NewRecoveryCodeRepository(conn), nil
+			},
+		},
+		{
+			Name: constants.WebAuthnCredentialRepositoryName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				conn := ctn.Get(constants.PgSQLConnectionName).(*gorm.DB)
+
+				return pgsql.NewWebAuthnCredentialRepository(conn), nil
+			},
+		},
+		{
+			Name: constants.WebAuthnSessionRepositoryName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				conn := ctn.Get(constants.RedisName).(*r.Client)
+
+				return redis.NewWebAuthnSessionRepository(conn), nil
+			},
+		},
 		{
 			Name: constants.DebugRepositoryName,
 			Build: func(ctn di.Container) (interface{}, error) {
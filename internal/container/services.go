@@ -9,9 +9,14 @@ import (
 	"backoffice/internal/transport/queue"
 	"backoffice/pkg/auth"
 	"backoffice/pkg/exchange"
+	"backoffice/pkg/file"
 	"backoffice/pkg/history"
 	"backoffice/pkg/mailgun"
+	"backoffice/pkg/oidc"
 	"backoffice/pkg/overlord"
+	"backoffice/pkg/password"
+	"backoffice/pkg/validator"
+	"backoffice/pkg/webauthn"
 
 	"github.com/sarulabs/di"
 )
@@ -24,8 +29,24 @@ func BuildServices() []di.Def {
 				repo := ctn.Get(constants.AccountRepositoryName).(repositories.AccountRepository)
 				sessionService := ctn.Get(constants.SessionServiceName).(*services.SessionService)
 				mailingService := ctn.Get(constants.MailingServiceName).(*services.MailingService)
+				passwordPolicyService := ctn.Get(constants.PasswordPolicyServiceName).(*services.PasswordPolicyService)
 
-				return services.NewAccountService(repo, sessionService, mailingService), nil
+				return services.NewAccountService(repo, sessionService, mailingService, passwordPolicyService), nil
+			},
+		},
+		{
+			Name: constants.PasswordPolicyServiceName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				cfg := ctn.Get(constants.ConfigName).(*config.Config)
+				policyRepo := ctn.Get(constants.PasswordPolicyRepositoryName).(repositories.BaseRepository[entities.PasswordPolicy])
+				historyRepo := ctn.Get(constants.PasswordHistoryRepositoryName).(repositories.PasswordHistoryRepository)
+
+				wordlist, err := password.LoadWordlist(cfg.PasswordConfig.Wordlist)
+				if err != nil {
+					return nil, err
+				}
+
+				return services.NewPasswordPolicyService(policyRepo, historyRepo, wordlist), nil
 			},
 		},
 		{
@@ -55,8 +76,9 @@ func BuildServices() []di.Def {
 				accountService := ctn.Get(constants.AccountServiceName).(*services.AccountService)
 				roleRepo := ctn.Get(constants.RoleRepositoryName).(repositories.RoleRepository)
 				permRepo := ctn.Get(constants.PermissionRepositoryName).(repositories.PermissionRepository)
+				grantRepo := ctn.Get(constants.RoleGrantRepositoryName).(repositories.RoleGrantRepository)
 
-				return services.NewAuthorizationService(accountService, roleRepo, permRepo), nil
+				return services.NewAuthorizationService(accountService, roleRepo, permRepo, grantRepo), nil
 			},
 		},
 		{
@@ -64,17 +86,53 @@ func BuildServices() []di.Def {
 			Build: func(ctn di.Container) (interface{}, error) {
 				gameRepo := ctn.Get(constants.GameRepositoryName).(repositories.GameRepository)
 				organizationRepo := ctn.Get(constants.OrganizationRepositoryName).(repositories.OrganizationRepository)
+				gameHistoryService := ctn.Get(constants.GameHistoryServiceName).(*services.GameHistoryService)
+				wagerConstraintService := ctn.Get(constants.WagerConstraintServiceName).(*services.WagerConstraintService)
+
+				return services.NewGameService(gameRepo, organizationRepo, gameHistoryService, wagerConstraintService), nil
+			},
+		},
+		{
+			Name: constants.WagerConstraintServiceName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				repo := ctn.Get(constants.WagerConstraintRepositoryName).(repositories.GameWagerConstraintRepository)
+				wagerSetRepo := ctn.Get(constants.WagerSetRepositoryName).(repositories.BaseRepository[entities.WagerSet])
+				gameRepo := ctn.Get(constants.GameRepositoryName).(repositories.GameRepository)
+
+				return services.NewWagerConstraintService(repo, wagerSetRepo, gameRepo), nil
+			},
+		},
+		{
+			Name: constants.GameHistoryServiceName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				repo := ctn.Get(constants.GameVersionRepositoryName).(repositories.GameVersionRepository)
+				gameRepo := ctn.Get(constants.GameRepositoryName).(repositories.GameRepository)
+				organizationRepo := ctn.Get(constants.OrganizationRepositoryName).(repositories.OrganizationRepository)
+				wagerConstraintService := ctn.Get(constants.WagerConstraintServiceName).(*services.WagerConstraintService)
 
-				return services.NewGameService(gameRepo, organizationRepo), nil
+				return services.NewGameHistoryService(repo, gameRepo, organizationRepo, wagerConstraintService), nil
+			},
+		},
+		{
+			Name: constants.GameCatalogServiceName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				gameRepo := ctn.Get(constants.GameRepositoryName).(repositories.GameRepository)
+				organizationRepo := ctn.Get(constants.OrganizationRepositoryName).(repositories.OrganizationRepository)
+				gameHistoryService := ctn.Get(constants.GameHistoryServiceName).(*services.GameHistoryService)
+				wagerConstraintService := ctn.Get(constants.WagerConstraintServiceName).(*services.WagerConstraintService)
+				validate := ctn.Get(constants.ValidatorName).(*validator.Validator)
+
+				return services.NewGameCatalogService(gameRepo, organizationRepo, gameHistoryService, wagerConstraintService, validate), nil
 			},
 		},
 		{
 			Name: constants.SpinServiceName,
 			Build: func(ctn di.Container) (interface{}, error) {
 				gameService := ctn.Get(constants.GameServiceName).(*services.GameService)
+				currencyService := ctn.Get(constants.CurrencyServiceName).(*services.CurrencyService)
 				historyClient := ctn.Get(constants.HistoryName).(history.Client)
 
-				return services.NewSpinService(gameService, historyClient), nil
+				return services.NewSpinService(gameService, currencyService, historyClient), nil
 			},
 		},
 		{
@@ -91,8 +149,10 @@ func BuildServices() []di.Def {
 				repo := ctn.Get(constants.OrganizationRepositoryName).(repositories.OrganizationRepository)
 				accountService := ctn.Get(constants.AccountServiceName).(*services.AccountService)
 				gameService := ctn.Get(constants.GameServiceName).(*services.GameService)
+				gameHistoryService := ctn.Get(constants.GameHistoryServiceName).(*services.GameHistoryService)
+				wagerConstraintService := ctn.Get(constants.WagerConstraintServiceName).(*services.WagerConstraintService)
 
-				return services.NewOrganizationService(repo, accountService, gameService), nil
+				return services.NewOrganizationService(repo, accountService, gameService, gameHistoryService, wagerConstraintService), nil
 			},
 		},
 		{
@@ -128,8 +188,20 @@ func BuildServices() []di.Def {
 			Name: constants.WagerSetServiceName,
 			Build: func(ctn di.Container) (interface{}, error) {
 				wagerSetRepo := ctn.Get(constants.WagerSetRepositoryName).(repositories.BaseRepository[entities.WagerSet])
+				wagerConstraintService := ctn.Get(constants.WagerConstraintServiceName).(*services.WagerConstraintService)
+
+				return services.NewWagerSetService(wagerSetRepo, wagerConstraintService), nil
+			},
+		},
+		{
+			Name: constants.WagerSetGeneratorServiceName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				wagerSetRepo := ctn.Get(constants.WagerSetRepositoryName).(repositories.BaseRepository[entities.WagerSet])
+				currencyRepo := ctn.Get(constants.CurrencyRepositoryName).(repositories.CurrencyRepository)
+				organizationRepo := ctn.Get(constants.OrganizationRepositoryName).(repositories.OrganizationRepository)
+				wagerConstraintService := ctn.Get(constants.WagerConstraintServiceName).(*services.WagerConstraintService)
 
-				return services.NewWagerSetService(wagerSetRepo), nil
+				return services.NewWagerSetGeneratorService(wagerSetRepo, currencyRepo, organizationRepo, wagerConstraintService), nil
 			},
 		},
 		{
@@ -165,8 +237,10 @@ func BuildServices() []di.Def {
 				cfg := ctn.Get(constants.ConfigName).(*config.Config)
 				spinService := ctn.Get(constants.SpinServiceName).(*services.SpinService)
 				fileRepo := ctn.Get(constants.FileRepositoryName).(repositories.FileRepository)
+				fileEventRepo := ctn.Get(constants.FileEventRepositoryName).(repositories.FileEventRepository)
+				storage := ctn.Get(constants.FileStorageName).(file.Storage)
 
-				return services.NewFileDownloadingService(cfg.FileConfig, cfg.ClientInfoConfig, spinService, fileRepo), nil
+				return services.NewFileDownloadingService(cfg.FileConfig, cfg.ClientInfoConfig, spinService, fileRepo, fileEventRepo, storage), nil
 			},
 		},
 		{
@@ -176,5 +250,166 @@ func BuildServices() []di.Def {
 
 				return services.NewClientInfoService(cfg.ClientInfoConfig), nil
 			}},
+		{
+			Name: constants.AuditServiceName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				auditRepo := ctn.Get(constants.AuditRepositoryName).(repositories.AuditRepository)
+
+				return services.NewAuditService(auditRepo), nil
+			},
+		},
+		{
+			Name: constants.ReportScheduleServiceName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				repo := ctn.Get(constants.ReportScheduleRepositoryName).(repositories.ReportScheduleRepository)
+				fileService := ctn.Get(constants.FileDownloadingServiceName).(*services.FileDownloadingService)
+				mailingService := ctn.Get(constants.MailingServiceName).(*services.MailingService)
+				accountService := ctn.Get(constants.AccountServiceName).(*services.AccountService)
+
+				return services.NewReportScheduleService(repo, fileService, mailingService, accountService), nil
+			},
+		},
+		{
+			Name: constants.APIKeyServiceName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				repo := ctn.Get(constants.APIKeyRepositoryName).(repositories.APIKeyRepository)
+				authorizationService := ctn.Get(constants.AuthorizationServiceName).(*services.AuthorizationService)
+
+				return services.NewAPIKeyService(repo, authorizationService), nil
+			},
+		},
+		{
+			Name: constants.SSOServiceName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				cfg := ctn.Get(constants.ConfigName).(*config.Config)
+				stateRepo := ctn.Get(constants.SSOStateRepositoryName).(repositories.SSOStateRepository)
+				identityRepo := ctn.Get(constants.AccountIdentityRepositoryName).(repositories.BaseRepository[entities.AccountIdentity])
+				accountService := ctn.Get(constants.AccountServiceName).(*services.AccountService)
+				organizationService := ctn.Get(constants.OrganizationServiceName).(*services.OrganizationService)
+				authorizationService := ctn.Get(constants.AuthorizationServiceName).(*services.AuthorizationService)
+				authenticationService := ctn.Get(constants.AuthenticationServiceName).(*services.AuthenticationService)
+				roleGrantService := ctn.Get(constants.RoleGrantServiceName).(*services.RoleGrantService)
+
+				var provider *oidc.Provider
+				if cfg.OIDCConfig.Enabled() {
+					provider = oidc.New(cfg.OIDCConfig)
+				}
+
+				return services.NewSSOService(cfg.SSOConfig, provider, stateRepo, identityRepo, accountService,
+					organizationService, authorizationService, authenticationService, roleGrantService), nil
+			},
+		},
+		{
+			Name: constants.LockoutServiceName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				cfg := ctn.Get(constants.ConfigName).(*config.Config)
+				attemptRepo := ctn.Get(constants.LoginAttemptRepositoryName).(repositories.LoginAttemptRepository)
+				eventRepo := ctn.Get(constants.SecurityEventRepositoryName).(repositories.SecurityEventRepository)
+				accountService := ctn.Get(constants.AccountServiceName).(*services.AccountService)
+				authenticationService := ctn.Get(constants.AuthenticationServiceName).(*services.AuthenticationService)
+				mailingService := ctn.Get(constants.MailingServiceName).(*services.MailingService)
+
+				return services.NewLockoutService(cfg.LockoutConfig, attemptRepo, eventRepo, accountService,
+					authenticationService, mailingService), nil
+			},
+		},
+		{
+			Name: constants.TwoFactorServiceName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				recoveryRepo := ctn.Get(constants.RecoveryCodeRepositoryName).(repositories.RecoveryCodeRepository)
+				accountService := ctn.Get(constants.AccountServiceName).(*services.AccountService)
+				webAuthnService := ctn.Get(constants.WebAuthnServiceName).(*services.WebAuthnService)
+				mailingService := ctn.Get(constants.MailingServiceName).(*services.MailingService)
+
+				return services.NewTwoFactorService(recoveryRepo, accountService, webAuthnService, mailingService), nil
+			},
+		},
+		{
+			Name: constants.WebAuthnServiceName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				cfg := ctn.Get(constants.ConfigName).(*config.Config)
+				sessionRepo := ctn.Get(constants.WebAuthnSessionRepositoryName).(repositories.WebAuthnSessionRepository)
+				credentialRepo := ctn.Get(constants.WebAuthnCredentialRepositoryName).(repositories.WebAuthnCredentialRepository)
+				accountService := ctn.Get(constants.AccountServiceName).(*services.AccountService)
+
+				var w *webauthn.WebAuthn
+				if cfg.WebAuthnConfig.Enabled() {
+					w = webauthn.New(cfg.WebAuthnConfig)
+				}
+
+				return services.NewWebAuthnService(w, sessionRepo, credentialRepo, accountService), nil
+			},
+		},
+		{
+			Name: constants.InviteServiceName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				cfg := ctn.Get(constants.ConfigName).(*config.Config)
+				accountService := ctn.Get(constants.AccountServiceName).(*services.AccountService)
+				organizationService := ctn.Get(constants.OrganizationServiceName).(*services.OrganizationService)
+				authorizationService := ctn.Get(constants.AuthorizationServiceName).(*services.AuthorizationService)
+				authenticationService := ctn.Get(constants.AuthenticationServiceName).(*services.AuthenticationService)
+				roleGrantService := ctn.Get(constants.RoleGrantServiceName).(*services.RoleGrantService)
+				mailingService := ctn.Get(constants.MailingServiceName).(*services.MailingService)
+
+				return services.NewInviteService(cfg.InviteConfig, accountService, organizationService,
+					authorizationService, authenticationService, roleGrantService, mailingService), nil
+			},
+		},
+		{
+			Name: constants.ImpersonationServiceName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				cfg := ctn.Get(constants.ConfigName).(*config.Config)
+				authz := ctn.Get(constants.AuthorizerName).(auth.Authorizer)
+				accountService := ctn.Get(constants.AccountServiceName).(*services.AccountService)
+				sessionService := ctn.Get(constants.SessionServiceName).(*services.SessionService)
+				eventRepo := ctn.Get(constants.SecurityEventRepositoryName).(repositories.SecurityEventRepository)
+
+				return services.NewImpersonationService(cfg.ImpersonationConfig, authz, accountService, sessionService, eventRepo), nil
+			},
+		},
+		{
+			Name: constants.RoleGrantServiceName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				cfg := ctn.Get(constants.ConfigName).(*config.Config)
+				grantRepo := ctn.Get(constants.RoleGrantRepositoryName).(repositories.RoleGrantRepository)
+				roleRepo := ctn.Get(constants.RoleRepositoryName).(repositories.RoleRepository)
+				accountService := ctn.Get(constants.AccountServiceName).(*services.AccountService)
+				authorizationService := ctn.Get(constants.AuthorizationServiceName).(*services.AuthorizationService)
+				authenticationService := ctn.Get(constants.AuthenticationServiceName).(*services.AuthenticationService)
+
+				return services.NewRoleGrantService(cfg.RoleGrantConfig, grantRepo, roleRepo, accountService,
+					authorizationService, authenticationService), nil
+			},
+		},
+		{
+			Name: constants.PublicReportServiceName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				cfg := ctn.Get(constants.ConfigName).(*config.Config)
+				spinService := ctn.Get(constants.SpinServiceName).(*services.SpinService)
+				organizationService := ctn.Get(constants.OrganizationServiceName).(*services.OrganizationService)
+
+				return services.NewPublicReportService(cfg.PublicReportConfig, spinService, organizationService), nil
+			},
+		},
+		{
+			Name: constants.PermissionRegistryServiceName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				cfg := ctn.Get(constants.ConfigName).(*config.Config)
+				permissionRepo := ctn.Get(constants.PermissionRepositoryName).(repositories.PermissionRepository)
+
+				return services.NewPermissionRegistryService(cfg.PermissionsConfig, permissionRepo), nil
+			},
+		},
+		{
+			Name: constants.CampaignServiceName,
+			Build: func(ctn di.Container) (interface{}, error) {
+				campaignRepo := ctn.Get(constants.CampaignRepositoryName).(repositories.BaseRepository[entities.FreeSpinCampaign])
+				campaignUserRepo := ctn.Get(constants.CampaignUserRepositoryName).(repositories.BaseRepository[entities.FreeSpinUser])
+				gameService := ctn.Get(constants.GameServiceName).(*services.GameService)
+				organizationService := ctn.Get(constants.OrganizationServiceName).(*services.OrganizationService)
+
+				return services.NewFreeSpinCampaignService(campaignRepo, campaignUserRepo, gameService, organizationService), nil
+			},
+		},
 	}
 }
@@ -12,6 +12,14 @@ const (
 	AuthProviderEmail = "email"
 )
 
+// Accounts created before statuses were used have status 0 and are active.
+const (
+	AccountStatusActive = 1
+	AccountStatusLocked = 2
+	// AccountStatusPending accounts were invited and have no password until the invite is accepted.
+	AccountStatusPending = 3
+)
+
 type Account struct {
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt time.Time  `json:"updated_at"`
@@ -34,8 +42,10 @@ type Account struct {
 
 	Status int64 `json:"status"`
 	*totp.Params
+	WebAuthnEnabled bool `json:"webauthn_enabled" gorm:"column:webauthn_enabled"`
 
-	ResetPasswordToken     string     `json:"reset_password_token" gorm:"column:reset_password_token"`
+	PasswordChangedAt      *time.Time `json:"password_changed_at" gorm:"column:password_changed_at"`
+	ResetPasswordToken     string     `json:"-" gorm:"column:reset_password_token"`
 	ResetPasswordExpiresAt *time.Time `json:"reset_password_expires_at" gorm:"column:reset_password_expires_at"`
 
 	Operators []*Organization `json:"operators" gorm:"many2many:account_operators;foreignKey:id;joinForeignKey:account_id;joinReferences:operator_id;references:id"`
@@ -81,6 +91,35 @@ func (a *Account) Authorized(endpoint, method string) bool {
 	return false
 }
 
+// HasSecondFactor is true when the account signs in with an authenticator app or a security key.
+func (a *Account) HasSecondFactor() bool {
+	return (a.Params != nil && a.TOTPEnabled) || a.WebAuthnEnabled
+}
+
+// PasswordSetAt is when the password was last changed, accounts created before changes were tracked use
+// the creation time.
+func (a *Account) PasswordSetAt() time.Time {
+	if a.PasswordChangedAt != nil {
+		return *a.PasswordChangedAt
+	}
+
+	return a.CreatedAt
+}
+
+func (a *Account) IsLocked() bool {
+	return a.Status == AccountStatusLocked
+}
+
+func (a *Account) IsPending() bool {
+	return a.Status == AccountStatusPending
+}
+
+func (a *Account) InOrganization(organizationID uuid.UUID) bool {
+	return lo.ContainsBy(a.Organizations, func(item *Organization) bool {
+		return item.ID == organizationID
+	})
+}
+
 func (a *Account) IsRoot() bool {
 	for _, role := range a.Roles {
 		if role.Type == RootRoleTypeName {
@@ -91,6 +130,16 @@ func (a *Account) IsRoot() bool {
 	return false
 }
 
+func (a *Account) IsAdmin() bool {
+	for _, role := range a.Roles {
+		if role.Type == AdminRoleTypeName {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (a *Account) GetDefaultOrganizationID() uuid.UUID {
 	if a.Organizations != nil {
 		return a.Organizations[0].ID
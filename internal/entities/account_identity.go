@@ -0,0 +1,39 @@
+package entities
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuthProviderOIDC = "oidc"
+)
+
+// AccountIdentity links an account to the subject of an external identity provider.
+type AccountIdentity struct {
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Issuer    string    `json:"issuer" gorm:"primaryKey"`
+	Subject   string    `json:"subject" gorm:"primaryKey"`
+	AccountID uuid.UUID `json:"account_id"`
+	Email     string    `json:"email"`
+
+	LastLoginAt *time.Time `json:"last_login_at"`
+}
+
+// SSOState is kept between the redirect to the identity provider and its callback.
+type SSOState struct {
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+}
+
+func (s *SSOState) MarshalBinary() (data []byte, err error) {
+	return json.Marshal(s)
+}
+
+func (s *SSOState) Unmarshal(data []byte) error {
+	return json.Unmarshal(data, s)
+}
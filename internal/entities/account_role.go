@@ -6,7 +6,8 @@ import (
 )
 
 type AccountRole struct {
-	CreatedAt time.Time `json:"created_at"`
-	AccountID uuid.UUID `json:"account_id"`
-	RoleID    uuid.UUID `json:"role_id"`
+	CreatedAt time.Time  `json:"created_at"`
+	AccountID uuid.UUID  `json:"account_id"`
+	RoleID    uuid.UUID  `json:"role_id"`
+	ExpiresAt *time.Time `json:"expires_at"`
 }
@@ -0,0 +1,86 @@
+package entities
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/samber/lo"
+)
+
+const (
+	AuthProviderAPIKey = "api_key"
+)
+
+// APIKey is a service account credential of an organization, the key acts with permissions of its role.
+// Only a hash of the secret is stored, the secret itself is returned once on creation and rotation.
+type APIKey struct {
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	ID             uuid.UUID      `json:"id"`
+	OrganizationID uuid.UUID      `json:"organization_id"`
+	CreatedBy      uuid.UUID      `json:"created_by"`
+	Name           string         `json:"name"`
+	Description    string         `json:"description"`
+	RoleID         uuid.UUID      `json:"role_id"`
+	Role           *Role          `json:"role,omitempty"`
+	Prefix         string         `json:"prefix"`
+	Hash           string         `json:"-"`
+	IPAllowlist    pq.StringArray `json:"ip_allowlist" gorm:"type:varchar[]" swaggertype:"array,string"`
+	ExpiresAt      time.Time      `json:"expires_at"`
+	LastUsedAt     *time.Time     `json:"last_used_at"`
+	RevokedAt      *time.Time     `json:"revoked_at"`
+
+	// Key is the plain secret, filled only in the creation and rotation responses.
+	Key string `json:"key,omitempty" gorm:"-"`
+}
+
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+func (k *APIKey) IsActive(now time.Time) bool {
+	return k.RevokedAt == nil && now.Before(k.ExpiresAt)
+}
+
+// IsIPAllowed accepts any address when the allowlist is empty, entries are single addresses or CIDR ranges.
+func (k *APIKey) IsIPAllowed(ip string) bool {
+	if len(k.IPAllowlist) == 0 {
+		return true
+	}
+
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+
+	return lo.ContainsBy(k.IPAllowlist, func(item string) bool {
+		if !strings.Contains(item, "/") {
+			return addr.Equal(net.ParseIP(item))
+		}
+
+		_, network, err := net.ParseCIDR(item)
+
+		return err == nil && network.Contains(addr)
+	})
+}
+
+// Session builds the request session of the key, the account is synthetic and carries only the key role.
+func (k *APIKey) Session() *Session {
+	return &Session{
+		ID: k.ID,
+		Account: &Account{
+			ID:             k.ID,
+			AuthProvider:   AuthProviderAPIKey,
+			AuthProviderID: k.Prefix,
+			FirstName:      k.Name,
+			Organizations:  []*Organization{{ID: k.OrganizationID}},
+			Roles:          []*Role{k.Role},
+			Permissions:    k.Role.Permissions,
+		},
+		OrganizationID: k.OrganizationID,
+	}
+}
@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type AuditLog struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	ID             uuid.UUID `json:"id"`
+	AccountID      uuid.UUID `json:"account_id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Endpoint       string    `json:"endpoint"`
+	Method         string    `json:"method"`
+	EntityID       string    `json:"entity_id"`
+	Status         int       `json:"status"`
+	// ImpersonatorID is the administrator who made the request signed in as the account.
+	ImpersonatorID *uuid.UUID `json:"impersonator_id"`
+
+	Request JSON `json:"request" gorm:"type:jsonb" swaggertype:"object"`
+	Before  JSON `json:"before" gorm:"type:jsonb" swaggertype:"object"`
+	After   JSON `json:"after" gorm:"type:jsonb" swaggertype:"object"`
+	Diff    JSON `json:"diff" gorm:"type:jsonb" swaggertype:"object"`
+}
+
+func (AuditLog) TableName() string {
+	return "audit_log"
+}
+
+type AuditFilters struct {
+	AccountID      *uuid.UUID
+	OrganizationID *uuid.UUID
+	Endpoint       string
+	Method         string
+	EntityID       string
+	ImpersonatorID *uuid.UUID
+	From           *time.Time
+	To             *time.Time
+}
@@ -0,0 +1,185 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const (
+	RateModeSpinTime = "spin_time"
+	RateModeSnapshot = "snapshot"
+)
+
+type ConsolidatedFinancialFilters struct {
+	TargetCurrency string `json:"target_currency" form:"target_currency" validate:"required"`
+	RateMode       string `json:"rate_mode,omitempty" form:"rate_mode"`
+	RateAt         string `json:"rate_at,omitempty" form:"rate_at" validate:"custom_datetime"`
+	FinancialFilters
+}
+
+// AppliedRate is an exchange rate in force from ValidFrom until ValidTo, excluding ValidTo.
+type AppliedRate struct {
+	ValidFrom time.Time `json:"valid_from" csv:"valid_from" xlsx:"Valid From"`
+	ValidTo   time.Time `json:"valid_to" csv:"valid_to" xlsx:"Valid To"`
+	Rate      float64   `json:"rate" csv:"rate" xlsx:"Rate"`
+}
+
+type ConsolidatedFinancialReportItem struct {
+	Currency       string `json:"currency" csv:"currency" xlsx:"Currency"`
+	TargetCurrency string `json:"target_currency" csv:"target_currency" xlsx:"Target Currency"`
+
+	// Rate is the snapshot rate or the wager weighted rate when several rates were applied.
+	Rate  float64        `json:"rate" csv:"rate" xlsx:"Rate"`
+	Rates []*AppliedRate `json:"rates" csv:"-" xlsx:"-"`
+
+	Wager           float64 `json:"wager" csv:"wager" xlsx:"Wager"`
+	Award           float64 `json:"award" csv:"award" xlsx:"Award"`
+	WagerWithoutPFR float64 `json:"wager_without_pfr" csv:"wager_without_pfr" xlsx:"Wager without PFR"`
+	AwardWithoutPFR float64 `json:"award_without_pfr" csv:"award_without_pfr" xlsx:"Award without PFR"`
+
+	ConvertedWager           float64 `json:"converted_wager" csv:"converted_wager" xlsx:"Converted Wager"`
+	ConvertedAward           float64 `json:"converted_award" csv:"converted_award" xlsx:"Converted Award"`
+	ConvertedWagerWithoutPFR float64 `json:"converted_wager_without_pfr" csv:"converted_wager_without_pfr" xlsx:"Converted Wager without PFR"`
+	ConvertedAwardWithoutPFR float64 `json:"converted_award_without_pfr" csv:"converted_award_without_pfr" xlsx:"Converted Award without PFR"`
+	ConvertedRevenue         float64 `json:"converted_revenue" csv:"converted_revenue" xlsx:"Converted Revenue"`
+
+	SpinQuantity int `json:"spin_quantity" csv:"spin_quantity" xlsx:"Spin Quantity"`
+	UserQuantity int `json:"user_quantity" csv:"user_quantity" xlsx:"User Quantity"`
+}
+
+type ConsolidatedFinancialReport struct {
+	Currency string     `json:"currency"`
+	RateMode string     `json:"rate_mode"`
+	RateAt   *time.Time `json:"rate_at,omitempty"`
+
+	Total *FinancialReport                   `json:"total"`
+	Items []*ConsolidatedFinancialReportItem `json:"items"`
+}
+
+func IsRateMode(mode string) bool {
+	return mode == RateModeSpinTime || mode == RateModeSnapshot
+}
+
+// Add converts source amounts of rep with rate and accumulates them.
+func (i *ConsolidatedFinancialReportItem) Add(rep *FinancialReport, rate float64) {
+	i.Wager += rep.Wager
+	i.Award += rep.Award
+	i.WagerWithoutPFR += rep.WagerWithoutPFR
+	i.AwardWithoutPFR += rep.AwardWithoutPFR
+
+	i.ConvertedWager += rep.Wager * rate
+	i.ConvertedAward += rep.Award * rate
+	i.ConvertedWagerWithoutPFR += rep.WagerWithoutPFR * rate
+	i.ConvertedAwardWithoutPFR += rep.AwardWithoutPFR * rate
+}
+
+// RateAt returns the applied rate in force at t, rates are expected to be ordered by ValidFrom.
+func (i *ConsolidatedFinancialReportItem) RateAt(t time.Time) float64 {
+	rate, _, ok := lo.FindLastIndexOf(i.Rates, func(item *AppliedRate) bool {
+		return !item.ValidFrom.After(t)
+	})
+	if !ok {
+		return 0
+	}
+
+	return rate.Rate
+}
+
+func (i *ConsolidatedFinancialReportItem) Compute() *ConsolidatedFinancialReportItem {
+	if i.Wager != 0 {
+		i.Rate = i.ConvertedWager / i.Wager
+	} else if len(i.Rates) > 0 {
+		i.Rate = i.Rates[len(i.Rates)-1].Rate
+	}
+
+	i.ConvertedRevenue = i.ConvertedWagerWithoutPFR - i.ConvertedAward
+
+	return i
+}
+
+func (i *ConsolidatedFinancialReportItem) Prettify() *ConsolidatedFinancialReportItem {
+	i.Wager = prettifyPrecision(i.Wager)
+	i.Award = prettifyPrecision(i.Award)
+	i.WagerWithoutPFR = prettifyPrecision(i.WagerWithoutPFR)
+	i.AwardWithoutPFR = prettifyPrecision(i.AwardWithoutPFR)
+
+	i.ConvertedWager = prettifyPrecision(i.ConvertedWager)
+	i.ConvertedAward = prettifyPrecision(i.ConvertedAward)
+	i.ConvertedWagerWithoutPFR = prettifyPrecision(i.ConvertedWagerWithoutPFR)
+	i.ConvertedAwardWithoutPFR = prettifyPrecision(i.ConvertedAwardWithoutPFR)
+	i.ConvertedRevenue = prettifyPrecision(i.ConvertedRevenue)
+
+	return i
+}
+
+// Compute sums converted items into the total, amounts are expected to be raw (not prettified).
+// Users playing in several currencies are counted once, so the user quantity of the total is kept as set.
+func (r *ConsolidatedFinancialReport) Compute() *ConsolidatedFinancialReport {
+	total := &FinancialReport{}
+	if r.Total != nil {
+		total.UserQuantity = r.Total.UserQuantity
+	}
+
+	r.Total = total
+
+	for _, item := range r.Items {
+		item.Compute()
+
+		r.Total.Wager += item.ConvertedWager
+		r.Total.Award += item.ConvertedAward
+		r.Total.WagerWithoutPFR += item.ConvertedWagerWithoutPFR
+		r.Total.AwardWithoutPFR += item.ConvertedAwardWithoutPFR
+		r.Total.SpinQuantity += item.SpinQuantity
+	}
+
+	return r
+}
+
+func (r *ConsolidatedFinancialReport) Prettify() *ConsolidatedFinancialReport {
+	r.Total.Prettify()
+
+	for _, item := range r.Items {
+		item.Prettify()
+	}
+
+	return r
+}
+
+// Table flattens the report for csv export: one row per source currency followed by the total row.
+func (r *ConsolidatedFinancialReport) Table() []*ConsolidatedFinancialReportItem {
+	rows := append([]*ConsolidatedFinancialReportItem{}, r.Items...)
+
+	rows = append(rows, &ConsolidatedFinancialReportItem{
+		Currency:                 "total",
+		TargetCurrency:           r.Currency,
+		Rate:                     1,
+		ConvertedWager:           r.Total.Wager,
+		ConvertedAward:           r.Total.Award,
+		ConvertedWagerWithoutPFR: r.Total.WagerWithoutPFR,
+		ConvertedAwardWithoutPFR: r.Total.AwardWithoutPFR,
+		ConvertedRevenue:         r.Total.Revenue,
+		SpinQuantity:             r.Total.SpinQuantity,
+		UserQuantity:             r.Total.UserQuantity,
+	})
+
+	return rows
+}
+
+// RateRow is a flat view of an applied rate for xlsx export.
+type RateRow struct {
+	Currency string `json:"currency" csv:"currency" xlsx:"Currency"`
+	AppliedRate
+}
+
+func (r *ConsolidatedFinancialReport) RateRows() []*RateRow {
+	rows := []*RateRow{}
+
+	for _, item := range r.Items {
+		for _, rate := range item.Rates {
+			rows = append(rows, &RateRow{Currency: item.Currency, AppliedRate: *rate})
+		}
+	}
+
+	return rows
+}
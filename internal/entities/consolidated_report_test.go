@@ -0,0 +1,82 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConsolidatedFinancialReportCompute(t *testing.T) {
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	change := from.Add(12 * time.Hour)
+	to := from.Add(24 * time.Hour)
+
+	usd := &ConsolidatedFinancialReportItem{
+		Currency:       "usd",
+		TargetCurrency: "eur",
+		Rates:          []*AppliedRate{{ValidFrom: from, ValidTo: change, Rate: 0.9}, {ValidFrom: change, ValidTo: to, Rate: 0.8}},
+		SpinQuantity:   30,
+		UserQuantity:   3,
+	}
+	usd.Add(&FinancialReport{Wager: 1000, Award: 500, WagerWithoutPFR: 800, AwardWithoutPFR: 400}, usd.RateAt(from))
+	usd.Add(&FinancialReport{Wager: 2000, Award: 1000, WagerWithoutPFR: 2000, AwardWithoutPFR: 1000}, usd.RateAt(change))
+
+	eur := &ConsolidatedFinancialReportItem{
+		Currency:       "eur",
+		TargetCurrency: "eur",
+		Rates:          []*AppliedRate{{ValidFrom: from, ValidTo: to, Rate: 1}},
+		SpinQuantity:   20,
+		UserQuantity:   2,
+	}
+	eur.Add(&FinancialReport{Wager: 4000, Award: 3000, WagerWithoutPFR: 3000, AwardWithoutPFR: 2000}, eur.RateAt(from))
+
+	// one of the users played in both currencies
+	report := &ConsolidatedFinancialReport{
+		Currency: "eur",
+		Total:    &FinancialReport{UserQuantity: 4},
+		Items:    []*ConsolidatedFinancialReportItem{usd, eur},
+	}
+	report.Compute()
+
+	total := report.Total
+	if total.Wager != 900+1600+4000 || total.Award != 450+800+3000 {
+		t.Fatalf("wager and award must be the sum of converted items, got %v and %v", total.Wager, total.Award)
+	}
+
+	if total.WagerWithoutPFR != 720+1600+3000 || total.AwardWithoutPFR != 360+800+2000 {
+		t.Fatalf("amounts without pfr must be the sum of converted items, got %v and %v", total.WagerWithoutPFR, total.AwardWithoutPFR)
+	}
+
+	if total.SpinQuantity != 50 {
+		t.Fatalf("spin quantity must be the sum of items, got %v", total.SpinQuantity)
+	}
+
+	if total.UserQuantity != 4 {
+		t.Fatalf("user quantity must not be summed across currencies, got %v", total.UserQuantity)
+	}
+
+	if usd.Rate != (900+1600)/3000.0 || eur.Rate != 1 {
+		t.Fatalf("item rate must be weighted by wager, got %v and %v", usd.Rate, eur.Rate)
+	}
+
+	if usd.ConvertedRevenue != 720+1600-(450+800) {
+		t.Fatalf("converted revenue is wager without pfr less award, got %v", usd.ConvertedRevenue)
+	}
+}
+
+func TestConsolidatedFinancialReportItemRateAt(t *testing.T) {
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	change := from.Add(time.Hour)
+
+	item := &ConsolidatedFinancialReportItem{
+		Rates: []*AppliedRate{{ValidFrom: from, ValidTo: change, Rate: 2}, {ValidFrom: change, ValidTo: change.Add(time.Hour), Rate: 3}},
+	}
+
+	// intervals are half-open, the rate changes exactly at ValidFrom of the next one
+	if rate := item.RateAt(change.Add(-time.Nanosecond)); rate != 2 {
+		t.Fatalf("expected the first rate before the change, got %v", rate)
+	}
+
+	if rate := item.RateAt(change); rate != 3 {
+		t.Fatalf("expected the second rate at the change, got %v", rate)
+	}
+}
@@ -2,7 +2,7 @@ package entities
 
 import (
 	"encoding/json"
-	"reflect"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,9 +15,11 @@ type FileStatus string
 type FileType string
 
 const (
+	FileStatusQueued     FileStatus = "queued"
 	FileStatusInProgress FileStatus = "inProgress"
 	FileStatusReady      FileStatus = "ready"
 	FileStatusError      FileStatus = "error"
+	FileStatusCancelled  FileStatus = "cancelled"
 )
 
 const (
@@ -26,14 +28,16 @@ const (
 )
 
 type File struct {
-	ID          uuid.UUID  `json:"id"`
-	Status      FileStatus `json:"status"`
-	Type        FileType   `json:"type"`
-	Name        string     `json:"name"`
-	Data        []byte     `json:"data"`
-	Array       []any      `json:"array"`
-	ReflectType string     `json:"reflectType"`
-	CreatedAt   time.Time  `json:"createdAt"`
+	ID     uuid.UUID  `json:"id"`
+	Status FileStatus `json:"status"`
+	Type   FileType   `json:"type"`
+	Name   string     `json:"name"`
+	Rows   int        `json:"rows"`
+	// Attempts counts export runs, more than one means transient failures were retried.
+	Attempts int `json:"attempts"`
+	// Data keeps the generation error, content itself lives in file storage.
+	Data      []byte    `json:"data"`
+	CreatedAt time.Time `json:"createdAt"`
 }
 
 type FileResponse struct {
@@ -41,16 +45,31 @@ type FileResponse struct {
 	Status    FileStatus `json:"status"`
 	Type      FileType   `json:"type"`
 	Name      string     `json:"name"`
+	Rows      int        `json:"rows"`
+	Attempts  int        `json:"attempts"`
 	Error     string     `json:"error"`
 	CreatedAt time.Time  `json:"createdAt"`
 }
 
+// FileEvent is pushed to subscribers of a session while its exports are generated.
+type FileEvent struct {
+	ID       uuid.UUID  `json:"id"`
+	Status   FileStatus `json:"status"`
+	Type     FileType   `json:"type"`
+	Name     string     `json:"name"`
+	Progress int        `json:"progress"`
+	Rows     int        `json:"rows"`
+	Error    string     `json:"error,omitempty"`
+}
+
 func (s *File) Response() FileResponse {
 	res := FileResponse{
 		ID:        s.ID,
 		Status:    s.Status,
 		Type:      s.Type,
 		Name:      s.Name,
+		Rows:      s.Rows,
+		Attempts:  s.Attempts,
 		CreatedAt: s.CreatedAt,
 	}
 
@@ -66,73 +85,48 @@ func (s *File) MarshalBinary() (data []byte, err error) {
 }
 
 func (s *File) Unmarshal(data []byte) error {
-	err := json.Unmarshal(data, &s)
-	if err != nil {
-		return err
+	return json.Unmarshal(data, &s)
+}
+
+// FileName is the name the file is served with, always carrying the type extension.
+func (s *File) FileName() string {
+	if strings.HasSuffix(s.Name, "."+string(s.Type)) {
+		return s.Name
+	}
+
+	return s.Name + "." + string(s.Type)
+}
+
+// Event snapshots file state, progress is a percentage and is reported as complete once the file is ready.
+func (s *File) Event(progress int) *FileEvent {
+	event := &FileEvent{
+		ID:       s.ID,
+		Status:   s.Status,
+		Type:     s.Type,
+		Name:     s.Name,
+		Progress: progress,
+		Rows:     s.Rows,
 	}
 
-	switch s.ReflectType {
-	case reflect.TypeOf(&FinancialReport{}).Name():
-		data, _ = json.Marshal(s.Array)
-		s.Array = []any{}
-		realData := []*FinancialReport{}
-		err = json.Unmarshal(data, &realData)
-		if err != nil {
-			return err
-		}
-
-		for _, elem := range realData {
-			s.Array = append(s.Array, elem)
-		}
-	case reflect.TypeOf(&Spin{}).Name():
-		data, _ = json.Marshal(s.Array)
-		s.Array = []any{}
-		realData := []*Spin{}
-		err = json.Unmarshal(data, &realData)
-		if err != nil {
-			return err
-		}
-
-		for _, elem := range realData {
-			s.Array = append(s.Array, elem)
-		}
-	case reflect.TypeOf(&GamingSession{}).Name():
-		data, _ = json.Marshal(s.Array)
-		s.Array = []any{}
-		realData := []*GamingSession{}
-		err = json.Unmarshal(data, &realData)
-		if err != nil {
-			return err
-		}
-
-		for _, elem := range realData {
-			s.Array = append(s.Array, elem)
-		}
-	case reflect.TypeOf(&AggregatedReportByGame{}).Name():
-		data, _ = json.Marshal(s.Array)
-		s.Array = []any{}
-		realData := []*GamingSession{}
-		err = json.Unmarshal(data, &realData)
-		if err != nil {
-			return err
-		}
-
-		for _, elem := range realData {
-			s.Array = append(s.Array, elem)
-		}
-	case reflect.TypeOf(&AggregatedReportByCountry{}).Name():
-		data, _ = json.Marshal(s.Array)
-		s.Array = []any{}
-		realData := []*GamingSession{}
-		err = json.Unmarshal(data, &realData)
-		if err != nil {
-			return err
-		}
-
-		for _, elem := range realData {
-			s.Array = append(s.Array, elem)
-		}
+	switch s.Status {
+	case FileStatusReady:
+		event.Progress = 100
+	case FileStatusError:
+		event.Error = string(s.Data)
 	}
 
-	return nil
+	return event
+}
+
+func (e *FileEvent) IsFinal() bool {
+	return e.Status.IsFinal()
+}
+
+// IsFinal reports whether the file will not change its status anymore.
+func (s FileStatus) IsFinal() bool {
+	return s == FileStatusReady || s == FileStatusError || s == FileStatusCancelled
+}
+
+func (e *FileEvent) MarshalBinary() (data []byte, err error) {
+	return json.Marshal(e)
 }
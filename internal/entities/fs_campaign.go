@@ -2,29 +2,71 @@ package entities
 
 import (
 	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/samber/lo"
 	"time"
 )
 
+const (
+	FreeSpinCampaignStatusDraft     = "draft"
+	FreeSpinCampaignStatusScheduled = "scheduled"
+	FreeSpinCampaignStatusActive    = "active"
+	FreeSpinCampaignStatusFinished  = "finished"
+	FreeSpinCampaignStatusCancelled = "cancelled"
+)
+
+var freeSpinCampaignTransitions = map[string][]string{
+	FreeSpinCampaignStatusDraft:     {FreeSpinCampaignStatusScheduled, FreeSpinCampaignStatusCancelled},
+	FreeSpinCampaignStatusScheduled: {FreeSpinCampaignStatusDraft, FreeSpinCampaignStatusActive, FreeSpinCampaignStatusCancelled},
+	FreeSpinCampaignStatusActive:    {FreeSpinCampaignStatusFinished, FreeSpinCampaignStatusCancelled},
+}
+
 type FreeSpinCampaign struct {
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt *time.Time `sql:"index"`
-
-	ID          uuid.UUID
-	CreatedBy   uuid.UUID
-	Name        string
-	Description string
-	StartDate   time.Time
-	EndDate     time.Time
-	Type        string
-
-	Token      string
-	FSType     string
-	Currencies []string
-	Status     string
-	CoinSize   int
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"-" sql:"index"`
+
+	ID          uuid.UUID `json:"id"`
+	CreatedBy   uuid.UUID `json:"created_by"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	StartDate   time.Time `json:"start_date"`
+	EndDate     time.Time `json:"end_date"`
+	Type        string    `json:"type"`
+
+	IntegratorID uuid.UUID `json:"integrator_id"`
+	GameID       uuid.UUID `json:"game_id"`
+
+	Token      string         `json:"token"`
+	FSType     string         `json:"fs_type" gorm:"column:fs_type"`
+	Currencies pq.StringArray `json:"currencies" gorm:"type:varchar[]" swaggertype:"array,string"`
+	Status     string         `json:"status"`
+	CoinSize   int            `json:"coin_size"`
 }
 
 func (e FreeSpinCampaign) TableName() string {
 	return "fs_campaigns"
 }
+
+// IsEditable reports whether campaign settings may still be changed.
+func (e FreeSpinCampaign) IsEditable() bool {
+	return e.Status == FreeSpinCampaignStatusDraft || e.Status == FreeSpinCampaignStatusScheduled
+}
+
+func (e FreeSpinCampaign) CanTransitTo(status string) bool {
+	if e.Status == status {
+		return true
+	}
+
+	return lo.Contains(freeSpinCampaignTransitions[e.Status], status)
+}
+
+func IsFreeSpinCampaignStatus(status string) bool {
+	return lo.Contains([]string{
+		FreeSpinCampaignStatusDraft,
+		FreeSpinCampaignStatusScheduled,
+		FreeSpinCampaignStatusActive,
+		FreeSpinCampaignStatusFinished,
+		FreeSpinCampaignStatusCancelled,
+	}, status)
+}
@@ -1 +1,21 @@
 package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type FreeSpinUser struct {
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"-" sql:"index"`
+
+	ID         uuid.UUID `json:"id"`
+	CampaignID uuid.UUID `json:"campaign_id" gorm:"column:fs_campaign_id"`
+	UserID     string    `json:"user_id"`
+	Currency   string    `json:"currency"`
+}
+
+func (e FreeSpinUser) TableName() string {
+	return "fs_users"
+}
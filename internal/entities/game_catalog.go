@@ -0,0 +1,29 @@
+package entities
+
+const (
+	GameCatalogCreate    = "create"
+	GameCatalogUpdate    = "update"
+	GameCatalogUnchanged = "unchanged"
+)
+
+// GameCatalogRow is what the import does with a row of the spreadsheet, Row counts from the header as in
+// the spreadsheet. A row with errors blocks the whole import.
+type GameCatalogRow struct {
+	Row     int               `json:"row"`
+	Name    string            `json:"name"`
+	Action  string            `json:"action,omitempty"`
+	Changes []GameVersionDiff `json:"changes,omitempty"`
+	Errors  []string          `json:"errors,omitempty"`
+}
+
+// GameCatalogReport is the outcome of a game catalog import, Applied is false for a dry run and for a
+// catalog with invalid rows.
+type GameCatalogReport struct {
+	DryRun    bool              `json:"dry_run"`
+	Applied   bool              `json:"applied"`
+	Created   int               `json:"created"`
+	Updated   int               `json:"updated"`
+	Unchanged int               `json:"unchanged"`
+	Invalid   int               `json:"invalid"`
+	Rows      []*GameCatalogRow `json:"rows"`
+}
@@ -0,0 +1,44 @@
+package entities
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"time"
+)
+
+// GameVersion is the configuration of a game after a change, or of the game for an integrator when
+// IntegratorID is set. The first version of a history is the configuration before its first change.
+type GameVersion struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	ID           uuid.UUID       `json:"id"`
+	GameID       uuid.UUID       `json:"game_id"`
+	IntegratorID *uuid.UUID      `json:"integrator_id"`
+	Version      int             `json:"version"`
+	Snapshot     json.RawMessage `json:"snapshot" gorm:"type:jsonb" swaggertype:"object"`
+	// AuthorID is empty for the configuration found before the first change.
+	AuthorID *uuid.UUID `json:"author_id"`
+	Author   string     `json:"author"`
+	// RollbackOf is the version a rollback restored.
+	RollbackOf *int `json:"rollback_of"`
+}
+
+// IntegratorGameConfig is the configuration of a game for an integrator as stored in its versions.
+type IntegratorGameConfig struct {
+	WagerSetID uuid.UUID `json:"wager_set_id"`
+	RTP        *int64    `json:"rtp"`
+	Volatility *string   `json:"volatility"`
+	ShortLink  bool      `json:"short_link"`
+}
+
+type GameVersionDiff struct {
+	Field  string      `json:"field"`
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// GameVersionChange is a version with the fields it changed from the version before.
+type GameVersionChange struct {
+	*GameVersion
+	Changes []GameVersionDiff `json:"changes"`
+}
@@ -0,0 +1,76 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// GameWagerConstraint limits the wager sets of a game in a currency, the constraint without a currency
+// applies to currencies without one of their own and to wager sets that are not bound to a currency.
+// Limits that are not set are not checked.
+type GameWagerConstraint struct {
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	ID       uuid.UUID `json:"id"`
+	GameID   uuid.UUID `json:"game_id"`
+	Currency string    `json:"currency"`
+	// Divisor every wager is a multiple of.
+	Divisor   *int64 `json:"divisor"`
+	MinBet    *int64 `json:"min_bet"`
+	MaxBet    *int64 `json:"max_bet"`
+	MaxLevels *int   `json:"max_levels"`
+	// MaxExposure is the most a spin can win, the max win multiplier of the game times the max bet.
+	MaxWinMultiplier *int64 `json:"max_win_multiplier"`
+	MaxExposure      *int64 `json:"max_exposure"`
+}
+
+func (GameWagerConstraint) TableName() string {
+	return "game_wager_constraints"
+}
+
+// WagerSetUsage is a wager set used by a game, by an integrator when OrganizationID is set and for a
+// currency when Currency is set.
+type WagerSetUsage struct {
+	GameID         uuid.UUID
+	WagerSetID     uuid.UUID
+	OrganizationID *uuid.UUID
+	Currency       string
+}
+
+// Check lists every limit the wager set breaks.
+func (c *GameWagerConstraint) Check(ws *WagerSet) []error {
+	var violations []error
+
+	if c.MaxLevels != nil && len(ws.WagerLevels) > *c.MaxLevels {
+		violations = append(violations, fmt.Errorf("%d wager levels, at most %d are allowed", len(ws.WagerLevels), *c.MaxLevels))
+	}
+
+	for _, wager := range ws.WagerLevels {
+		if c.Divisor != nil && wager%*c.Divisor != 0 {
+			violations = append(violations, fmt.Errorf("wager %d is not a multiple of %d", wager, *c.Divisor))
+		}
+
+		if c.MinBet != nil && wager < *c.MinBet {
+			violations = append(violations, fmt.Errorf("wager %d is less than the min bet %d", wager, *c.MinBet))
+		}
+
+		if c.MaxBet != nil && wager > *c.MaxBet {
+			violations = append(violations, fmt.Errorf("wager %d is more than the max bet %d", wager, *c.MaxBet))
+		}
+	}
+
+	if c.MaxWinMultiplier != nil && c.MaxExposure != nil && len(ws.WagerLevels) > 0 {
+		maxWager := lo.Max(ws.WagerLevels)
+
+		if exposure := maxWager * *c.MaxWinMultiplier; exposure > *c.MaxExposure {
+			violations = append(violations, fmt.Errorf("max wager %d times max win multiplier %d is %d, more than the max exposure %d",
+				maxWager, *c.MaxWinMultiplier, exposure, *c.MaxExposure))
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Invite is a pending account seen from the invite side, the id is the id of the account.
+type Invite struct {
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	Expired   bool       `json:"expired"`
+
+	ID        uuid.UUID `json:"id"`
+	Email     string    `json:"email"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+
+	Organizations []*Organization `json:"organizations,omitempty"`
+	Roles         []*Role         `json:"roles,omitempty"`
+}
+
+func NewInvite(account *Account) *Invite {
+	return &Invite{
+		CreatedAt:     account.CreatedAt,
+		ExpiresAt:     account.ResetPasswordExpiresAt,
+		Expired:       account.ResetPasswordExpiresAt == nil || !account.ResetPasswordExpiresAt.After(time.Now()),
+		ID:            account.ID,
+		Email:         account.Email,
+		FirstName:     account.FirstName,
+		LastName:      account.LastName,
+		Organizations: account.Organizations,
+		Roles:         account.Roles,
+	}
+}
@@ -21,6 +21,8 @@ type Organization struct {
 	Type   string    `json:"type"`
 	Status *uint8    `json:"status"`
 	ApiKey string    `json:"api_key"`
+	// UnsignedPublicReports lets public reports of the integrator be read without a signed token.
+	UnsignedPublicReports bool `json:"unsigned_public_reports" gorm:"default:true"`
 }
 
 func (o *Organization) IsIntegrator() bool {
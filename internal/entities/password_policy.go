@@ -0,0 +1,80 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const DefaultPasswordMinLength = 8
+
+// PasswordPolicy of an organization, accounts in several organizations follow the strictest combination.
+type PasswordPolicy struct {
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	OrganizationID uuid.UUID `json:"organization_id" gorm:"primaryKey"`
+	MinLength      int       `json:"min_length"`
+	RequireUpper   bool      `json:"require_upper"`
+	RequireLower   bool      `json:"require_lower"`
+	RequireDigit   bool      `json:"require_digit"`
+	RequireSymbol  bool      `json:"require_symbol"`
+	// CheckBreached rejects passwords of the breached password wordlist.
+	CheckBreached bool `json:"check_breached"`
+	// MaxAgeDays forces a new password on sign in once the current one is older, 0 turns rotation off.
+	MaxAgeDays int `json:"max_age_days"`
+	// History is how many last passwords, the current one included, can not be reused, 0 turns it off.
+	History int `json:"history"`
+}
+
+func (PasswordPolicy) TableName() string {
+	return "organization_password_policies"
+}
+
+func DefaultPasswordPolicy(organizationID uuid.UUID) *PasswordPolicy {
+	return &PasswordPolicy{OrganizationID: organizationID, MinLength: DefaultPasswordMinLength}
+}
+
+// Merge returns the strictest combination of both policies.
+func (p *PasswordPolicy) Merge(other *PasswordPolicy) *PasswordPolicy {
+	merged := *p
+
+	merged.MinLength = max(p.MinLength, other.MinLength)
+	merged.RequireUpper = p.RequireUpper || other.RequireUpper
+	merged.RequireLower = p.RequireLower || other.RequireLower
+	merged.RequireDigit = p.RequireDigit || other.RequireDigit
+	merged.RequireSymbol = p.RequireSymbol || other.RequireSymbol
+	merged.CheckBreached = p.CheckBreached || other.CheckBreached
+	merged.History = max(p.History, other.History)
+
+	if merged.MaxAgeDays == 0 || (other.MaxAgeDays > 0 && other.MaxAgeDays < merged.MaxAgeDays) {
+		merged.MaxAgeDays = other.MaxAgeDays
+	}
+
+	return &merged
+}
+
+// Expired is true when a password changed at changedAt has to be rotated.
+func (p *PasswordPolicy) Expired(changedAt time.Time) bool {
+	return p.MaxAgeDays > 0 && time.Since(changedAt) > time.Duration(p.MaxAgeDays)*24*time.Hour
+}
+
+// PasswordHistory keeps the hash of a replaced password.
+type PasswordHistory struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	ID        uuid.UUID `json:"id"`
+	AccountID uuid.UUID `json:"account_id"`
+	Hash      string    `json:"-"`
+}
+
+func (PasswordHistory) TableName() string {
+	return "account_password_history"
+}
+
+// PasswordRotation is returned instead of tokens when the password expired, the token works with the
+// reset password endpoint.
+type PasswordRotation struct {
+	ResetToken string    `json:"reset_token"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
@@ -25,3 +25,35 @@ type Permission struct {
 func (p *Permission) IsActionMatched(method string) bool {
 	return p.Action == actions[method]
 }
+
+// Route is an endpoint of the http server, protected routes need a permission of the account.
+type Route struct {
+	Method    string `json:"method"`
+	Endpoint  string `json:"endpoint"`
+	Protected bool   `json:"protected"`
+}
+
+// Action a permission needs to grant the route, methods without one are granted to root only.
+func (r Route) Action() string {
+	return actions[r.Method]
+}
+
+func (r Route) String() string {
+	return r.Method + " " + r.Endpoint
+}
+
+// PermissionCoverage compares the routes of the http server with the permissions.
+type PermissionCoverage struct {
+	// Unprotected routes are served without checking permissions.
+	Unprotected []Route `json:"unprotected"`
+	// Unmapped routes are protected but granted by no permission, only root can use them.
+	Unmapped []Route `json:"unmapped"`
+	// Orphaned permissions grant no route.
+	Orphaned []*Permission `json:"orphaned"`
+}
+
+// PermissionSync is the outcome of creating the permissions of unmapped routes.
+type PermissionSync struct {
+	Created  []*Permission `json:"created"`
+	Orphaned []*Permission `json:"orphaned"`
+}
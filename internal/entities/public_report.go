@@ -0,0 +1,24 @@
+package entities
+
+import "time"
+
+const (
+	PublicReportSession = "session"
+	PublicReportSpin    = "spin"
+)
+
+// PublicReportClaims are signed into the token of a public report link.
+type PublicReportClaims struct {
+	Kind      string `json:"k"`
+	ID        string `json:"id"`
+	Game      string `json:"g"`
+	Currency  string `json:"c,omitempty"`
+	ExpiresAt int64  `json:"e"`
+}
+
+type PublicReportLink struct {
+	Token string `json:"token"`
+	// Path of the public report, the token is in its query.
+	Path      string    `json:"path"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -0,0 +1,26 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// RecoveryCode replaces the authenticator code once, only a hash of the code is stored.
+type RecoveryCode struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	ID        uuid.UUID  `json:"id"`
+	AccountID uuid.UUID  `json:"account_id"`
+	Hash      string     `json:"-"`
+	UsedAt    *time.Time `json:"used_at"`
+}
+
+func (RecoveryCode) TableName() string {
+	return "account_recovery_codes"
+}
+
+// RecoveryCodes holds the plain codes right after they are generated, otherwise only the count of unused ones.
+type RecoveryCodes struct {
+	Codes     []string `json:"codes,omitempty"`
+	Remaining int64    `json:"remaining"`
+}
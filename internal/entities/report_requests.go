@@ -30,11 +30,20 @@ type AggregateFilters struct {
 	EndingAt     string `json:"ending_at,omitempty" form:"ending_at" validate:"custom_datetime" mapstructure:"ending_at,omitempty"`
 	IsPFR        *bool  `json:"is_pfr,omitempty"  form:"is_demo"`
 	IsDemo       *bool  `json:"is_demo,omitempty"  form:"is_demo"`
+
+	SeriesFilters `mapstructure:"-"`
 }
 
 type FinancialBase struct {
 	Currency *string `json:"currency" form:"currency" validate:"required" mapstructure:"-"`
 	FinancialFilters
+	SeriesFilters
+}
+
+// SeriesFilters turn a report into a time series bucketed by granularity in the given timezone.
+type SeriesFilters struct {
+	Granularity string `json:"granularity,omitempty" form:"granularity" mapstructure:"-"`
+	Timezone    string `json:"timezone,omitempty" form:"timezone" mapstructure:"-"`
 }
 
 type SpinPagination struct {
@@ -100,6 +109,40 @@ func (fb *FinancialBase) ToHistoryFilters(gameIDs []string) (*history.FinancialB
 	return hfb, nil
 }
 
+func (fb *FinancialBase) ToHistorySeries(gameIDs []string) (*history.FinancialSeriesIn, error) {
+	if err := fb.SeriesFilters.Validate(); err != nil {
+		return nil, err
+	}
+
+	base, err := fb.ToHistoryFilters(gameIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &history.FinancialSeriesIn{
+		Base:        base,
+		Granularity: fb.Granularity,
+		Timezone:    fb.SeriesFilters.Location().String(),
+	}, nil
+}
+
+func (af *AggregateFilters) ToHistorySeries(gameIDs []string) (*history.AggregatedSeriesIn, error) {
+	if err := af.SeriesFilters.Validate(); err != nil {
+		return nil, err
+	}
+
+	filters, err := af.ToHistoryFilters(gameIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &history.AggregatedSeriesIn{
+		Filters:     filters,
+		Granularity: af.Granularity,
+		Timezone:    af.SeriesFilters.Location().String(),
+	}, nil
+}
+
 func (af *AggregateFilters) ToHistoryFilters(gameIDs []string) (*history.GetAggregatedReportFilters, error) {
 	start, err := utils.ParseTimestampPB(af.StartingFrom)
 	if err != nil {
@@ -0,0 +1,114 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/samber/lo"
+	"strings"
+	"time"
+)
+
+const (
+	ReportTypeFinancialCSV            = "financial_csv"
+	ReportTypeFinancialXLSX           = "financial_xlsx"
+	ReportTypeSpinsCSV                = "spins_csv"
+	ReportTypeSpinsXLSX               = "spins_xlsx"
+	ReportTypeSessionsCSV             = "sessions_csv"
+	ReportTypeSessionsXLSX            = "sessions_xlsx"
+	ReportTypeAggregatedByGameCSV     = "aggregated_by_game_csv"
+	ReportTypeAggregatedByGameXLSX    = "aggregated_by_game_xlsx"
+	ReportTypeAggregatedByCountryCSV  = "aggregated_by_country_csv"
+	ReportTypeAggregatedByCountryXLSX = "aggregated_by_country_xlsx"
+)
+
+const (
+	ReportPeriodPreviousDay   = "previous_day"
+	ReportPeriodPreviousWeek  = "previous_week"
+	ReportPeriodPreviousMonth = "previous_month"
+	ReportPeriodMonthToDate   = "month_to_date"
+)
+
+var financialReportTypes = []string{
+	ReportTypeFinancialCSV, ReportTypeFinancialXLSX,
+	ReportTypeSpinsCSV, ReportTypeSpinsXLSX,
+	ReportTypeSessionsCSV, ReportTypeSessionsXLSX,
+}
+
+var aggregatedReportTypes = []string{
+	ReportTypeAggregatedByGameCSV, ReportTypeAggregatedByGameXLSX,
+	ReportTypeAggregatedByCountryCSV, ReportTypeAggregatedByCountryXLSX,
+}
+
+var reportPeriods = []string{
+	ReportPeriodPreviousDay, ReportPeriodPreviousWeek, ReportPeriodPreviousMonth, ReportPeriodMonthToDate,
+}
+
+// ReportSchedule describes a report that is generated by cron and emailed to recipients.
+// Payload holds FinancialBase for financial, spins and sessions reports and AggregateFilters for aggregated ones.
+// When Period is set the report date range is recalculated on every run in the schedule timezone.
+type ReportSchedule struct {
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	ID             uuid.UUID      `json:"id"`
+	OrganizationID uuid.UUID      `json:"organization_id"`
+	CreatedBy      uuid.UUID      `json:"created_by"`
+	Name           string         `json:"name"`
+	ReportType     string         `json:"report_type"`
+	Payload        JSON           `json:"payload" gorm:"type:jsonb" swaggertype:"object"`
+	Period         string         `json:"period"`
+	Cron           string         `json:"cron"`
+	Timezone       string         `json:"timezone"`
+	Recipients     pq.StringArray `json:"recipients" gorm:"type:varchar[]" swaggertype:"array,string"`
+	IsActive       bool           `json:"is_active"`
+
+	NextRunAt *time.Time `json:"next_run_at"`
+	LastRunAt *time.Time `json:"last_run_at"`
+	LastError string     `json:"last_error"`
+}
+
+func (ReportSchedule) TableName() string {
+	return "report_schedules"
+}
+
+func IsFinancialReportType(t string) bool {
+	return lo.Contains(financialReportTypes, t)
+}
+
+func IsAggregatedReportType(t string) bool {
+	return lo.Contains(aggregatedReportTypes, t)
+}
+
+func IsReportPeriod(period string) bool {
+	return lo.Contains(reportPeriods, period)
+}
+
+func ReportFileType(t string) FileType {
+	if strings.HasSuffix(t, string(FileXLSX)) {
+		return FileXLSX
+	}
+
+	return FileCSV
+}
+
+// ReportPeriodRange returns [from, to) for the period relative to now, now must be in the schedule location.
+func ReportPeriodRange(period string, now time.Time) (from, to time.Time) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch period {
+	case ReportPeriodPreviousDay:
+		return today.AddDate(0, 0, -1), today
+	case ReportPeriodPreviousWeek:
+		monday := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+
+		return monday.AddDate(0, 0, -7), monday
+	case ReportPeriodPreviousMonth:
+		first := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+
+		return first.AddDate(0, -1, 0), first
+	case ReportPeriodMonthToDate:
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()), now
+	}
+
+	return now, now
+}
@@ -0,0 +1,98 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// ReportScope limits the spins a role sees in reports to games, integrators, operators, countries and
+// currencies, an empty list does not limit its dimension. Games are game ids.
+type ReportScope struct {
+	Games       []string `json:"games,omitempty"`
+	Integrators []string `json:"integrators,omitempty"`
+	Operators   []string `json:"operators,omitempty"`
+	Countries   []string `json:"countries,omitempty"`
+	Currencies  []string `json:"currencies,omitempty"`
+}
+
+func (s ReportScope) Value() (driver.Value, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+func (s *ReportScope) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*s = ReportScope{}
+
+		return nil
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	default:
+		return errors.New("unsupported report scope value type")
+	}
+}
+
+func (s *ReportScope) Empty() bool {
+	return s == nil || len(s.Games)+len(s.Integrators)+len(s.Operators)+len(s.Countries)+len(s.Currencies) == 0
+}
+
+// ReportAccess is what a session sees in reports: spins of the games of its organization within its scope.
+type ReportAccess struct {
+	OrganizationID *uuid.UUID
+	// Scope is nil when reports are not limited.
+	Scope *ReportScope
+}
+
+func (s *Session) ReportAccess() *ReportAccess {
+	return &ReportAccess{OrganizationID: &s.OrganizationID, Scope: s.Account.ReportScope()}
+}
+
+// ReportScope of the account combines the scopes of its roles, roles add up so a dimension is limited only
+// when every role limits it. Root accounts are not limited.
+func (a *Account) ReportScope() *ReportScope {
+	if len(a.Roles) == 0 || a.IsRoot() {
+		return nil
+	}
+
+	scope := &ReportScope{}
+	dimensions := []func(s *ReportScope) *[]string{
+		func(s *ReportScope) *[]string { return &s.Games },
+		func(s *ReportScope) *[]string { return &s.Integrators },
+		func(s *ReportScope) *[]string { return &s.Operators },
+		func(s *ReportScope) *[]string { return &s.Countries },
+		func(s *ReportScope) *[]string { return &s.Currencies },
+	}
+
+	for _, dimension := range dimensions {
+		var values []string
+
+		for _, role := range a.Roles {
+			if role.ReportScope == nil || len(*dimension(role.ReportScope)) == 0 {
+				values = nil
+
+				break
+			}
+
+			values = append(values, *dimension(role.ReportScope)...)
+		}
+
+		*dimension(scope) = lo.Uniq(values)
+	}
+
+	if scope.Empty() {
+		return nil
+	}
+
+	return scope
+}
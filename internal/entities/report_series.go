@@ -0,0 +1,93 @@
+package entities
+
+import (
+	e "backoffice/internal/errors"
+	"backoffice/pkg/history"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const (
+	GranularityHour  = "hour"
+	GranularityDay   = "day"
+	GranularityWeek  = "week"
+	GranularityMonth = "month"
+)
+
+var granularities = []string{GranularityHour, GranularityDay, GranularityWeek, GranularityMonth}
+
+func IsGranularity(granularity string) bool {
+	return lo.Contains(granularities, granularity)
+}
+
+func (f SeriesFilters) IsSeries() bool {
+	return f.Granularity != ""
+}
+
+func (f SeriesFilters) Validate() error {
+	if !IsGranularity(f.Granularity) {
+		return e.ErrValidationFailed("granularity")
+	}
+
+	if _, err := time.LoadLocation(f.Timezone); err != nil {
+		return e.ErrValidationFailed("timezone")
+	}
+
+	return nil
+}
+
+// Location is the timezone buckets are aligned to, UTC when empty or unknown.
+func (f SeriesFilters) Location() *time.Location {
+	loc, err := time.LoadLocation(f.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+type ReportSeriesItem struct {
+	Bucket time.Time `json:"bucket" csv:"bucket" xlsx:"Bucket"`
+
+	Wager           float64 `json:"wager" csv:"wager" xlsx:"Wager"`
+	Award           float64 `json:"award" csv:"award" xlsx:"Award"`
+	WagerWithoutPFR float64 `json:"wager_without_pfr" csv:"wager_without_pfr" xlsx:"Wager without PFR"`
+	AwardWithoutPFR float64 `json:"award_without_pfr" csv:"award_without_pfr" xlsx:"Award without PFR"`
+
+	SpinQuantity int `json:"spin_quantity" csv:"spin_quantity" xlsx:"Spin Quantity"`
+	UserQuantity int `json:"user_quantity" csv:"user_quantity" xlsx:"Unique Users"`
+
+	// computed
+	Revenue float64 `json:"revenue" csv:"revenue" xlsx:"Revenue"`
+	RTP     float64 `json:"rtp" csv:"rtp" xlsx:"RTP"`
+}
+
+func ReportSeriesItemFromHistory(out *history.SeriesItem, loc *time.Location) *ReportSeriesItem {
+	return &ReportSeriesItem{
+		Bucket: out.Bucket.AsTime().In(loc),
+
+		Wager:           float64(out.Wager),
+		Award:           float64(out.Award),
+		WagerWithoutPFR: float64(out.WagerWithoutPfr),
+		AwardWithoutPFR: float64(out.AwardWithoutPfr),
+
+		SpinQuantity: int(out.SpinQuantity),
+		UserQuantity: int(out.UserQuantity),
+	}
+}
+
+func (r *ReportSeriesItem) Prettify() *ReportSeriesItem {
+	if r.Wager != 0 {
+		r.RTP = r.Award / r.Wager
+	}
+
+	r.Revenue = prettifyPrecision(r.WagerWithoutPFR - r.Award)
+
+	r.Wager = prettifyPrecision(r.Wager)
+	r.Award = prettifyPrecision(r.Award)
+	r.WagerWithoutPFR = prettifyPrecision(r.WagerWithoutPFR)
+	r.AwardWithoutPFR = prettifyPrecision(r.AwardWithoutPFR)
+
+	return r
+}
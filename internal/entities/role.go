@@ -15,4 +15,5 @@ type Role struct {
 	Description    string        `json:"description"`
 	Type           string        `json:"type"`
 	Permissions    []*Permission `json:"permissions" gorm:"many2many:role_permissions;foreignKey:id;joinForeignKey:role_id;joinReferences:permission_id;references:id"`
+	ReportScope    *ReportScope  `json:"report_scope,omitempty" gorm:"type:jsonb"`
 }
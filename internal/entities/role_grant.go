@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	RoleGrantPending  = "pending"
+	RoleGrantActive   = "active"
+	RoleGrantExpired  = "expired"
+	RoleGrantRejected = "rejected"
+	RoleGrantRevoked  = "revoked"
+)
+
+// RoleGrant is the history of a role assignment. Only active grants are in account_roles, a pending grant
+// waits for a second administrator to approve it.
+type RoleGrant struct {
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	ID             uuid.UUID  `json:"id"`
+	AccountID      uuid.UUID  `json:"account_id"`
+	RoleID         uuid.UUID  `json:"role_id"`
+	OrganizationID *uuid.UUID `json:"organization_id"`
+	Status         string     `json:"status"`
+	// ExpiresAt revokes the role when it passes, grants without it are permanent.
+	ExpiresAt *time.Time `json:"expires_at"`
+	// RequestedBy is empty for roles assigned by the system, e.g. on invites and single sign-on.
+	RequestedBy *uuid.UUID `json:"requested_by"`
+	DecidedBy   *uuid.UUID `json:"decided_by"`
+	DecidedAt   *time.Time `json:"decided_at"`
+	EndedAt     *time.Time `json:"ended_at"`
+}
+
+func (g *RoleGrant) IsPending() bool {
+	return g.Status == RoleGrantPending
+}
+
+func (g *RoleGrant) Expired(now time.Time) bool {
+	return g.ExpiresAt != nil && !g.ExpiresAt.After(now)
+}
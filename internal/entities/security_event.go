@@ -0,0 +1,47 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	SecurityEventLoginFailed     = "login_failed"
+	SecurityEventTOTPFailed      = "totp_failed"
+	SecurityEventWebAuthnFailed  = "webauthn_failed"
+	SecurityEventLockedOut       = "locked_out"
+	SecurityEventAccountLocked   = "account_locked"
+	SecurityEventAccountUnlocked = "account_unlocked"
+
+	SecurityEventImpersonationStarted = "impersonation_started"
+	SecurityEventImpersonationEnded   = "impersonation_ended"
+)
+
+// SecurityEvent is a failed or blocked authentication attempt, a change of the lock state of an account or
+// an administrator signing in as the account.
+type SecurityEvent struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	ID   uuid.UUID `json:"id"`
+	Type string    `json:"type"`
+	// Login is the identifier the attempt was made with, the account may not exist.
+	Login          string     `json:"login"`
+	AccountID      *uuid.UUID `json:"account_id"`
+	OrganizationID *uuid.UUID `json:"organization_id"`
+	// ActorID is the administrator behind an unlock or an impersonation.
+	ActorID   *uuid.UUID `json:"actor_id"`
+	IP        string     `json:"ip"`
+	UserAgent string     `json:"user_agent"`
+	// Failures is the count of recent failures of the login when the event was recorded.
+	Failures int64 `json:"failures"`
+}
+
+type SecurityEventFilters struct {
+	Type           string
+	Login          string
+	AccountID      *uuid.UUID
+	OrganizationID *uuid.UUID
+	IP             string
+	From           *time.Time
+	To             *time.Time
+}
@@ -3,6 +3,7 @@ package entities
 import (
 	"encoding/json"
 	"github.com/google/uuid"
+	"time"
 )
 
 type Session struct {
@@ -10,6 +11,38 @@ type Session struct {
 	Account        *Account  `json:"account"`
 	OrganizationID uuid.UUID `json:"organization_id"`
 	Currency       string    `json:"currency"`
+
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	Device
+
+	Impersonator *Impersonator `json:"impersonator,omitempty"`
+}
+
+// Impersonator is the administrator signed in as the account of an impersonated session.
+type Impersonator struct {
+	ID        uuid.UUID `json:"id"`
+	Login     string    `json:"login"`
+	SessionID uuid.UUID `json:"session_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Device is the client a session is used from, the address is the last one seen.
+type Device struct {
+	IP        string `json:"ip"`
+	UserAgent string `json:"user_agent"`
+}
+
+// SessionInfo is a session as listed to its owner or an administrator.
+type SessionInfo struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	CreatedAt      time.Time `json:"created_at"`
+	LastUsedAt     time.Time `json:"last_used_at"`
+	IP             string    `json:"ip"`
+	UserAgent      string    `json:"user_agent"`
+	Current        bool      `json:"current"`
+	Impersonated   bool      `json:"impersonated"`
 }
 
 func (s *Session) MarshalBinary() (data []byte, err error) {
@@ -26,3 +59,20 @@ func (s *Session) CleanUP() *Session {
 
 	return s
 }
+
+func (s *Session) Info(currentID uuid.UUID) *SessionInfo {
+	return &SessionInfo{
+		ID:             s.ID,
+		OrganizationID: s.OrganizationID,
+		CreatedAt:      s.CreatedAt,
+		LastUsedAt:     s.LastUsedAt,
+		IP:             s.IP,
+		UserAgent:      s.UserAgent,
+		Current:        s.ID == currentID,
+		Impersonated:   s.Impersonated(),
+	}
+}
+
+func (s *Session) Impersonated() bool {
+	return s.Impersonator != nil
+}
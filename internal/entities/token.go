@@ -12,4 +12,6 @@ type Token struct {
 	AccessToken  string
 	RefreshToken string
 	ExpiredAt    time.Time
+	IP           string
+	UserAgent    string
 }
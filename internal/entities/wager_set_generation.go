@@ -0,0 +1,27 @@
+package entities
+
+import "github.com/google/uuid"
+
+// WagerSetGeneration is the preview of the wager sets generated from a base wager set for the currencies
+// of a provider and integrator pair. Checksum identifies the preview, only the approved preview is saved.
+type WagerSetGeneration struct {
+	BaseWagerSetID     uuid.UUID            `json:"base_wager_set_id"`
+	OrganizationPairID uuid.UUID            `json:"organization_pair_id"`
+	IntegratorID       uuid.UUID            `json:"integrator_id"`
+	GameIDs            []uuid.UUID          `json:"game_ids"`
+	WagerSets          []*GeneratedWagerSet `json:"wager_sets"`
+	Checksum           string               `json:"checksum"`
+	Saved              bool                 `json:"saved"`
+}
+
+// GeneratedWagerSet is the wager set of a currency, Dropped are the scaled wagers the wager constraints of
+// the games do not allow. A currency with errors gets no wager set.
+type GeneratedWagerSet struct {
+	Currency     string    `json:"currency"`
+	Multiplier   int64     `json:"multiplier"`
+	WagerLevels  []int64   `json:"wager_levels"`
+	DefaultWager int64     `json:"default_wager"`
+	Dropped      []int64   `json:"dropped,omitempty"`
+	Errors       []string  `json:"errors,omitempty"`
+	WagerSet     *WagerSet `json:"wager_set,omitempty"`
+}
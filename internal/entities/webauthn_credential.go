@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"backoffice/pkg/webauthn"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	SecondFactorTOTP     = "totp"
+	SecondFactorWebAuthn = "webauthn"
+)
+
+// WebAuthnCredential is a security key or passkey registered as a second factor of the account.
+type WebAuthnCredential struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	ID           uuid.UUID      `json:"id"`
+	AccountID    uuid.UUID      `json:"account_id"`
+	Name         string         `json:"name"`
+	CredentialID []byte         `json:"-"`
+	PublicKey    []byte         `json:"-"`
+	SignCount    int64          `json:"-"`
+	AAGUID       []byte         `json:"-" gorm:"column:aaguid"`
+	Transports   pq.StringArray `json:"transports" gorm:"type:varchar[]" swaggertype:"array,string"`
+	LastUsedAt   *time.Time     `json:"last_used_at"`
+}
+
+func (WebAuthnCredential) TableName() string {
+	return "account_webauthn_credentials"
+}
+
+func (c *WebAuthnCredential) Descriptor() webauthn.Descriptor {
+	return webauthn.Descriptor{Type: "public-key", ID: c.CredentialID, Transports: c.Transports}
+}
+
+func (c *WebAuthnCredential) Credential() *webauthn.Credential {
+	return &webauthn.Credential{
+		ID:         c.CredentialID,
+		PublicKey:  c.PublicKey,
+		SignCount:  uint32(c.SignCount),
+		AAGUID:     c.AAGUID,
+		Transports: c.Transports,
+	}
+}
+
+// SecondFactorChallenge tells the client which second factors the account accepts, the webauthn options
+// are set when the account has security keys.
+type SecondFactorChallenge struct {
+	Methods  []string                 `json:"methods"`
+	WebAuthn *webauthn.RequestOptions `json:"webauthn,omitempty"`
+}
+
+// WebAuthnRegistration holds the registered security key, recovery codes are set when it is the first
+// second factor of the account.
+type WebAuthnRegistration struct {
+	Credential    *WebAuthnCredential `json:"credential"`
+	RecoveryCodes *RecoveryCodes      `json:"recovery_codes,omitempty"`
+}
@@ -4,6 +4,7 @@ import (
 	"backoffice/internal/entities"
 	"context"
 	"github.com/google/uuid"
+	"time"
 )
 
 type AccountRepository interface {
@@ -15,4 +16,8 @@ type AccountRepository interface {
 	Save(ctx context.Context, account *entities.Account) (*entities.Account, error)
 	Update(ctx context.Context, account *entities.Account) (*entities.Account, error)
 	DisableTOTP(ctx context.Context, account *entities.Account) (*entities.Account, error)
+	SetWebAuthn(ctx context.Context, account *entities.Account, enabled bool) (*entities.Account, error)
+	// SetPassword replaces the password and clears the reset token.
+	SetPassword(ctx context.Context, account *entities.Account, passwordHash string, changedAt time.Time) (*entities.Account, error)
+	Activate(ctx context.Context, account *entities.Account, passwordHash string, changedAt time.Time) (*entities.Account, error)
 }
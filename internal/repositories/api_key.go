@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"backoffice/internal/entities"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type APIKeyRepository interface {
+	BaseRepository[entities.APIKey]
+	// FindByPrefix loads the key with its role permissions for request authentication.
+	FindByPrefix(ctx context.Context, prefix string) (*entities.APIKey, error)
+	Touch(ctx context.Context, id uuid.UUID, at time.Time) error
+}
@@ -0,0 +1,11 @@
+package repositories
+
+import (
+	"backoffice/internal/entities"
+	"context"
+)
+
+type AuditRepository interface {
+	Create(ctx context.Context, log *entities.AuditLog) error
+	Paginate(ctx context.Context, filters *entities.AuditFilters, limit int, page int) (pagination entities.Pagination[entities.AuditLog], err error)
+}
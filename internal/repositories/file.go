@@ -14,3 +14,10 @@ type FileRepository interface {
 	Update(ctx context.Context, organizationID uuid.UUID, file *entities.File, expiration time.Duration) error
 	Find(ctx context.Context, organizationID uuid.UUID) ([]entities.File, error)
 }
+
+// FileEventRepository is the export event bus, events are scoped to the session that requested the export.
+type FileEventRepository interface {
+	Publish(ctx context.Context, sessionID uuid.UUID, event *entities.FileEvent) error
+	// Subscribe delivers events until ctx is done, then closes the channel.
+	Subscribe(ctx context.Context, sessionID uuid.UUID) (<-chan *entities.FileEvent, error)
+}
@@ -22,4 +22,5 @@ type GameRepository interface {
 	GetIntegratorGame(ctx context.Context, organizationID uuid.UUID, gameName string) (game *entities.Game, err error)
 	GetAvailableWagerSetsByIDs(ctx context.Context, game *entities.Game) (wagerSets []entities.WagerSet, err error)
 	GetWagerSetByID(ctx context.Context, id uuid.UUID) (*entities.WagerSet, error)
+	SaveCatalog(ctx context.Context, created []*entities.Game, updated map[uuid.UUID]map[string]interface{}) error
 }
@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"backoffice/internal/entities"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type GameVersionRepository interface {
+	BaseRepository[entities.GameVersion]
+	// History lists the versions of the game oldest first, those of the integrator when integratorID is set.
+	History(ctx context.Context, gameID uuid.UUID, integratorID *uuid.UUID) ([]*entities.GameVersion, error)
+	// Transaction runs fn in a transaction, changes of games and integrator games made with its context
+	// are committed together with their versions.
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+	// Lock locks the games until the transaction of ctx ends, so their versions are numbered one change at a time.
+	Lock(ctx context.Context, gameIDs ...uuid.UUID) error
+}
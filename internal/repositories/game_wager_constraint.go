@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"backoffice/internal/entities"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type GameWagerConstraintRepository interface {
+	BaseRepository[entities.GameWagerConstraint]
+	// Replace swaps the constraints of the game for the given ones.
+	Replace(ctx context.Context, gameID uuid.UUID, constraints []*entities.GameWagerConstraint) error
+	// Usages lists where games use wager sets, only of the game and only of the wager set when they are set.
+	Usages(ctx context.Context, gameID, wagerSetID *uuid.UUID) ([]*entities.WagerSetUsage, error)
+}
@@ -11,6 +11,7 @@ type OrganizationRepository interface {
 	Paginate(ctx context.Context, filters map[string]interface{}, order string, limit int, offset int) (organization []*entities.Organization, total int64, err error)
 	Create(ctx context.Context, organization *entities.Organization) (*entities.Organization, error)
 	Update(ctx context.Context, organization *entities.Organization) (*entities.Organization, error)
+	UpdateUnsignedPublicReports(ctx context.Context, organization *entities.Organization, allowed bool) (*entities.Organization, error)
 	Delete(ctx context.Context, accountID uuid.UUID, organization *entities.Organization) error
 	Get(ctx context.Context, params map[string]interface{}) (organization *entities.Organization, err error)
 	Assign(ctx context.Context, account *entities.Account, organization *entities.Organization) error
@@ -24,6 +25,9 @@ type OrganizationRepository interface {
 	AssignGames(ctx context.Context, integratorID uuid.UUID, wagerSetID uuid.UUID, gameIDs []uuid.UUID) error
 	RevokeGames(ctx context.Context, integratorID uuid.UUID, gameIDs []uuid.UUID) error
 	GetIntegratorGameList(ctx context.Context, integratorID uuid.UUID) (ig []*entities.IntegratorGame, err error)
+	GetIntegratorGame(ctx context.Context, integratorID, gameID uuid.UUID) (ig *entities.IntegratorGame, err error)
+	// RestoreIntegratorGame writes every setting of the integrator game, empty ones included.
+	RestoreIntegratorGame(ctx context.Context, ig *entities.IntegratorGame) error
 	UpdateIntegratorGame(ctx context.Context, integratorID, gameID, wagerSetID uuid.UUID, rtp *int64, volatility *string, shortLink bool) (ig *entities.IntegratorGame, err error)
 	GetIntegratorGameSettings(ctx context.Context, integratorID, gameID uuid.UUID, currency string) (ig *entities.IntegratorGame, err error)
 	GetOperatorPair(ctx context.Context, integratorID, operatorID uuid.UUID) (*entities.IntegratorOperatorPair, error)
@@ -37,5 +41,6 @@ type OrganizationRepository interface {
 	GetIntegratorGameWagerSetList(ctx context.Context, integratorID uuid.UUID) (igws []*entities.IntegratorGameWagerSet, err error)
 	CreateIntegratorGameWagerSet(ctx context.Context, integratorID uuid.UUID, wagerSetID uuid.UUID, currency string, gameID uuid.UUID) error
 	UpdateIntegratorGameWagerSet(ctx context.Context, integratorID, gameID, wagerSetID uuid.UUID, currency string, newCurrency string) (igws *entities.IntegratorGameWagerSet, err error)
+	ReplaceIntegratorGameWagerSets(ctx context.Context, wagerSets []*entities.WagerSet, igws []*entities.IntegratorGameWagerSet) error
 	DeleteGameWagerSet(ctx context.Context, integratorID uuid.UUID, wagerSetID uuid.UUID, currency string, gameID uuid.UUID) error
 }
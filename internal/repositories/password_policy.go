@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"backoffice/internal/entities"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type PasswordHistoryRepository interface {
+	// Recent returns the last limit replaced passwords of the account, newest first.
+	Recent(ctx context.Context, accountID uuid.UUID, limit int) ([]*entities.PasswordHistory, error)
+	// Add stores the replaced password and drops all but the newest keep entries of the account.
+	Add(ctx context.Context, entry *entities.PasswordHistory, keep int) error
+}
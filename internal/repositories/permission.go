@@ -15,6 +15,7 @@ type PermissionRepository interface {
 	Paginate(ctx context.Context, filters map[string]interface{}, order string, limit int, offset int) (permissions []*entities.Permission, total int64, err error)
 	RevokeRolePermissions(ctx context.Context, role *entities.Role, permissions ...*entities.Permission) error
 	AssignRolePermissions(ctx context.Context, role *entities.Role, permissions []*entities.Permission) error
+	RefreshAdminPermissions(ctx context.Context) error
 	//AssignAccountPermissions(ctx context.Context, account *entities.Account, permissions []*entities.Permission) error
 	//RevokeAccountPermissions(ctx context.Context, account *entities.Account, permissions ...*entities.Permission) error
 }
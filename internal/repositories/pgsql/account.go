@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"time"
 )
 
 type accountRepository struct {
@@ -115,3 +116,41 @@ func (r *accountRepository) DisableTOTP(ctx context.Context, account *entities.A
 
 	return r.FindBy(ctx, map[string]interface{}{"id": account.ID})
 }
+
+func (r *accountRepository) SetWebAuthn(ctx context.Context, account *entities.Account, enabled bool) (*entities.Account, error) {
+	if err := r.conn.Omit(clause.Associations).WithContext(ctx).Model(&account).Updates(map[string]interface{}{
+		"webauthn_enabled": enabled,
+	}).Error; err != nil {
+		return nil, err
+	}
+
+	return r.FindBy(ctx, map[string]interface{}{"id": account.ID})
+}
+
+func (r *accountRepository) SetPassword(ctx context.Context, account *entities.Account, passwordHash string, changedAt time.Time) (*entities.Account, error) {
+	if err := r.conn.Omit(clause.Associations).WithContext(ctx).Model(&account).Updates(map[string]interface{}{
+		"auth_provider_token":       passwordHash,
+		"password_changed_at":       changedAt,
+		"reset_password_token":      "",
+		"reset_password_expires_at": nil,
+	}).Error; err != nil {
+		return nil, err
+	}
+
+	return r.FindBy(ctx, map[string]interface{}{"id": account.ID})
+}
+
+// Activate sets the password of a pending account, makes it active and clears its invite token.
+func (r *accountRepository) Activate(ctx context.Context, account *entities.Account, passwordHash string, changedAt time.Time) (*entities.Account, error) {
+	if err := r.conn.Omit(clause.Associations).WithContext(ctx).Model(&account).Updates(map[string]interface{}{
+		"auth_provider_token":       passwordHash,
+		"password_changed_at":       changedAt,
+		"status":                    entities.AccountStatusActive,
+		"reset_password_token":      "",
+		"reset_password_expires_at": nil,
+	}).Error; err != nil {
+		return nil, err
+	}
+
+	return r.FindBy(ctx, map[string]interface{}{"id": account.ID})
+}
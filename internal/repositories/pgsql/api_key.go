@@ -0,0 +1,38 @@
+package pgsql
+
+import (
+	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type apiKeyRepository struct {
+	BaseRepository[entities.APIKey]
+}
+
+func NewAPIKeyRepository(conn *gorm.DB) *apiKeyRepository {
+	return &apiKeyRepository{
+		BaseRepository: BaseRepository[entities.APIKey]{conn: conn},
+	}
+}
+
+func (r *apiKeyRepository) FindByPrefix(ctx context.Context, prefix string) (key *entities.APIKey, err error) {
+	if err = r.conn.WithContext(ctx).Where("prefix = ?", prefix).Preload("Role.Permissions").First(&key).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, e.ErrEntityNotFound
+		}
+
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func (r *apiKeyRepository) Touch(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return r.conn.WithContext(ctx).Model(&entities.APIKey{}).Where("id = ?", id).UpdateColumn("last_used_at", at).Error
+}
@@ -0,0 +1,75 @@
+package pgsql
+
+import (
+	"backoffice/internal/entities"
+	"context"
+	"gorm.io/gorm"
+)
+
+type auditRepository struct {
+	conn *gorm.DB
+}
+
+func NewAuditRepository(conn *gorm.DB) *auditRepository {
+	return &auditRepository{
+		conn: conn,
+	}
+}
+
+func (r *auditRepository) Create(ctx context.Context, log *entities.AuditLog) error {
+	return r.conn.WithContext(ctx).Create(&log).Error
+}
+
+func (r *auditRepository) Paginate(ctx context.Context, filters *entities.AuditFilters, limit int, page int) (
+	pagination entities.Pagination[entities.AuditLog], err error) {
+	query := r.conn.WithContext(ctx).Model(&entities.AuditLog{})
+
+	if filters.AccountID != nil {
+		query = query.Where("account_id = ?", *filters.AccountID)
+	}
+
+	if filters.OrganizationID != nil {
+		query = query.Where("organization_id = ?", *filters.OrganizationID)
+	}
+
+	if filters.Endpoint != "" {
+		query = query.Where("endpoint = ?", filters.Endpoint)
+	}
+
+	if filters.Method != "" {
+		query = query.Where("method = ?", filters.Method)
+	}
+
+	if filters.EntityID != "" {
+		query = query.Where("entity_id = ?", filters.EntityID)
+	}
+
+	if filters.ImpersonatorID != nil {
+		query = query.Where("impersonator_id = ?", *filters.ImpersonatorID)
+	}
+
+	if filters.From != nil {
+		query = query.Where("created_at >= ?", *filters.From)
+	}
+
+	if filters.To != nil {
+		query = query.Where("created_at < ?", *filters.To)
+	}
+
+	var total int64
+	if err = query.Count(&total).Error; err != nil {
+		return
+	}
+
+	items := make([]*entities.AuditLog, 0)
+	if err = query.Order("created_at desc").Limit(limit).Offset(limit * (page - 1)).Find(&items).Error; err != nil {
+		return
+	}
+
+	pagination.Total = int(total)
+	pagination.Limit = limit
+	pagination.CurrentPage = page
+	pagination.Items = items
+
+	return
+}
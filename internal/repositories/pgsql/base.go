@@ -24,7 +24,7 @@ func (r *BaseRepository[T]) DB() *gorm.DB {
 }
 
 func (r *BaseRepository[T]) Find(ctx context.Context, conditions map[string]interface{}) (data []*T, err error) {
-	query := r.conn.WithContext(ctx).Where(conditions)
+	query := withContext(ctx, r.conn).Where(conditions)
 
 	err = query.Find(&data).Error
 
@@ -32,7 +32,7 @@ func (r *BaseRepository[T]) Find(ctx context.Context, conditions map[string]inte
 }
 
 func (r *BaseRepository[T]) FindLimit(ctx context.Context, conditions map[string]interface{}, limit, offset int) (data []*T, total int64, err error) {
-	query := r.conn.WithContext(ctx).Where(conditions)
+	query := withContext(ctx, r.conn).Where(conditions)
 
 	query.Count(&total)
 
@@ -42,7 +42,7 @@ func (r *BaseRepository[T]) FindLimit(ctx context.Context, conditions map[string
 }
 
 func (r *BaseRepository[T]) FindBy(ctx context.Context, params map[string]interface{}) (entity *T, err error) {
-	err = r.conn.WithContext(ctx).Where(params).First(&entity).Error
+	err = withContext(ctx, r.conn).Where(params).First(&entity).Error
 
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, e.ErrEntityNotFound
@@ -52,7 +52,7 @@ func (r *BaseRepository[T]) FindBy(ctx context.Context, params map[string]interf
 }
 
 func (r *BaseRepository[T]) FindByWith(ctx context.Context, params map[string]interface{}, references ...string) (entity *T, err error) {
-	query := r.conn.WithContext(ctx).Where(params)
+	query := withContext(ctx, r.conn).Where(params)
 
 	for _, reference := range references {
 		query = query.Preload(reference)
@@ -65,7 +65,7 @@ func (r *BaseRepository[T]) FindByWith(ctx context.Context, params map[string]in
 
 func (r *BaseRepository[T]) Create(ctx context.Context, entity *T) (*T, error) {
 	// TODO: add returning
-	if err := r.conn.WithContext(ctx).Create(entity).Error; err != nil {
+	if err := withContext(ctx, r.conn).Create(entity).Error; err != nil {
 		return nil, err
 	}
 
@@ -73,15 +73,15 @@ func (r *BaseRepository[T]) Create(ctx context.Context, entity *T) (*T, error) {
 }
 
 func (r *BaseRepository[T]) CreateNoReturn(ctx context.Context, entity *T) error {
-	return r.conn.WithContext(ctx).Create(&entity).Error
+	return withContext(ctx, r.conn).Create(&entity).Error
 }
 
 func (r *BaseRepository[T]) Delete(ctx context.Context, entity *T, conditions ...interface{}) error {
-	return r.conn.WithContext(ctx).Model(&entity).Delete(&entity, conditions...).Error
+	return withContext(ctx, r.conn).Model(&entity).Delete(&entity, conditions...).Error
 }
 
 func (r *BaseRepository[T]) Save(ctx context.Context, entity *T) (*T, error) {
-	if err := r.conn.WithContext(ctx).Save(&entity).Error; err != nil {
+	if err := withContext(ctx, r.conn).Save(&entity).Error; err != nil {
 		return nil, err
 	}
 
@@ -89,7 +89,7 @@ func (r *BaseRepository[T]) Save(ctx context.Context, entity *T) (*T, error) {
 }
 
 func (r *BaseRepository[T]) Update(ctx context.Context, entity *T, values interface{}, conditions map[string]interface{}) (*T, error) {
-	if err := r.conn.WithContext(ctx).Model(&entity).Where(conditions).Updates(values).Error; err != nil {
+	if err := withContext(ctx, r.conn).Model(&entity).Where(conditions).Updates(values).Error; err != nil {
 		return nil, err
 	}
 
@@ -97,7 +97,7 @@ func (r *BaseRepository[T]) Update(ctx context.Context, entity *T, values interf
 }
 
 func (r *BaseRepository[T]) Paginate(ctx context.Context, filters map[string]interface{}, order string, limit int, page int) (pagination entities.Pagination[T], err error) {
-	conn := r.conn.WithContext(ctx).Where(filters).Order(order)
+	conn := withContext(ctx, r.conn).Where(filters).Order(order)
 
 	items := make([]*T, 0)
 
@@ -20,7 +20,7 @@ func NewGameRepository(conn *gorm.DB) *gameRepository {
 }
 
 func (r *gameRepository) GetBy(ctx context.Context, condition map[string]interface{}) (game *entities.Game, err error) {
-	if err = r.conn.WithContext(ctx).Preload("Organization").Where(condition).First(&game).Error; err != nil {
+	if err = withContext(ctx, r.conn).Preload("Organization").Where(condition).First(&game).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, e.ErrEntityNotFound
 		}
@@ -167,7 +167,7 @@ func (r *gameRepository) GetIntegratorGameList(ctx context.Context, organization
 }
 
 func (r *gameRepository) Update(ctx context.Context, gameID uuid.UUID, condition map[string]interface{}) (*entities.Game, error) {
-	if err := r.conn.WithContext(ctx).Model(&entities.Game{}).Where("id = ?", gameID).Updates(condition).Error; err != nil {
+	if err := withContext(ctx, r.conn).Model(&entities.Game{}).Where("id = ?", gameID).Updates(condition).Error; err != nil {
 		return nil, err
 	}
 
@@ -251,3 +251,21 @@ func (r *gameRepository) GetWagerSetByID(ctx context.Context, id uuid.UUID) (*en
 	}
 	return &wagerSet, nil
 }
+
+func (r *gameRepository) SaveCatalog(ctx context.Context, created []*entities.Game, updated map[uuid.UUID]map[string]interface{}) error {
+	return withContext(ctx, r.conn).Transaction(func(tx *gorm.DB) error {
+		if len(created) > 0 {
+			if err := tx.Create(created).Error; err != nil {
+				return err
+			}
+		}
+
+		for gameID, columns := range updated {
+			if err := tx.Model(&entities.Game{}).Where("id = ?", gameID).Updates(columns).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
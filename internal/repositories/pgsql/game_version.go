@@ -0,0 +1,46 @@
+package pgsql
+
+import (
+	"backoffice/internal/entities"
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/google/uuid"
+)
+
+type gameVersionRepository struct {
+	BaseRepository[entities.GameVersion]
+}
+
+func NewGameVersionRepository(conn *gorm.DB) *gameVersionRepository {
+	return &gameVersionRepository{
+		BaseRepository: BaseRepository[entities.GameVersion]{conn: conn},
+	}
+}
+
+func (r *gameVersionRepository) History(ctx context.Context, gameID uuid.UUID, integratorID *uuid.UUID) (versions []*entities.GameVersion, err error) {
+	query := withContext(ctx, r.conn).Where("game_id = ?", gameID)
+
+	if integratorID != nil {
+		query = query.Where("integrator_id = ?", integratorID)
+	} else {
+		query = query.Where("integrator_id is null")
+	}
+
+	err = query.Order("version").Find(&versions).Error
+
+	return
+}
+
+func (r *gameVersionRepository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return transaction(ctx, r.conn, fn)
+}
+
+func (r *gameVersionRepository) Lock(ctx context.Context, gameIDs ...uuid.UUID) error {
+	var locked []uuid.UUID
+
+	// rows are locked in the same order by every change, so changes of several games do not deadlock
+	return withContext(ctx, r.conn).Model(&entities.Game{}).Where("id in ?", gameIDs).Order("id").
+		Clauses(clause.Locking{Strength: "UPDATE"}).Pluck("id", &locked).Error
+}
@@ -0,0 +1,59 @@
+package pgsql
+
+import (
+	"backoffice/internal/entities"
+	"context"
+	"gorm.io/gorm"
+
+	"github.com/google/uuid"
+)
+
+type gameWagerConstraintRepository struct {
+	BaseRepository[entities.GameWagerConstraint]
+}
+
+func NewGameWagerConstraintRepository(conn *gorm.DB) *gameWagerConstraintRepository {
+	return &gameWagerConstraintRepository{
+		BaseRepository: BaseRepository[entities.GameWagerConstraint]{conn: conn},
+	}
+}
+
+func (r *gameWagerConstraintRepository) Replace(ctx context.Context, gameID uuid.UUID, constraints []*entities.GameWagerConstraint) error {
+	return r.conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("game_id = ?", gameID).Delete(&entities.GameWagerConstraint{}).Error; err != nil {
+			return err
+		}
+
+		if len(constraints) == 0 {
+			return nil
+		}
+
+		return tx.Create(constraints).Error
+	})
+}
+
+func (r *gameWagerConstraintRepository) Usages(ctx context.Context, gameID, wagerSetID *uuid.UUID) (usages []*entities.WagerSetUsage, err error) {
+	all := r.conn.Raw(`
+		select id as game_id, wager_set_id, null::uuid as organization_id, '' as currency from games
+		union
+		select id, unnest(available_wager_sets_id), null, '' from games
+		union
+		select game_id, wager_set_id, organization_id, '' from integrator_games
+		union
+		select game_id, wager_set_id, organization_id, currency from integrator_game_wager_sets
+	`)
+
+	query := r.conn.WithContext(ctx).Table("(?) as usages", all).Where("wager_set_id <> ?", uuid.Nil)
+
+	if gameID != nil {
+		query = query.Where("game_id = ?", *gameID)
+	}
+
+	if wagerSetID != nil {
+		query = query.Where("wager_set_id = ?", *wagerSetID)
+	}
+
+	err = query.Scan(&usages).Error
+
+	return usages, err
+}
@@ -59,6 +59,15 @@ func (r *organizationRepository) Update(ctx context.Context, organization *entit
 	return r.Get(ctx, map[string]interface{}{"id": organization.ID})
 }
 
+func (r *organizationRepository) UpdateUnsignedPublicReports(ctx context.Context, organization *entities.Organization, allowed bool) (*entities.Organization, error) {
+	if err := r.conn.WithContext(ctx).Model(&entities.Organization{}).Where("id = ?", organization.ID).
+		Update("unsigned_public_reports", allowed).Error; err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, map[string]interface{}{"id": organization.ID})
+}
+
 func (r *organizationRepository) Delete(ctx context.Context, accountID uuid.UUID, organization *entities.Organization) error {
 	var res []entities.AccountOrganization
 	err := r.conn.WithContext(ctx).Where("organization_id = ?", organization.ID).Find(&res).Error
@@ -225,7 +234,7 @@ func (r *organizationRepository) AssignGames(ctx context.Context, integratorID u
 }
 
 func (r *organizationRepository) UpdateIntegratorGame(ctx context.Context, integratorID uuid.UUID, gameID uuid.UUID, wagerSetID uuid.UUID, rtp *int64, volatility *string, shortLink bool) (ig *entities.IntegratorGame, err error) {
-	err = r.conn.WithContext(ctx).Where("organization_id = ? AND game_id = ?", integratorID, gameID).First(&ig).Error
+	err = withContext(ctx, r.conn).Where("organization_id = ? AND game_id = ?", integratorID, gameID).First(&ig).Error
 	if err != nil {
 		return nil, err
 	}
@@ -235,7 +244,7 @@ func (r *organizationRepository) UpdateIntegratorGame(ctx context.Context, integ
 	ig.Volatility = volatility
 	ig.ShortLink = shortLink
 
-	err = r.conn.WithContext(ctx).Where("organization_id = ? AND game_id = ?", integratorID, gameID).Updates(&ig).Error
+	err = withContext(ctx, r.conn).Where("organization_id = ? AND game_id = ?", integratorID, gameID).Updates(&ig).Error
 	if err != nil {
 		return nil, err
 	}
@@ -243,6 +252,26 @@ func (r *organizationRepository) UpdateIntegratorGame(ctx context.Context, integ
 	return ig, nil
 }
 
+func (r *organizationRepository) GetIntegratorGame(ctx context.Context, integratorID, gameID uuid.UUID) (ig *entities.IntegratorGame, err error) {
+	err = withContext(ctx, r.conn).Where("organization_id = ? AND game_id = ?", integratorID, gameID).First(&ig).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, e.ErrEntityNotFound
+	}
+
+	return ig, err
+}
+
+func (r *organizationRepository) RestoreIntegratorGame(ctx context.Context, ig *entities.IntegratorGame) error {
+	return withContext(ctx, r.conn).Model(&entities.IntegratorGame{}).
+		Where("organization_id = ? AND game_id = ?", ig.OrganizationID, ig.GameID).
+		Updates(map[string]interface{}{
+			"wager_set_id": ig.WagerSetID,
+			"rtp":          ig.RTP,
+			"volatility":   ig.Volatility,
+			"short_link":   ig.ShortLink,
+		}).Error
+}
+
 func (r *organizationRepository) RevokeGames(ctx context.Context, integratorID uuid.UUID, gameIDs []uuid.UUID) error {
 	for _, gameID := range gameIDs {
 		err := r.conn.WithContext(ctx).Where("organization_id = ? AND game_id = ?", integratorID, gameID).Delete(&entities.IntegratorGame{}).Error
@@ -414,6 +443,30 @@ func (r *organizationRepository) UpdateIntegratorGameWagerSet(ctx context.Contex
 	return igws, nil
 }
 
+func (r *organizationRepository) ReplaceIntegratorGameWagerSets(ctx context.Context, wagerSets []*entities.WagerSet,
+	igws []*entities.IntegratorGameWagerSet) error {
+	return r.conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(wagerSets) > 0 {
+			if err := tx.Create(wagerSets).Error; err != nil {
+				return err
+			}
+		}
+
+		for _, item := range igws {
+			if err := tx.Where("organization_id = ? AND game_id = ? AND currency = ?", item.OrganizationID, item.GameID, item.Currency).
+				Delete(&entities.IntegratorGameWagerSet{}).Error; err != nil {
+				return err
+			}
+
+			if err := tx.Create(item).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
 func (r *organizationRepository) DeleteGameWagerSet(ctx context.Context, integratorID uuid.UUID, wagerSetID uuid.UUID, currency string, gameID uuid.UUID) error {
 	err := r.conn.WithContext(ctx).Where("organization_id = ? AND game_id = ? AND wager_set_id = ? AND currency = ?", integratorID, gameID, wagerSetID, currency).Delete(&entities.IntegratorGameWagerSet{}).Error
 
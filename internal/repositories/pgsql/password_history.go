@@ -0,0 +1,44 @@
+package pgsql
+
+import (
+	"backoffice/internal/entities"
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type passwordHistoryRepository struct {
+	conn *gorm.DB
+}
+
+func NewPasswordHistoryRepository(conn *gorm.DB) *passwordHistoryRepository {
+	return &passwordHistoryRepository{
+		conn: conn,
+	}
+}
+
+func (r *passwordHistoryRepository) Recent(ctx context.Context, accountID uuid.UUID, limit int) (entries []*entities.PasswordHistory, err error) {
+	err = r.conn.WithContext(ctx).Where("account_id = ?", accountID).
+		Order("created_at desc").Limit(limit).Find(&entries).Error
+
+	return entries, err
+}
+
+func (r *passwordHistoryRepository) Add(ctx context.Context, entry *entities.PasswordHistory, keep int) error {
+	return r.conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if keep <= 0 {
+			return tx.Where("account_id = ?", entry.AccountID).Delete(&entities.PasswordHistory{}).Error
+		}
+
+		if err := tx.Create(entry).Error; err != nil {
+			return err
+		}
+
+		kept := tx.Model(&entities.PasswordHistory{}).Select("id").
+			Where("account_id = ?", entry.AccountID).Order("created_at desc").Limit(keep)
+
+		return tx.Where("account_id = ? and id not in (?)", entry.AccountID, kept).
+			Delete(&entities.PasswordHistory{}).Error
+	})
+}
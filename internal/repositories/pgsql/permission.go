@@ -97,3 +97,8 @@ func (r *permissionRepository) RevokeRolePermissions(ctx context.Context, role *
 func (r *permissionRepository) AssignRolePermissions(ctx context.Context, role *entities.Role, permissions []*entities.Permission) error {
 	return r.conn.WithContext(ctx).Model(&role).Association("Permissions").Append(&permissions)
 }
+
+// RefreshAdminPermissions grants every permission to the admin roles.
+func (r *permissionRepository) RefreshAdminPermissions(ctx context.Context) error {
+	return r.conn.WithContext(ctx).Exec("call refresh_admin_permissions()").Error
+}
@@ -0,0 +1,52 @@
+package pgsql
+
+import (
+	"backoffice/internal/entities"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type recoveryCodeRepository struct {
+	conn *gorm.DB
+}
+
+func NewRecoveryCodeRepository(conn *gorm.DB) *recoveryCodeRepository {
+	return &recoveryCodeRepository{
+		conn: conn,
+	}
+}
+
+func (r *recoveryCodeRepository) Replace(ctx context.Context, accountID uuid.UUID, codes []*entities.RecoveryCode) error {
+	return r.conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("account_id = ?", accountID).Delete(&entities.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&codes).Error
+	})
+}
+
+func (r *recoveryCodeRepository) Use(ctx context.Context, accountID uuid.UUID, hash string, at time.Time) (bool, error) {
+	res := r.conn.WithContext(ctx).Model(&entities.RecoveryCode{}).
+		Where("account_id = ? and hash = ? and used_at is null", accountID, hash).
+		UpdateColumn("used_at", at)
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	return res.RowsAffected == 1, nil
+}
+
+func (r *recoveryCodeRepository) Remaining(ctx context.Context, accountID uuid.UUID) (count int64, err error) {
+	err = r.conn.WithContext(ctx).Model(&entities.RecoveryCode{}).
+		Where("account_id = ? and used_at is null", accountID).Count(&count).Error
+
+	return count, err
+}
+
+func (r *recoveryCodeRepository) DeleteAll(ctx context.Context, accountID uuid.UUID) error {
+	return r.conn.WithContext(ctx).Where("account_id = ?", accountID).Delete(&entities.RecoveryCode{}).Error
+}
@@ -0,0 +1,36 @@
+package pgsql
+
+import (
+	"backoffice/internal/entities"
+	"context"
+	"gorm.io/gorm"
+	"time"
+)
+
+type reportScheduleRepository struct {
+	BaseRepository[entities.ReportSchedule]
+}
+
+func NewReportScheduleRepository(conn *gorm.DB) *reportScheduleRepository {
+	return &reportScheduleRepository{
+		BaseRepository: BaseRepository[entities.ReportSchedule]{conn: conn},
+	}
+}
+
+func (r *reportScheduleRepository) FindDue(ctx context.Context, now time.Time) (schedules []*entities.ReportSchedule, err error) {
+	err = r.conn.WithContext(ctx).
+		Where("is_active = ? and next_run_at <= ?", true, now).
+		Order("next_run_at").
+		Find(&schedules).Error
+
+	return
+}
+
+func (r *reportScheduleRepository) Claim(ctx context.Context, schedule *entities.ReportSchedule, nextRunAt time.Time) (bool, error) {
+	res := r.conn.WithContext(ctx).
+		Model(&entities.ReportSchedule{}).
+		Where("id = ? and next_run_at = ?", schedule.ID, schedule.NextRunAt).
+		Updates(map[string]interface{}{"next_run_at": nextRunAt, "last_run_at": time.Now()})
+
+	return res.RowsAffected == 1, res.Error
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"time"
 )
 
 type roleRepository struct {
@@ -20,7 +21,7 @@ func NewRoleRepository(conn *gorm.DB) *roleRepository {
 	}
 }
 
-func (r *roleRepository) Assign(ctx context.Context, account *entities.Account, role *entities.Role) error {
+func (r *roleRepository) Assign(ctx context.Context, account *entities.Account, role *entities.Role, expiresAt *time.Time) error {
 	var ar *entities.AccountRole
 
 	err := r.conn.WithContext(ctx).Where("account_id = ? and role_id = ?", account.ID, role.ID).First(&ar).Error
@@ -32,7 +33,13 @@ func (r *roleRepository) Assign(ctx context.Context, account *entities.Account,
 		return e.ErrRoleAlreadyAssigned
 	}
 
-	return r.conn.WithContext(ctx).Create(&entities.AccountRole{AccountID: account.ID, RoleID: role.ID}).Error
+	return r.conn.WithContext(ctx).Create(&entities.AccountRole{AccountID: account.ID, RoleID: role.ID, ExpiresAt: expiresAt}).Error
+}
+
+func (r *roleRepository) FindExpired(ctx context.Context, now time.Time) (roles []*entities.AccountRole, err error) {
+	err = r.conn.WithContext(ctx).Where("expires_at <= ?", now).Find(&roles).Error
+
+	return
 }
 
 func (r *roleRepository) GetAccountRoles(ctx context.Context, account *entities.Account) ([]*entities.Role, error) {
@@ -80,6 +87,19 @@ func (r *roleRepository) Update(ctx context.Context, role *entities.Role) (*enti
 	return r.FindBy(ctx, map[string]interface{}{"id": role.ID})
 }
 
+func (r *roleRepository) UpdateReportScope(ctx context.Context, role *entities.Role, scope *entities.ReportScope) (*entities.Role, error) {
+	var value interface{} = gorm.Expr("NULL")
+	if scope != nil {
+		value = scope
+	}
+
+	if err := r.conn.WithContext(ctx).Model(&entities.Role{}).Where("id = ?", role.ID).Update("report_scope", value).Error; err != nil {
+		return nil, err
+	}
+
+	return r.FindBy(ctx, map[string]interface{}{"id": role.ID})
+}
+
 func (r *roleRepository) Revoke(ctx context.Context, account *entities.Account, role *entities.Role) error {
 	return r.conn.WithContext(ctx).Where("account_id = ? and role_id = ?", account.ID, role.ID).Delete(&entities.AccountRole{}).Error
 }
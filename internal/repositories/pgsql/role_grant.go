@@ -0,0 +1,37 @@
+package pgsql
+
+import (
+	"backoffice/internal/entities"
+	"context"
+	"gorm.io/gorm"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type roleGrantRepository struct {
+	BaseRepository[entities.RoleGrant]
+}
+
+func NewRoleGrantRepository(conn *gorm.DB) *roleGrantRepository {
+	return &roleGrantRepository{
+		BaseRepository: BaseRepository[entities.RoleGrant]{conn: conn},
+	}
+}
+
+func (r *roleGrantRepository) End(ctx context.Context, accountID, roleID uuid.UUID, status string) (bool, error) {
+	now := time.Now()
+	res := r.conn.WithContext(ctx).
+		Model(&entities.RoleGrant{}).
+		Where("account_id = ? and role_id = ? and status = ?", accountID, roleID, entities.RoleGrantActive).
+		Updates(map[string]interface{}{"status": status, "ended_at": now, "updated_at": now})
+
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *roleGrantRepository) ExpirePending(ctx context.Context, now time.Time) error {
+	return r.conn.WithContext(ctx).
+		Model(&entities.RoleGrant{}).
+		Where("status = ? and expires_at <= ?", entities.RoleGrantPending, now).
+		Updates(map[string]interface{}{"status": entities.RoleGrantExpired, "ended_at": now, "updated_at": now}).Error
+}
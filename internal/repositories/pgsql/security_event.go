@@ -0,0 +1,71 @@
+package pgsql
+
+import (
+	"backoffice/internal/entities"
+	"context"
+	"gorm.io/gorm"
+)
+
+type securityEventRepository struct {
+	conn *gorm.DB
+}
+
+func NewSecurityEventRepository(conn *gorm.DB) *securityEventRepository {
+	return &securityEventRepository{
+		conn: conn,
+	}
+}
+
+func (r *securityEventRepository) Create(ctx context.Context, event *entities.SecurityEvent) error {
+	return r.conn.WithContext(ctx).Create(&event).Error
+}
+
+func (r *securityEventRepository) Paginate(ctx context.Context, filters *entities.SecurityEventFilters, limit int, page int) (
+	pagination entities.Pagination[entities.SecurityEvent], err error) {
+	query := r.conn.WithContext(ctx).Model(&entities.SecurityEvent{})
+
+	if filters.Type != "" {
+		query = query.Where("type = ?", filters.Type)
+	}
+
+	if filters.Login != "" {
+		query = query.Where("login = ?", filters.Login)
+	}
+
+	if filters.AccountID != nil {
+		query = query.Where("account_id = ?", *filters.AccountID)
+	}
+
+	if filters.OrganizationID != nil {
+		query = query.Where("organization_id = ?", *filters.OrganizationID)
+	}
+
+	if filters.IP != "" {
+		query = query.Where("ip = ?", filters.IP)
+	}
+
+	if filters.From != nil {
+		query = query.Where("created_at >= ?", *filters.From)
+	}
+
+	if filters.To != nil {
+		query = query.Where("created_at < ?", *filters.To)
+	}
+
+	var total int64
+	if err = query.Count(&total).Error; err != nil {
+		return
+	}
+
+	items := make([]*entities.SecurityEvent, 0)
+	if err = query.Order("created_at desc").Limit(limit).Offset(limit * (page - 1)).Find(&items).Error; err != nil {
+		return
+	}
+
+	pagination.Total = int(total)
+	pagination.Limit = limit
+	pagination.CurrentPage = page
+	pagination.Items = items
+
+	return
+}
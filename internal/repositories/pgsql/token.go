@@ -40,3 +40,7 @@ func (r *tokenRepository) GetByAccountID(ctx context.Context, accountID uuid.UUI
 func (r *tokenRepository) DeleteByAccess(ctx context.Context, t string) error {
 	return r.conn.WithContext(ctx).Where("access_token = ?", t).Delete(&entities.Token{}).Error
 }
+
+func (r *tokenRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.conn.WithContext(ctx).Where("id = ?", id).Delete(&entities.Token{}).Error
+}
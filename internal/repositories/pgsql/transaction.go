@@ -0,0 +1,25 @@
+package pgsql
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type transactionKey struct{}
+
+// transaction runs fn in a transaction, repositories called with the context fn gets join it.
+func transaction(ctx context.Context, conn *gorm.DB, fn func(ctx context.Context) error) error {
+	return withContext(ctx, conn).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, transactionKey{}, tx))
+	})
+}
+
+// withContext is the transaction of ctx when there is one, conn otherwise.
+func withContext(ctx context.Context, conn *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(transactionKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+
+	return conn.WithContext(ctx)
+}
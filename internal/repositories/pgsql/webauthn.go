@@ -0,0 +1,25 @@
+package pgsql
+
+import (
+	"backoffice/internal/entities"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type webAuthnCredentialRepository struct {
+	BaseRepository[entities.WebAuthnCredential]
+}
+
+func NewWebAuthnCredentialRepository(conn *gorm.DB) *webAuthnCredentialRepository {
+	return &webAuthnCredentialRepository{
+		BaseRepository: BaseRepository[entities.WebAuthnCredential]{conn: conn},
+	}
+}
+
+func (r *webAuthnCredentialRepository) Touch(ctx context.Context, id uuid.UUID, signCount int64, at time.Time) error {
+	return r.conn.WithContext(ctx).Model(&entities.WebAuthnCredential{}).Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{"sign_count": signCount, "last_used_at": at}).Error
+}
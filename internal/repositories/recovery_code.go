@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"backoffice/internal/entities"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RecoveryCodeRepository interface {
+	// Replace drops all codes of the account and stores the new ones.
+	Replace(ctx context.Context, accountID uuid.UUID, codes []*entities.RecoveryCode) error
+	// Use marks the unused code with the hash as used, false is returned when there is no such code.
+	Use(ctx context.Context, accountID uuid.UUID, hash string, at time.Time) (bool, error)
+	Remaining(ctx context.Context, accountID uuid.UUID) (int64, error)
+	DeleteAll(ctx context.Context, accountID uuid.UUID) error
+}
@@ -0,0 +1,72 @@
+package redis
+
+import (
+	"backoffice/internal/entities"
+	"backoffice/pkg/redis"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	FileEventChannelPrefix = "file_events"
+)
+
+type fileEventRepository struct {
+	conn *redis.Client
+}
+
+func NewFileEventRepository(conn *redis.Client) *fileEventRepository {
+	return &fileEventRepository{
+		conn: conn,
+	}
+}
+
+func (r *fileEventRepository) Publish(ctx context.Context, sessionID uuid.UUID, event *entities.FileEvent) error {
+	return r.conn.Publish(ctx, fmt.Sprintf("%s:%s", FileEventChannelPrefix, sessionID.String()), event)
+}
+
+func (r *fileEventRepository) Subscribe(ctx context.Context, sessionID uuid.UUID) (<-chan *entities.FileEvent, error) {
+	sub, err := r.conn.Subscribe(ctx, fmt.Sprintf("%s:%s", FileEventChannelPrefix, sessionID.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan *entities.FileEvent)
+
+	go func() {
+		defer close(events)
+		defer sub.Close()
+
+		messages := sub.Channel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				event := &entities.FileEvent{}
+				if err := json.Unmarshal([]byte(msg.Payload), event); err != nil {
+					zap.S().Error(err)
+
+					continue
+				}
+
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
@@ -0,0 +1,43 @@
+package redis
+
+import (
+	"backoffice/pkg/redis"
+	"context"
+	"time"
+)
+
+const (
+	LoginFailuresKeyPrefix = "login_failures"
+	LoginLockoutKeyPrefix  = "login_lockout"
+)
+
+type loginAttemptRepository struct {
+	conn *redis.Client
+}
+
+func NewLoginAttemptRepository(conn *redis.Client) *loginAttemptRepository {
+	return &loginAttemptRepository{
+		conn: conn,
+	}
+}
+
+func (r *loginAttemptRepository) Fail(ctx context.Context, key string, window time.Duration) (int64, error) {
+	return r.conn.Incr(ctx, r.conn.PrepareKey(LoginFailuresKeyPrefix, key), window)
+}
+
+func (r *loginAttemptRepository) Reset(ctx context.Context, key string) error {
+	return r.conn.Del(ctx, r.conn.PrepareKey(LoginFailuresKeyPrefix, key), r.conn.PrepareKey(LoginLockoutKeyPrefix, key))
+}
+
+func (r *loginAttemptRepository) Lock(ctx context.Context, key string, duration time.Duration) error {
+	return r.conn.Set(ctx, r.conn.PrepareKey(LoginLockoutKeyPrefix, key), 1, duration)
+}
+
+func (r *loginAttemptRepository) LockedFor(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := r.conn.TTL(ctx, r.conn.PrepareKey(LoginLockoutKeyPrefix, key))
+	if err != nil || ttl < 0 {
+		return 0, err
+	}
+
+	return ttl, nil
+}
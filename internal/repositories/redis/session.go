@@ -97,8 +97,11 @@ func (r *sessionRepository) Find(ctx context.Context, id uuid.UUID) ([]*entities
 	for _, i := range ids {
 		session, err := r.Get(ctx, uuid.MustParse(i))
 		if err != nil {
+			// the session expired or was revoked, its key is dropped from the account index
 			if errors.Is(rd.Nil, err) {
-				err = r.conn.HDelete(ctx, r.conn.PrepareKey(AccountsCacheKeyPrefix, id.String()), i)
+				if err = r.conn.HDelete(ctx, r.conn.PrepareKey(AccountsCacheKeyPrefix, id.String()), i); err == nil {
+					continue
+				}
 			}
 
 			return nil, err
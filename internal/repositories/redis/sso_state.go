@@ -0,0 +1,49 @@
+package redis
+
+import (
+	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
+	"backoffice/pkg/redis"
+	"context"
+	"errors"
+	"time"
+
+	rd "github.com/go-redis/redis/v8"
+)
+
+const (
+	SSOStateLifetime  = 10 * time.Minute
+	SSOStateKeyPrefix = "sso_state"
+)
+
+type ssoStateRepository struct {
+	conn *redis.Client
+}
+
+func NewSSOStateRepository(conn *redis.Client) *ssoStateRepository {
+	return &ssoStateRepository{
+		conn: conn,
+	}
+}
+
+func (r *ssoStateRepository) Create(ctx context.Context, state string, s *entities.SSOState) error {
+	return r.conn.Set(ctx, r.conn.PrepareKey(SSOStateKeyPrefix, state), s, SSOStateLifetime)
+}
+
+func (r *ssoStateRepository) Take(ctx context.Context, state string) (*entities.SSOState, error) {
+	bts, err := r.conn.GetDel(ctx, r.conn.PrepareKey(SSOStateKeyPrefix, state))
+	if err != nil {
+		if errors.Is(err, rd.Nil) {
+			return nil, e.ErrEntityNotFound
+		}
+
+		return nil, err
+	}
+
+	s := &entities.SSOState{}
+	if err = s.Unmarshal(bts); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
@@ -0,0 +1,46 @@
+package redis
+
+import (
+	e "backoffice/internal/errors"
+	"backoffice/pkg/redis"
+	"backoffice/pkg/webauthn"
+	"context"
+	"errors"
+	"time"
+
+	rd "github.com/go-redis/redis/v8"
+)
+
+const WebAuthnSessionKeyPrefix = "webauthn_session"
+
+type webAuthnSessionRepository struct {
+	conn *redis.Client
+}
+
+func NewWebAuthnSessionRepository(conn *redis.Client) *webAuthnSessionRepository {
+	return &webAuthnSessionRepository{
+		conn: conn,
+	}
+}
+
+func (r *webAuthnSessionRepository) Create(ctx context.Context, key string, s *webauthn.SessionData) error {
+	return r.conn.Set(ctx, r.conn.PrepareKey(WebAuthnSessionKeyPrefix, key), s, time.Until(s.Expires))
+}
+
+func (r *webAuthnSessionRepository) Take(ctx context.Context, key string) (*webauthn.SessionData, error) {
+	bts, err := r.conn.GetDel(ctx, r.conn.PrepareKey(WebAuthnSessionKeyPrefix, key))
+	if err != nil {
+		if errors.Is(err, rd.Nil) {
+			return nil, e.ErrEntityNotFound
+		}
+
+		return nil, err
+	}
+
+	s := &webauthn.SessionData{}
+	if err = s.Unmarshal(bts); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
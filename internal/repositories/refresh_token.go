@@ -11,4 +11,5 @@ type TokenRepository interface {
 	GetByRefresh(ctx context.Context, t string) (token *entities.Token, err error)
 	GetByAccountID(ctx context.Context, accountID uuid.UUID) (tokens []*entities.Token, err error)
 	DeleteByAccess(ctx context.Context, t string) error
+	Delete(ctx context.Context, id uuid.UUID) error
 }
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"backoffice/internal/entities"
+	"context"
+	"time"
+)
+
+type ReportScheduleRepository interface {
+	BaseRepository[entities.ReportSchedule]
+	FindDue(ctx context.Context, now time.Time) ([]*entities.ReportSchedule, error)
+	// Claim moves next_run_at forward only if no other instance has done it yet.
+	Claim(ctx context.Context, schedule *entities.ReportSchedule, nextRunAt time.Time) (bool, error)
+}
@@ -4,6 +4,7 @@ import (
 	"backoffice/internal/entities"
 	"context"
 	"github.com/google/uuid"
+	"time"
 )
 
 type RoleRepository interface {
@@ -11,8 +12,11 @@ type RoleRepository interface {
 	Paginate(ctx context.Context, organizationID uuid.UUID, filters Filters, order string, limit int, offset int) (roles []*entities.Role, total int64, err error)
 	Create(ctx context.Context, role *entities.Role) (*entities.Role, error)
 	Update(ctx context.Context, role *entities.Role) (*entities.Role, error)
-	Assign(ctx context.Context, account *entities.Account, role *entities.Role) error
+	UpdateReportScope(ctx context.Context, role *entities.Role, scope *entities.ReportScope) (*entities.Role, error)
+	// Assign grants the role until expiresAt, nil grants it permanently.
+	Assign(ctx context.Context, account *entities.Account, role *entities.Role, expiresAt *time.Time) error
 	Revoke(ctx context.Context, account *entities.Account, role *entities.Role) error
 	Delete(ctx context.Context, role *entities.Role) error
 	FindBy(ctx context.Context, params map[string]interface{}) (role *entities.Role, err error)
+	FindExpired(ctx context.Context, now time.Time) ([]*entities.AccountRole, error)
 }
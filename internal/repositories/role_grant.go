@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"backoffice/internal/entities"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RoleGrantRepository interface {
+	BaseRepository[entities.RoleGrant]
+	// End moves the active grant of the role to status, it tells whether there was one to end.
+	End(ctx context.Context, accountID, roleID uuid.UUID, status string) (bool, error)
+	// ExpirePending expires the pending grants that were not approved in time.
+	ExpirePending(ctx context.Context, now time.Time) error
+}
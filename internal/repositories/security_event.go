@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"backoffice/internal/entities"
+	"context"
+	"time"
+)
+
+type SecurityEventRepository interface {
+	Create(ctx context.Context, event *entities.SecurityEvent) error
+	Paginate(ctx context.Context, filters *entities.SecurityEventFilters, limit int, page int) (pagination entities.Pagination[entities.SecurityEvent], err error)
+}
+
+// LoginAttemptRepository counts recent authentication failures and keeps temporary lockouts,
+// keys are logins or client addresses.
+type LoginAttemptRepository interface {
+	// Fail counts a failure, the counter expires window after the last failure.
+	Fail(ctx context.Context, key string, window time.Duration) (int64, error)
+	Reset(ctx context.Context, key string) error
+	Lock(ctx context.Context, key string, duration time.Duration) error
+	// LockedFor is the remaining lockout of the key, zero when it is not locked.
+	LockedFor(ctx context.Context, key string) (time.Duration, error)
+}
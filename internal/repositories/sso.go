@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"backoffice/internal/entities"
+	"context"
+)
+
+// SSOStateRepository keeps login states until the identity provider redirects back, a state is usable once.
+type SSOStateRepository interface {
+	Create(ctx context.Context, state string, s *entities.SSOState) error
+	Take(ctx context.Context, state string) (*entities.SSOState, error)
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"backoffice/internal/entities"
+	"backoffice/pkg/webauthn"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebAuthnCredentialRepository interface {
+	BaseRepository[entities.WebAuthnCredential]
+	Touch(ctx context.Context, id uuid.UUID, signCount int64, at time.Time) error
+}
+
+// WebAuthnSessionRepository keeps the challenge of a ceremony until the browser answers, a session is usable once.
+type WebAuthnSessionRepository interface {
+	Create(ctx context.Context, key string, s *webauthn.SessionData) error
+	Take(ctx context.Context, key string) (*webauthn.SessionData, error)
+}
@@ -18,17 +18,22 @@ var (
 	ErrCanNotRemoveAccount = errors.New("can't remove account")
 )
 
+const passwordRotationTTL = 15 * time.Minute
+
 type AccountService struct {
-	accountRepository repositories.AccountRepository
-	sessionService    *SessionService
-	mailingService    *MailingService
+	accountRepository     repositories.AccountRepository
+	sessionService        *SessionService
+	mailingService        *MailingService
+	passwordPolicyService *PasswordPolicyService
 }
 
-func NewAccountService(accountRepository repositories.AccountRepository, sessionService *SessionService, mailingService *MailingService) *AccountService {
+func NewAccountService(accountRepository repositories.AccountRepository, sessionService *SessionService, mailingService *MailingService,
+	passwordPolicyService *PasswordPolicyService) *AccountService {
 	return &AccountService{
-		accountRepository: accountRepository,
-		sessionService:    sessionService,
-		mailingService:    mailingService,
+		accountRepository:     accountRepository,
+		sessionService:        sessionService,
+		mailingService:        mailingService,
+		passwordPolicyService: passwordPolicyService,
 	}
 }
 
@@ -79,7 +84,8 @@ func (s *AccountService) Paginate(ctx context.Context, organizationID uuid.UUID,
 	return p, total, nil
 }
 
-func (s *AccountService) Create(ctx context.Context, authProviderID, authProviderToken, firstName, lastName string) (*entities.Account, error) {
+// Create checks the password against the policy of the organization the account is created in.
+func (s *AccountService) Create(ctx context.Context, organizationID uuid.UUID, authProviderID, authProviderToken, firstName, lastName string) (*entities.Account, error) {
 	_, err := s.accountRepository.FindBy(ctx, map[string]interface{}{"auth_provider_id": authProviderID})
 	if err == nil {
 		return nil, e.ErrAccountAlreadyExists
@@ -89,11 +95,16 @@ func (s *AccountService) Create(ctx context.Context, authProviderID, authProvide
 		return nil, err
 	}
 
+	if _, err = s.passwordPolicyService.Check(ctx, []uuid.UUID{organizationID}, nil, authProviderToken); err != nil {
+		return nil, err
+	}
+
 	tokenHash, err := bcrypt.GenerateFromPassword([]byte(authProviderToken), 15)
 	if err != nil {
 		return nil, err
 	}
 
+	now := time.Now()
 	account := &entities.Account{
 		ID:                uuid.New(),
 		AuthProvider:      entities.AuthProviderEmail,
@@ -102,6 +113,7 @@ func (s *AccountService) Create(ctx context.Context, authProviderID, authProvide
 		FirstName:         firstName,
 		LastName:          lastName,
 		Email:             authProviderID,
+		PasswordChangedAt: &now,
 	}
 
 	account, err = s.accountRepository.Create(ctx, account)
@@ -109,7 +121,76 @@ func (s *AccountService) Create(ctx context.Context, authProviderID, authProvide
 		return account, err
 	}
 
-	return account, s.mailingService.NotifyUserEmail(authProviderID, authProviderID, authProviderToken)
+	return account, s.mailingService.NotifyUserEmail(authProviderID, authProviderID)
+}
+
+// CreatePending creates an invited account, it has no password until the invite is accepted.
+func (s *AccountService) CreatePending(ctx context.Context, email, firstName, lastName string) (*entities.Account, error) {
+	_, err := s.accountRepository.FindBy(ctx, map[string]interface{}{"auth_provider_id": email})
+	if err == nil {
+		return nil, e.ErrAccountAlreadyExists
+	}
+
+	if !errors.Is(err, e.ErrEntityNotFound) {
+		return nil, err
+	}
+
+	return s.accountRepository.Create(ctx, &entities.Account{
+		ID:             uuid.New(),
+		AuthProvider:   entities.AuthProviderEmail,
+		AuthProviderID: email,
+		FirstName:      firstName,
+		LastName:       lastName,
+		Email:          email,
+		Status:         entities.AccountStatusPending,
+	})
+}
+
+func (s *AccountService) SetInviteToken(ctx context.Context, account *entities.Account, token string, expiresAt time.Time) (*entities.Account, error) {
+	return s.accountRepository.Update(ctx, &entities.Account{
+		ID:                     account.ID,
+		ResetPasswordToken:     token,
+		ResetPasswordExpiresAt: &expiresAt,
+	})
+}
+
+// Activate sets the password of a pending account and makes it active.
+func (s *AccountService) Activate(ctx context.Context, account *entities.Account, password string) (*entities.Account, error) {
+	if _, err := s.passwordPolicyService.Check(ctx, organizationIDs(account), account, password); err != nil {
+		return nil, err
+	}
+
+	tokenHash, err := bcrypt.GenerateFromPassword([]byte(password), 15)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.accountRepository.Activate(ctx, account, string(tokenHash), time.Now())
+}
+
+func (s *AccountService) UpdateStatus(ctx context.Context, id uuid.UUID, status int64) (*entities.Account, error) {
+	return s.accountRepository.Update(ctx, &entities.Account{ID: id, Status: status})
+}
+
+// CreateExternal creates an account authenticated by an external identity provider, it has no password.
+func (s *AccountService) CreateExternal(ctx context.Context, authProvider, email, firstName, lastName string) (*entities.Account, error) {
+	_, err := s.accountRepository.FindBy(ctx, map[string]interface{}{"auth_provider_id": email})
+	if err == nil {
+		return nil, e.ErrAccountAlreadyExists
+	}
+
+	if !errors.Is(err, e.ErrEntityNotFound) {
+		return nil, err
+	}
+
+	return s.accountRepository.Create(ctx, &entities.Account{
+		ID:             uuid.New(),
+		AuthProvider:   authProvider,
+		AuthProviderID: email,
+		FirstName:      firstName,
+		LastName:       lastName,
+		Email:          email,
+	})
 }
 
 func (s *AccountService) Delete(ctx context.Context, accountDeleter *entities.Account, accountID string) error {
@@ -193,6 +274,19 @@ func (s *AccountService) DisableTOTP(ctx context.Context, account *entities.Acco
 	return account, nil
 }
 
+func (s *AccountService) SetWebAuthn(ctx context.Context, account *entities.Account, enabled bool) (*entities.Account, error) {
+	account, err := s.accountRepository.SetWebAuthn(ctx, account, enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.sessionService.UpdateAccountInfo(ctx, account); err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
 func (s *AccountService) Auth(ctx context.Context, id string, token string) (*entities.Account, error) {
 	account, err := s.FindBy(ctx, map[string]interface{}{"auth_provider_id": id})
 	if err != nil {
@@ -207,20 +301,15 @@ func (s *AccountService) Auth(ctx context.Context, id string, token string) (*en
 }
 
 func (s *AccountService) ChangePassword(ctx context.Context, account *entities.Account, password, newPassword string) error {
-	if _, err := s.Auth(ctx, account.AuthProviderID, password); err != nil {
-		return err
-	}
-
-	tokenHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), 15)
+	// the session copy of the account has no password hash
+	account, err := s.Auth(ctx, account.AuthProviderID, password)
 	if err != nil {
 		return err
 	}
 
-	if _, err = s.accountRepository.Update(ctx, &entities.Account{ID: account.ID, AuthProviderToken: string(tokenHash)}); err != nil {
-		return err
-	}
+	_, err = s.setPassword(ctx, account, newPassword)
 
-	return nil
+	return err
 }
 
 func (s *AccountService) ResetPasswordRequest(ctx context.Context, email string) error {
@@ -229,18 +318,17 @@ func (s *AccountService) ResetPasswordRequest(ctx context.Context, email string)
 		return err
 	}
 
-	resetToken := uuid.New().String()
-	resetTokenExpiry := time.Now().Add(1 * time.Hour)
-
-	account.ResetPasswordToken = resetToken
-	account.ResetPasswordExpiresAt = &resetTokenExpiry
+	// the token column holds the invite of a pending account
+	if account.IsPending() {
+		return ErrInvitePending
+	}
 
-	_, err = s.accountRepository.Update(ctx, account)
+	rotation, err := s.issueResetToken(ctx, account, 1*time.Hour)
 	if err != nil {
 		return err
 	}
 
-	return s.mailingService.ResetUserPassword(email, resetToken)
+	return s.mailingService.ResetUserPassword(email, rotation.ResetToken)
 }
 
 func (s *AccountService) ResetPassword(ctx context.Context, token, newPassword string) error {
@@ -249,23 +337,76 @@ func (s *AccountService) ResetPassword(ctx context.Context, token, newPassword s
 		return err
 	}
 
-	if account.ResetPasswordExpiresAt != nil && account.ResetPasswordExpiresAt.After(time.Now()) {
-		tokenHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), 15)
-		if err != nil {
-			return err
-		}
+	if account.IsPending() {
+		return e.ErrAccountInvalidResetToken
+	}
 
-		account.AuthProviderToken = string(tokenHash)
-		account.ResetPasswordToken = ""
-		account.ResetPasswordExpiresAt = nil
+	if account.ResetPasswordExpiresAt == nil || !account.ResetPasswordExpiresAt.After(time.Now()) {
+		return e.ErrAccountInvalidResetToken
+	}
 
-		_, err = s.accountRepository.Update(ctx, account)
-		if err != nil {
-			return err
-		}
+	_, err = s.setPassword(ctx, account, newPassword)
+
+	return err
+}
 
-		return nil
+// PasswordExpired is true when the password of the account is older than its policy allows, accounts of
+// external identity providers have no password to expire.
+func (s *AccountService) PasswordExpired(ctx context.Context, account *entities.Account) (bool, error) {
+	if account.AuthProvider != entities.AuthProviderEmail {
+		return false, nil
 	}
 
-	return e.ErrAccountInvalidResetToken
+	policy, err := s.passwordPolicyService.Policy(ctx, organizationIDs(account))
+	if err != nil {
+		return false, err
+	}
+
+	return policy.Expired(account.PasswordSetAt()), nil
+}
+
+// RotatePassword hands out a short lived reset token for an account signing in with an expired password.
+func (s *AccountService) RotatePassword(ctx context.Context, account *entities.Account) (*entities.PasswordRotation, error) {
+	return s.issueResetToken(ctx, account, passwordRotationTTL)
+}
+
+func (s *AccountService) issueResetToken(ctx context.Context, account *entities.Account, ttl time.Duration) (*entities.PasswordRotation, error) {
+	rotation := &entities.PasswordRotation{ResetToken: uuid.New().String(), ExpiresAt: time.Now().Add(ttl)}
+
+	_, err := s.accountRepository.Update(ctx, &entities.Account{
+		ID:                     account.ID,
+		ResetPasswordToken:     rotation.ResetToken,
+		ResetPasswordExpiresAt: &rotation.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rotation, nil
+}
+
+// setPassword checks the new password against the policy of the organizations of the account, the
+// replaced password is kept in the history.
+func (s *AccountService) setPassword(ctx context.Context, account *entities.Account, password string) (*entities.Account, error) {
+	policy, err := s.passwordPolicyService.Check(ctx, organizationIDs(account), account, password)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenHash, err := bcrypt.GenerateFromPassword([]byte(password), 15)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.passwordPolicyService.Remember(ctx, policy, account); err != nil {
+		return nil, err
+	}
+
+	return s.accountRepository.SetPassword(ctx, account, string(tokenHash), time.Now())
+}
+
+func organizationIDs(account *entities.Account) []uuid.UUID {
+	return lo.Map(account.Organizations, func(item *entities.Organization, index int) uuid.UUID {
+		return item.ID
+	})
 }
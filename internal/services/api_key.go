@@ -0,0 +1,243 @@
+package services
+
+import (
+	"backoffice/internal/constants"
+	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
+	"backoffice/internal/repositories"
+	"backoffice/internal/transport/http/requests"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	apiKeyScheme = "bo"
+	// apiKeyTouchInterval limits last_used_at writes to one per key and interval.
+	apiKeyTouchInterval = time.Minute
+)
+
+var (
+	ErrAPIKeyInvalid      = errors.New("api key is invalid")
+	ErrAPIKeyInactive     = errors.New("api key is expired or revoked")
+	ErrAPIKeyIPNotAllowed = errors.New("api key is not allowed from this address")
+	ErrAPIKeyExpiresAt    = errors.New("api key expiry must be in the future")
+	ErrAPIKeyIPAllowlist  = errors.New("ip allowlist entries must be addresses or CIDR ranges")
+	ErrAPIKeyForeignRole  = errors.New("role belongs to another organization")
+	ErrAPIKeyRevoked      = errors.New("api key is already revoked")
+)
+
+type APIKeyService struct {
+	repo                 repositories.APIKeyRepository
+	authorizationService *AuthorizationService
+}
+
+func NewAPIKeyService(repo repositories.APIKeyRepository, authorizationService *AuthorizationService) *APIKeyService {
+	return &APIKeyService{
+		repo:                 repo,
+		authorizationService: authorizationService,
+	}
+}
+
+func (s *APIKeyService) Paginate(ctx context.Context, organizationID uuid.UUID, limit int, page int) (
+	entities.Pagination[entities.APIKey], error) {
+	return s.repo.Paginate(ctx, map[string]interface{}{"organization_id": organizationID}, "created_at desc", limit, page)
+}
+
+func (s *APIKeyService) Get(ctx context.Context, organizationID uuid.UUID, id uuid.UUID) (*entities.APIKey, error) {
+	return s.repo.FindBy(ctx, map[string]interface{}{"id": id, "organization_id": organizationID})
+}
+
+// Create issues a key for a new service account, the returned key holds the secret in Key.
+func (s *APIKeyService) Create(ctx context.Context, session *entities.Session, req *requests.CreateAPIKeyRequest) (*entities.APIKey, error) {
+	roleID, err := uuid.Parse(req.RoleID)
+	if err != nil {
+		return nil, e.ErrValidationFailed("role_id")
+	}
+
+	role, err := s.authorizationService.GetRole(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role.OrganizationID != session.OrganizationID {
+		return nil, ErrAPIKeyForeignRole
+	}
+
+	if err = s.authorizationService.CanAssignRole(ctx, role.ID.String()); err != nil {
+		return nil, err
+	}
+
+	expiresAt, err := parseAPIKeyExpiry(req.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range req.IPAllowlist {
+		if net.ParseIP(item) == nil {
+			if _, _, err := net.ParseCIDR(item); err != nil {
+				return nil, ErrAPIKeyIPAllowlist
+			}
+		}
+	}
+
+	key := &entities.APIKey{
+		CreatedAt: time.Now(),
+
+		ID:             uuid.New(),
+		OrganizationID: session.OrganizationID,
+		CreatedBy:      session.Account.ID,
+		Name:           req.Name,
+		Description:    req.Description,
+		RoleID:         role.ID,
+		IPAllowlist:    req.IPAllowlist,
+		ExpiresAt:      expiresAt,
+	}
+
+	if err = issueAPIKey(key); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Create(ctx, key)
+}
+
+// Rotate replaces the secret of an active key, the previous secret stops working immediately.
+func (s *APIKeyService) Rotate(ctx context.Context, organizationID uuid.UUID, id uuid.UUID, req *requests.RotateAPIKeyRequest) (*entities.APIKey, error) {
+	key, err := s.Get(ctx, organizationID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.RevokedAt != nil {
+		return nil, ErrAPIKeyRevoked
+	}
+
+	if req.ExpiresAt != "" {
+		if key.ExpiresAt, err = parseAPIKeyExpiry(req.ExpiresAt); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = issueAPIKey(key); err != nil {
+		return nil, err
+	}
+
+	secret := key.Key
+	if key, err = s.repo.Save(ctx, key); err != nil {
+		return nil, err
+	}
+
+	key.Key = secret
+
+	return key, nil
+}
+
+func (s *APIKeyService) Revoke(ctx context.Context, organizationID uuid.UUID, id uuid.UUID) error {
+	key, err := s.Get(ctx, organizationID, id)
+	if err != nil {
+		return err
+	}
+
+	if key.RevokedAt != nil {
+		return ErrAPIKeyRevoked
+	}
+
+	now := time.Now()
+	key.RevokedAt = &now
+
+	_, err = s.repo.Save(ctx, key)
+
+	return err
+}
+
+// Authenticate resolves the request session of a key presented by a client from ip.
+func (s *APIKeyService) Authenticate(ctx context.Context, token, ip string) (*entities.Session, error) {
+	prefix, ok := apiKeyPrefix(token)
+	if !ok {
+		return nil, ErrAPIKeyInvalid
+	}
+
+	key, err := s.repo.FindByPrefix(ctx, prefix)
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			return nil, ErrAPIKeyInvalid
+		}
+
+		return nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashAPIKey(token)), []byte(key.Hash)) != 1 {
+		return nil, ErrAPIKeyInvalid
+	}
+
+	now := time.Now()
+
+	if !key.IsActive(now) {
+		return nil, ErrAPIKeyInactive
+	}
+
+	if !key.IsIPAllowed(ip) {
+		return nil, ErrAPIKeyIPNotAllowed
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) > apiKeyTouchInterval {
+		if err = s.repo.Touch(ctx, key.ID, now); err != nil {
+			zap.S().Error(err)
+		}
+	}
+
+	return key.Session(), nil
+}
+
+func parseAPIKeyExpiry(value string) (time.Time, error) {
+	expiresAt, err := time.Parse(constants.TimeLayout, value)
+	if err != nil {
+		return expiresAt, e.ErrValidationFailed("expires_at")
+	}
+
+	if !expiresAt.After(time.Now()) {
+		return expiresAt, ErrAPIKeyExpiresAt
+	}
+
+	return expiresAt, nil
+}
+
+// issueAPIKey sets a new random secret formatted as bo_<prefix>_<secret>, the prefix is the lookup handle.
+func issueAPIKey(key *entities.APIKey) error {
+	buf := make([]byte, 38)
+	if _, err := rand.Read(buf); err != nil {
+		return err
+	}
+
+	key.Prefix = hex.EncodeToString(buf[:6])
+	key.Key = fmt.Sprintf("%s_%s_%s", apiKeyScheme, key.Prefix, base64.RawURLEncoding.EncodeToString(buf[6:]))
+	key.Hash = hashAPIKey(key.Key)
+
+	return nil
+}
+
+func apiKeyPrefix(token string) (string, bool) {
+	parts := strings.SplitN(token, "_", 3)
+	if len(parts) != 3 || parts[0] != apiKeyScheme || parts[1] == "" || parts[2] == "" {
+		return "", false
+	}
+
+	return parts[1], true
+}
+
+func hashAPIKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
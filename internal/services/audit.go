@@ -0,0 +1,170 @@
+package services
+
+import (
+	"backoffice/internal/entities"
+	"backoffice/internal/repositories"
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// auditSensitiveKeys are never persisted in audit records.
+var auditSensitiveKeys = []string{"password", "token", "secret", "totp", "api_key", "codes"}
+
+type AuditRecord struct {
+	Session  *entities.Session
+	Endpoint string
+	Method   string
+	EntityID string
+	Status   int
+	Request  []byte
+	Before   []byte
+	After    []byte
+}
+
+type AuditService struct {
+	auditRepo repositories.AuditRepository
+}
+
+func NewAuditService(auditRepo repositories.AuditRepository) *AuditService {
+	return &AuditService{auditRepo: auditRepo}
+}
+
+func (s *AuditService) Record(ctx context.Context, record *AuditRecord) error {
+	log := &entities.AuditLog{
+		CreatedAt: time.Now(),
+
+		ID:             uuid.New(),
+		AccountID:      record.Session.Account.ID,
+		OrganizationID: record.Session.OrganizationID,
+		Endpoint:       record.Endpoint,
+		Method:         record.Method,
+		EntityID:       record.EntityID,
+		Status:         record.Status,
+
+		Request: sanitizeAuditJSON(record.Request),
+		Before:  sanitizeAuditJSON(record.Before),
+		After:   sanitizeAuditJSON(record.After),
+	}
+
+	if record.Session.Impersonator != nil {
+		log.ImpersonatorID = &record.Session.Impersonator.ID
+	}
+
+	log.Diff = auditDiff(log.Before, log.After)
+
+	if log.EntityID == "" {
+		log.EntityID = auditEntityID(log.After)
+	}
+
+	return s.auditRepo.Create(ctx, log)
+}
+
+func (s *AuditService) Paginate(ctx context.Context, filters *entities.AuditFilters, limit int, page int) (
+	entities.Pagination[entities.AuditLog], error) {
+	return s.auditRepo.Paginate(ctx, filters, limit, page)
+}
+
+// sanitizeAuditJSON drops sensitive fields; anything that is not valid json is not stored.
+func sanitizeAuditJSON(data []byte) entities.JSON {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil
+	}
+
+	sanitized, err := json.Marshal(redactAuditValue(value))
+	if err != nil {
+		return nil
+	}
+
+	return sanitized
+}
+
+func redactAuditValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if isAuditSensitiveKey(key) {
+				delete(v, key)
+
+				continue
+			}
+
+			v[key] = redactAuditValue(item)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redactAuditValue(v[i])
+		}
+	}
+
+	return value
+}
+
+func isAuditSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+
+	for _, sensitive := range auditSensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// auditDiff returns top level fields changed between before and after as {"field": {"before": x, "after": y}}.
+func auditDiff(before, after entities.JSON) entities.JSON {
+	if len(before) == 0 && len(after) == 0 {
+		return nil
+	}
+
+	b, a := map[string]interface{}{}, map[string]interface{}{}
+	_ = json.Unmarshal(before, &b)
+	_ = json.Unmarshal(after, &a)
+
+	diff := map[string]map[string]interface{}{}
+
+	for key, value := range b {
+		if !reflect.DeepEqual(value, a[key]) {
+			diff[key] = map[string]interface{}{"before": value, "after": a[key]}
+		}
+	}
+
+	for key, value := range a {
+		if _, ok := b[key]; !ok {
+			diff[key] = map[string]interface{}{"before": nil, "after": value}
+		}
+	}
+
+	if len(diff) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(diff)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+func auditEntityID(data entities.JSON) string {
+	value := map[string]interface{}{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return ""
+	}
+
+	if id, ok := value["id"].(string); ok {
+		return id
+	}
+
+	return ""
+}
@@ -2,11 +2,16 @@ package services
 
 import (
 	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
 	"backoffice/internal/repositories"
 	"backoffice/pkg/auth"
 	"context"
 	"errors"
+	"sort"
+	"time"
+
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 var (
@@ -33,7 +38,7 @@ func NewAuthenticationService(authProvider auth.Authorizer, authorizeService *Au
 	}
 }
 
-func (s *AuthenticationService) Authenticate(ctx context.Context, account *entities.Account) (*auth.Auth, error) {
+func (s *AuthenticationService) Authenticate(ctx context.Context, account *entities.Account, device entities.Device) (*auth.Auth, error) {
 	jti := uuid.New()
 	tokens, err := s.authProvider.Generate(auth.WithSubject(account.ID.String()), auth.WithID(jti.String()))
 	if err != nil {
@@ -46,16 +51,22 @@ func (s *AuthenticationService) Authenticate(ctx context.Context, account *entit
 		AccessToken:  tokens.AccessToken,
 		RefreshToken: tokens.RefreshToken,
 		ExpiredAt:    tokens.ExpiredAt,
+		IP:           device.IP,
+		UserAgent:    device.UserAgent,
 	}
 
 	if err = s.tokenRepository.Create(ctx, t); err != nil {
 		return nil, err
 	}
 
+	now := time.Now()
 	session := &entities.Session{
 		ID:             jti,
 		Account:        account,
 		OrganizationID: account.GetDefaultOrganizationID(),
+		CreatedAt:      now,
+		LastUsedAt:     now,
+		Device:         device,
 	}
 
 	if err = s.sessionService.Create(ctx, jti, session, t.ExpiredAt); err != nil {
@@ -65,7 +76,8 @@ func (s *AuthenticationService) Authenticate(ctx context.Context, account *entit
 	return tokens, nil
 }
 
-func (s *AuthenticationService) Refresh(ctx context.Context, rt string) (*auth.Auth, error) {
+// Refresh rotates the tokens of a session, the session moves to the new token id and keeps its creation time.
+func (s *AuthenticationService) Refresh(ctx context.Context, rt string, device entities.Device) (*auth.Auth, error) {
 	token, err := s.tokenRepository.GetByRefresh(ctx, rt)
 	if err != nil {
 		return nil, err
@@ -77,25 +89,125 @@ func (s *AuthenticationService) Refresh(ctx context.Context, rt string) (*auth.A
 		return nil, err
 	}
 
+	session, err := s.sessionService.Get(ctx, token.ID)
+	if err != nil {
+		// the cached session outlived its lifetime while the refresh token is still valid
+		account, err := s.accountService.FindBy(ctx, map[string]interface{}{"id": token.AccountID})
+		if err != nil {
+			return nil, err
+		}
+
+		session = &entities.Session{
+			Account:        account,
+			OrganizationID: account.GetDefaultOrganizationID(),
+			CreatedAt:      token.CreatedAt,
+		}
+	}
+
 	if err = s.tokenRepository.DeleteByAccess(ctx, token.AccessToken); err != nil {
 		return nil, err
 	}
 
 	t := &entities.Token{
 		ID:           jti,
+		CreatedAt:    token.CreatedAt,
 		AccountID:    token.AccountID,
 		AccessToken:  tokens.AccessToken,
 		RefreshToken: tokens.RefreshToken,
 		ExpiredAt:    tokens.ExpiredAt,
+		IP:           device.IP,
+		UserAgent:    device.UserAgent,
 	}
 
 	if err = s.tokenRepository.Create(ctx, t); err != nil {
 		return nil, err
 	}
 
+	if err = s.sessionService.Delete(ctx, token.ID); err != nil && !errors.Is(err, e.ErrEntityNotFound) {
+		return nil, err
+	}
+
+	session.ID, session.LastUsedAt, session.Device = jti, time.Now(), device
+	if err = s.sessionService.Create(ctx, jti, session, t.ExpiredAt); err != nil {
+		return nil, err
+	}
+
 	return tokens, nil
 }
 
+// Sessions lists active sessions of the account, most recently used first.
+func (s *AuthenticationService) Sessions(ctx context.Context, accountID, currentID uuid.UUID) ([]*entities.SessionInfo, error) {
+	sessions, err := s.sessionService.Find(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := lo.Map(sessions, func(item *entities.Session, index int) *entities.SessionInfo {
+		return item.Info(currentID)
+	})
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].LastUsedAt.After(infos[j].LastUsedAt)
+	})
+
+	return infos, nil
+}
+
+// Revoke ends a session of the account, its refresh token stops working as well.
+func (s *AuthenticationService) Revoke(ctx context.Context, accountID, sessionID uuid.UUID) error {
+	sessions, err := s.sessionService.Find(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	if !lo.ContainsBy(sessions, func(item *entities.Session) bool {
+		return item.ID == sessionID
+	}) {
+		return e.ErrEntityNotFound
+	}
+
+	if err = s.tokenRepository.Delete(ctx, sessionID); err != nil {
+		return err
+	}
+
+	return s.sessionService.Delete(ctx, sessionID)
+}
+
+// RevokeOthers ends every session of the account except keepID, uuid.Nil ends all of them.
+func (s *AuthenticationService) RevokeOthers(ctx context.Context, accountID, keepID uuid.UUID) error {
+	tokens, err := s.tokenRepository.GetByAccountID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	for _, token := range tokens {
+		if token.ID == keepID {
+			continue
+		}
+
+		if err = s.tokenRepository.Delete(ctx, token.ID); err != nil {
+			return err
+		}
+	}
+
+	sessions, err := s.sessionService.Find(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		if session.ID == keepID {
+			continue
+		}
+
+		if err = s.sessionService.Delete(ctx, session.ID); err != nil && !errors.Is(err, e.ErrEntityNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (s *AuthenticationService) Logout(ctx context.Context, sessionID uuid.UUID, token string) (err error) {
 	if err = s.tokenRepository.DeleteByAccess(ctx, token); err != nil {
 		return
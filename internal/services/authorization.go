@@ -9,6 +9,9 @@ import (
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"strings"
+	"time"
 )
 
 const (
@@ -18,19 +21,23 @@ const (
 var (
 	ErrCanNotAssignRole             = errors.New("can not assign role")
 	ErrCanNotRevokeAdminPermissions = errors.New("cant revoke admin permissions")
+	ErrCanNotScopeAdminRole         = errors.New("only root can limit reports of admin roles")
 )
 
 type AuthorizationService struct {
 	accountService       *AccountService
 	roleRepository       repositories.RoleRepository
 	permissionRepository repositories.PermissionRepository
+	grantRepository      repositories.RoleGrantRepository
 }
 
-func NewAuthorizationService(accountService *AccountService, roleRepository repositories.RoleRepository, permissionRepository repositories.PermissionRepository) *AuthorizationService {
+func NewAuthorizationService(accountService *AccountService, roleRepository repositories.RoleRepository,
+	permissionRepository repositories.PermissionRepository, grantRepository repositories.RoleGrantRepository) *AuthorizationService {
 	return &AuthorizationService{
 		accountService:       accountService,
 		roleRepository:       roleRepository,
 		permissionRepository: permissionRepository,
+		grantRepository:      grantRepository,
 	}
 }
 
@@ -181,7 +188,33 @@ func (s *AuthorizationService) AssignRole(ctx context.Context, accountID, roleID
 		return err
 	}
 
-	return s.roleRepository.Assign(ctx, account, role)
+	grant := &entities.RoleGrant{ID: uuid.New(), AccountID: account.ID, RoleID: role.ID}
+	if organizationID := account.GetDefaultOrganizationID(); organizationID != uuid.Nil {
+		grant.OrganizationID = &organizationID
+	}
+
+	return s.ActivateRoleGrant(ctx, account, role, grant)
+}
+
+// ActivateRoleGrant assigns the role of the grant to the account and stores the grant as active.
+func (s *AuthorizationService) ActivateRoleGrant(ctx context.Context, account *entities.Account, role *entities.Role, grant *entities.RoleGrant) error {
+	if err := s.roleRepository.Assign(ctx, account, role, grant.ExpiresAt); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	grant.Status = entities.RoleGrantActive
+	grant.UpdatedAt = now
+
+	if grant.CreatedAt.IsZero() {
+		grant.CreatedAt = now
+
+		return s.grantRepository.CreateNoReturn(ctx, grant)
+	}
+
+	_, err := s.grantRepository.Save(ctx, grant)
+
+	return err
 }
 
 func (s *AuthorizationService) RevokeRole(ctx context.Context, accountID, roleID string) error {
@@ -195,7 +228,13 @@ func (s *AuthorizationService) RevokeRole(ctx context.Context, accountID, roleID
 		return err
 	}
 
-	return s.roleRepository.Revoke(ctx, account, role)
+	if err = s.roleRepository.Revoke(ctx, account, role); err != nil {
+		return err
+	}
+
+	_, err = s.grantRepository.End(ctx, account.ID, role.ID, entities.RoleGrantRevoked)
+
+	return err
 }
 
 //func (s *AuthorizationService) AssignAccountPermissions(ctx context.Context, accountID string, permissionIDs ...string) error {
@@ -282,6 +321,26 @@ func (s *AuthorizationService) RevokeRolePermissions(ctx context.Context, sessio
 	return s.permissionRepository.RevokeRolePermissions(ctx, role, permissions...)
 }
 
+// SetRoleReportScope limits the reports of the role, an empty scope removes the limits.
+func (s *AuthorizationService) SetRoleReportScope(ctx context.Context, session *entities.Session, roleID uuid.UUID, scope *entities.ReportScope) (*entities.Role, error) {
+	role, err := s.roleRepository.FindBy(ctx, map[string]interface{}{"id": roleID, "organization_id": session.OrganizationID})
+	if err != nil {
+		return nil, err
+	}
+
+	if role.Type == entities.AdminRoleTypeName && !session.Account.IsRoot() {
+		return nil, ErrCanNotScopeAdminRole
+	}
+
+	if scope.Empty() {
+		scope = nil
+	} else {
+		scope.Currencies = lo.Map(scope.Currencies, func(item string, _ int) string { return strings.ToUpper(item) })
+	}
+
+	return s.roleRepository.UpdateReportScope(ctx, role, scope)
+}
+
 func (s *AuthorizationService) DeleteRole(ctx context.Context, organizationID uuid.UUID, roleID string) error {
 	role, err := s.roleRepository.FindBy(ctx, map[string]interface{}{"id": roleID, "organization_id": organizationID})
 	if err != nil {
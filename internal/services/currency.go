@@ -13,6 +13,7 @@ import (
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"mime/multipart"
+	"sort"
 	"strings"
 	"time"
 )
@@ -318,3 +319,28 @@ func (s *CurrencyService) DeleteCurrencyRate(ctx context.Context, from, to strin
 
 	return nil
 }
+
+// Rates returns from->to exchange rates published between start and end ordered by date.
+func (s *CurrencyService) Rates(ctx context.Context, from, to string, start, end time.Time) ([]*entities.CurrencyExchange, error) {
+	from, to = strings.ToLower(from), strings.ToLower(to)
+
+	bag, err := s.exchangeClient.GetRates(ctx, from, []string{to}, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := lo.Map(bag[exchange.RateKey{From: from, To: to}], func(item *exchange.RateItem, index int) *entities.CurrencyExchange {
+		return &entities.CurrencyExchange{
+			CreatedAt: item.Date.AsTime(),
+			From:      from,
+			To:        to,
+			Rate:      item.Rate,
+		}
+	})
+
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].CreatedAt.Before(rates[j].CreatedAt)
+	})
+
+	return rates, nil
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"backoffice/internal/entities"
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultExportJobs             = 8
+	defaultOrganizationExportJobs = 2
+)
+
+// exportJob is a queued report generation of one file.
+type exportJob struct {
+	session *entities.Session
+	file    *entities.File
+	run     func(ctx context.Context) error
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// exportQueue starts jobs in submission order while keeping the global and the per-organization number
+// of running jobs under their caps. A job over the cap of its organization waits without blocking jobs
+// of other organizations queued behind it.
+type exportQueue struct {
+	mu sync.Mutex
+
+	limit             int
+	organizationLimit int
+
+	pending        []*exportJob
+	running        map[uuid.UUID]*exportJob
+	organizationOf map[uuid.UUID]int
+
+	start func(job *exportJob)
+}
+
+func newExportQueue(limit, organizationLimit int, start func(job *exportJob)) *exportQueue {
+	if limit <= 0 {
+		limit = defaultExportJobs
+	}
+
+	if organizationLimit <= 0 {
+		organizationLimit = defaultOrganizationExportJobs
+	}
+
+	return &exportQueue{
+		limit:             limit,
+		organizationLimit: organizationLimit,
+		running:           map[uuid.UUID]*exportJob{},
+		organizationOf:    map[uuid.UUID]int{},
+		start:             start,
+	}
+}
+
+// submit queues the job, the returned channel is closed once the job is finished or cancelled.
+func (q *exportQueue) submit(session *entities.Session, file *entities.File, run func(ctx context.Context) error) <-chan struct{} {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	job := &exportJob{
+		session: session,
+		file:    file,
+		run:     run,
+		ctx:     ctx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+
+	q.mu.Lock()
+	q.pending = append(q.pending, job)
+	q.dispatch()
+	q.mu.Unlock()
+
+	return job.done
+}
+
+// cancel stops a running job or drops a pending one, it reports whether the job was pending
+// and whether it is known to this queue at all.
+func (q *exportQueue) cancel(fileID uuid.UUID) (pending bool, found bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if job, ok := q.running[fileID]; ok {
+		job.cancel()
+
+		return false, true
+	}
+
+	for i, job := range q.pending {
+		if job.file.ID == fileID {
+			q.pending = append(q.pending[:i], q.pending[i+1:]...)
+			job.cancel()
+			close(job.done)
+
+			return true, true
+		}
+	}
+
+	return false, false
+}
+
+func (q *exportQueue) finish(job *exportJob) {
+	job.cancel()
+
+	q.mu.Lock()
+	delete(q.running, job.file.ID)
+	q.organizationOf[job.session.OrganizationID]--
+	q.dispatch()
+	q.mu.Unlock()
+
+	close(job.done)
+}
+
+// dispatch must be called with the lock held.
+func (q *exportQueue) dispatch() {
+	for i := 0; i < len(q.pending) && len(q.running) < q.limit; {
+		job := q.pending[i]
+
+		if q.organizationOf[job.session.OrganizationID] >= q.organizationLimit {
+			i++
+
+			continue
+		}
+
+		q.pending = append(q.pending[:i], q.pending[i+1:]...)
+		q.running[job.file.ID] = job
+		q.organizationOf[job.session.OrganizationID]++
+
+		go q.start(job)
+	}
+}
+
+// isTransient reports failures worth another attempt, such as a timed out or unavailable history service.
+func isTransient(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
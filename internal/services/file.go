@@ -5,10 +5,12 @@ import (
 	"backoffice/internal/repositories"
 	"backoffice/pkg/file"
 	"backoffice/utils"
-	"bytes"
 	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"reflect"
+	"io"
 	"sort"
 	"strconv"
 	"strings"
@@ -20,22 +22,41 @@ import (
 	"go.uber.org/zap"
 )
 
-const ()
+// exportPageSize is how many rows are requested from history per page while streaming an export.
+const exportPageSize = 5000
+
+var (
+	ErrFileIsNotReady = errors.New("file is not ready")
+	ErrFileIsFinished = errors.New("file is already finished")
+)
 
 type FileDownloadingService struct {
 	cfg         *file.Config
 	cfgClient   *ClientInfoConfig
 	spinService *SpinService
 	fileRepo    repositories.FileRepository
+	eventRepo   repositories.FileEventRepository
+	storage     file.Storage
+	jobs        *exportQueue
 }
 
-func NewFileDownloadingService(cfg *file.Config, cfgClient *ClientInfoConfig, spinService *SpinService, fileRepo repositories.FileRepository) *FileDownloadingService {
-	return &FileDownloadingService{
+// progressFunc reports how many rows of the expected total are already written.
+type progressFunc func(rows, total int)
+
+func NewFileDownloadingService(cfg *file.Config, cfgClient *ClientInfoConfig, spinService *SpinService,
+	fileRepo repositories.FileRepository, eventRepo repositories.FileEventRepository, storage file.Storage) *FileDownloadingService {
+	s := &FileDownloadingService{
 		cfg:         cfg,
 		cfgClient:   cfgClient,
 		spinService: spinService,
 		fileRepo:    fileRepo,
+		eventRepo:   eventRepo,
+		storage:     storage,
 	}
+
+	s.jobs = newExportQueue(cfg.Jobs, cfg.OrganizationJobs, s.runJob)
+
+	return s
 }
 
 func (s *FileDownloadingService) GetFiles(ctx context.Context, organizationID uuid.UUID) ([]entities.File, error) {
@@ -60,6 +81,173 @@ func (s *FileDownloadingService) GetFile(ctx context.Context, organizationID, id
 	return file, nil
 }
 
+// Follow streams export events of the session until ctx is done. With fileID only that file is followed:
+// its current state comes first and the channel is closed after its final event.
+func (s *FileDownloadingService) Follow(ctx context.Context, sessionID uuid.UUID, fileID *uuid.UUID) (<-chan *entities.FileEvent, error) {
+	if fileID == nil {
+		return s.eventRepo.Subscribe(ctx, sessionID)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	// subscribe before reading the current state, so an event published in between is not lost
+	events, err := s.eventRepo.Subscribe(ctx, sessionID)
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	file, err := s.GetFile(ctx, sessionID, *fileID)
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	current := file.Event(0)
+	out := make(chan *entities.FileEvent, 1)
+	out <- current
+
+	if current.IsFinal() {
+		cancel()
+		close(out)
+
+		return out, nil
+	}
+
+	go func() {
+		defer cancel()
+		defer close(out)
+
+		for event := range events {
+			if event.ID != *fileID {
+				continue
+			}
+
+			select {
+			case out <- event:
+			case <-ctx.Done():
+				return
+			}
+
+			if event.IsFinal() {
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// Open streams content of a ready file from storage, the caller closes the reader.
+func (s *FileDownloadingService) Open(ctx context.Context, file *entities.File) (io.ReadCloser, error) {
+	if file.Status != entities.FileStatusReady {
+		return nil, ErrFileIsNotReady
+	}
+
+	return s.storage.Open(ctx, file.ID.String())
+}
+
+// RunCleanup removes files whose metadata has already expired until ctx is cancelled.
+func (s *FileDownloadingService) RunCleanup(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.TTL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.storage.DeleteExpired(ctx, time.Now().Add(-s.cfg.TTL)); err != nil {
+				zap.S().Error(err)
+			}
+		}
+	}
+}
+
+// Cancel stops generation of a queued or running file.
+func (s *FileDownloadingService) Cancel(ctx context.Context, sessionID, id uuid.UUID) error {
+	file, err := s.GetFile(ctx, sessionID, id)
+	if err != nil {
+		return err
+	}
+
+	if file.Status.IsFinal() {
+		return ErrFileIsFinished
+	}
+
+	// a running job saves its cancelled status itself once it stops
+	if pending, found := s.jobs.cancel(id); found && !pending {
+		return nil
+	}
+
+	file.Status = entities.FileStatusCancelled
+	s.saveFile(ctx, sessionID, file, 0)
+
+	return nil
+}
+
+// runJob generates the file, retrying transient failures with an exponential backoff.
+func (s *FileDownloadingService) runJob(job *exportJob) {
+	defer s.jobs.finish(job)
+
+	ctx, sessionID, file := job.ctx, job.session.ID, job.file
+
+	file.Status = entities.FileStatusInProgress
+
+	var err error
+
+	for attempt := 1; ; attempt++ {
+		file.Attempts, file.Rows = attempt, 0
+		s.saveFile(ctx, sessionID, file, 0)
+
+		if err = job.run(ctx); err == nil || ctx.Err() != nil || !isTransient(err) || attempt > s.cfg.Retries {
+			break
+		}
+
+		zap.S().Warnf("export %s failed on attempt %d, retrying: %v", file.ID, attempt, err)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(s.cfg.RetryBackoff << (attempt - 1)):
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	// the job context may already be cancelled, final status is saved regardless
+	ctx = context.Background()
+
+	switch {
+	case job.ctx.Err() != nil:
+		if err := s.storage.Delete(ctx, file.ID.String()); err != nil {
+			zap.S().Error(err)
+		}
+
+		file.Status = entities.FileStatusCancelled
+		s.saveFile(ctx, sessionID, file, 0)
+	case err != nil:
+		s.saveFileWithError(ctx, sessionID, file, err)
+	case s.cancelledElsewhere(ctx, sessionID, file.ID):
+		if err := s.storage.Delete(ctx, file.ID.String()); err != nil {
+			zap.S().Error(err)
+		}
+	default:
+		file.Status = entities.FileStatusReady
+		s.saveFile(ctx, sessionID, file, 100)
+	}
+}
+
+// cancelledElsewhere reports whether the file was cancelled through another instance while it was generated here.
+func (s *FileDownloadingService) cancelledElsewhere(ctx context.Context, sessionID, id uuid.UUID) bool {
+	stored, err := s.fileRepo.Get(ctx, sessionID, id)
+
+	return err == nil && stored.Status == entities.FileStatusCancelled
+}
+
 func (s *FileDownloadingService) FinancialXLSX(ctx context.Context, session *entities.Session, req *entities.FinancialBase) (uuid.UUID, error) {
 	id := uuid.New()
 	name := time.Now().UTC().Format("finacial_report-20060102150405.xlsx")
@@ -67,7 +255,7 @@ func (s *FileDownloadingService) FinancialXLSX(ctx context.Context, session *ent
 	file := &entities.File{
 		ID:        id,
 		CreatedAt: time.Now(),
-		Status:    entities.FileStatusInProgress,
+		Status:    entities.FileStatusQueued,
 		Type:      entities.FileXLSX,
 		Name:      name,
 	}
@@ -76,7 +264,32 @@ func (s *FileDownloadingService) FinancialXLSX(ctx context.Context, session *ent
 		return id, err
 	}
 
-	go s.generateFinancialXLSX(ctx, session, req, file)
+	s.jobs.submit(session, file, func(ctx context.Context) error {
+		return s.generateFinancialXLSX(ctx, session, req, file)
+	})
+
+	return id, nil
+}
+
+func (s *FileDownloadingService) ConsolidatedFinancialXLSX(ctx context.Context, session *entities.Session, req *entities.ConsolidatedFinancialFilters) (uuid.UUID, error) {
+	id := uuid.New()
+	name := time.Now().UTC().Format("consolidated_financial_report-20060102150405.xlsx")
+
+	file := &entities.File{
+		ID:        id,
+		CreatedAt: time.Now(),
+		Status:    entities.FileStatusQueued,
+		Type:      entities.FileXLSX,
+		Name:      name,
+	}
+
+	if err := s.fileRepo.Create(ctx, session.ID, file, s.cfg.TTL); err != nil {
+		return id, err
+	}
+
+	s.jobs.submit(session, file, func(ctx context.Context) error {
+		return s.generateConsolidatedFinancialXLSX(ctx, session, req, file)
+	})
 
 	return id, nil
 }
@@ -88,7 +301,7 @@ func (s *FileDownloadingService) SpinsXLSX(ctx context.Context, session *entitie
 	file := &entities.File{
 		ID:        id,
 		CreatedAt: time.Now(),
-		Status:    entities.FileStatusInProgress,
+		Status:    entities.FileStatusQueued,
 		Type:      entities.FileXLSX,
 		Name:      name,
 	}
@@ -97,7 +310,9 @@ func (s *FileDownloadingService) SpinsXLSX(ctx context.Context, session *entitie
 		return id, err
 	}
 
-	go s.generateSpinsXLSX(ctx, session, req, file)
+	s.jobs.submit(session, file, func(ctx context.Context) error {
+		return s.generateSpinsXLSX(ctx, session, req, file)
+	})
 
 	return id, nil
 }
@@ -109,7 +324,7 @@ func (s *FileDownloadingService) SessionXLSX(ctx context.Context, session *entit
 	file := &entities.File{
 		ID:        id,
 		CreatedAt: time.Now(),
-		Status:    entities.FileStatusInProgress,
+		Status:    entities.FileStatusQueued,
 		Type:      entities.FileXLSX,
 		Name:      name,
 	}
@@ -118,7 +333,9 @@ func (s *FileDownloadingService) SessionXLSX(ctx context.Context, session *entit
 		return id, err
 	}
 
-	go s.generateSessionXLSX(ctx, session, req, file)
+	s.jobs.submit(session, file, func(ctx context.Context) error {
+		return s.generateSessionXLSX(ctx, session, req, file)
+	})
 
 	return id, nil
 }
@@ -129,7 +346,7 @@ func (s *FileDownloadingService) FinancialCSV(ctx context.Context, session *enti
 	file := &entities.File{
 		ID:        id,
 		CreatedAt: time.Now(),
-		Status:    entities.FileStatusInProgress,
+		Status:    entities.FileStatusQueued,
 		Type:      entities.FileCSV,
 		Name:      "financial",
 	}
@@ -138,7 +355,31 @@ func (s *FileDownloadingService) FinancialCSV(ctx context.Context, session *enti
 		return id, err
 	}
 
-	go s.generateFinancialCVS(ctx, session, req, file)
+	s.jobs.submit(session, file, func(ctx context.Context) error {
+		return s.generateFinancialCVS(ctx, session, req, file)
+	})
+
+	return id, nil
+}
+
+func (s *FileDownloadingService) ConsolidatedFinancialCSV(ctx context.Context, session *entities.Session, req *entities.ConsolidatedFinancialFilters) (uuid.UUID, error) {
+	id := uuid.New()
+
+	file := &entities.File{
+		ID:        id,
+		CreatedAt: time.Now(),
+		Status:    entities.FileStatusQueued,
+		Type:      entities.FileCSV,
+		Name:      "consolidated-financial",
+	}
+
+	if err := s.fileRepo.Create(ctx, session.ID, file, s.cfg.TTL); err != nil {
+		return id, err
+	}
+
+	s.jobs.submit(session, file, func(ctx context.Context) error {
+		return s.generateConsolidatedFinancialCSV(ctx, session, req, file)
+	})
 
 	return id, nil
 }
@@ -149,7 +390,7 @@ func (s *FileDownloadingService) SpinsCSV(ctx context.Context, session *entities
 	file := &entities.File{
 		ID:        id,
 		CreatedAt: time.Now(),
-		Status:    entities.FileStatusInProgress,
+		Status:    entities.FileStatusQueued,
 		Type:      entities.FileCSV,
 		Name:      "spins",
 	}
@@ -158,7 +399,9 @@ func (s *FileDownloadingService) SpinsCSV(ctx context.Context, session *entities
 		return id, err
 	}
 
-	go s.generateSpinsCVS(ctx, session, req, file)
+	s.jobs.submit(session, file, func(ctx context.Context) error {
+		return s.generateSpinsCVS(ctx, session, req, file)
+	})
 
 	return id, nil
 }
@@ -169,7 +412,7 @@ func (s *FileDownloadingService) SessionCSV(ctx context.Context, session *entiti
 	file := &entities.File{
 		ID:        id,
 		CreatedAt: time.Now(),
-		Status:    entities.FileStatusInProgress,
+		Status:    entities.FileStatusQueued,
 		Type:      entities.FileCSV,
 		Name:      "gaming-sessions",
 	}
@@ -178,7 +421,9 @@ func (s *FileDownloadingService) SessionCSV(ctx context.Context, session *entiti
 		return id, err
 	}
 
-	go s.generateSessionCSV(ctx, session, req, file)
+	s.jobs.submit(session, file, func(ctx context.Context) error {
+		return s.generateSessionCSV(ctx, session, req, file)
+	})
 
 	return id, nil
 }
@@ -189,7 +434,7 @@ func (s *FileDownloadingService) AggregatedByGameCSV(ctx context.Context, sessio
 	file := &entities.File{
 		ID:        id,
 		CreatedAt: time.Now(),
-		Status:    entities.FileStatusInProgress,
+		Status:    entities.FileStatusQueued,
 		Type:      entities.FileCSV,
 		Name:      "report-by-game",
 	}
@@ -198,7 +443,9 @@ func (s *FileDownloadingService) AggregatedByGameCSV(ctx context.Context, sessio
 		return id, err
 	}
 
-	go s.generateAggregatedByGameCSV(ctx, session, req, file)
+	s.jobs.submit(session, file, func(ctx context.Context) error {
+		return s.generateAggregatedByGameCSV(ctx, session, req, file)
+	})
 
 	return id, nil
 }
@@ -209,7 +456,7 @@ func (s *FileDownloadingService) AggregatedByGameXLSX(ctx context.Context, sessi
 	file := &entities.File{
 		ID:        id,
 		CreatedAt: time.Now(),
-		Status:    entities.FileStatusInProgress,
+		Status:    entities.FileStatusQueued,
 		Type:      entities.FileXLSX,
 		Name:      name,
 	}
@@ -218,7 +465,9 @@ func (s *FileDownloadingService) AggregatedByGameXLSX(ctx context.Context, sessi
 		return id, err
 	}
 
-	go s.generateAggregatedByGameXLSX(ctx, session, req, file)
+	s.jobs.submit(session, file, func(ctx context.Context) error {
+		return s.generateAggregatedByGameXLSX(ctx, session, req, file)
+	})
 
 	return id, nil
 }
@@ -229,7 +478,7 @@ func (s *FileDownloadingService) AggregatedByCountryCSV(ctx context.Context, ses
 	file := &entities.File{
 		ID:        id,
 		CreatedAt: time.Now(),
-		Status:    entities.FileStatusInProgress,
+		Status:    entities.FileStatusQueued,
 		Type:      entities.FileCSV,
 		Name:      "report-by-country",
 	}
@@ -238,7 +487,9 @@ func (s *FileDownloadingService) AggregatedByCountryCSV(ctx context.Context, ses
 		return id, err
 	}
 
-	go s.generateAggregatedByCountryCSV(ctx, session, req, file)
+	s.jobs.submit(session, file, func(ctx context.Context) error {
+		return s.generateAggregatedByCountryCSV(ctx, session, req, file)
+	})
 
 	return id, nil
 }
@@ -249,7 +500,7 @@ func (s *FileDownloadingService) AggregatedByCountryXLSX(ctx context.Context, se
 	file := &entities.File{
 		ID:        id,
 		CreatedAt: time.Now(),
-		Status:    entities.FileStatusInProgress,
+		Status:    entities.FileStatusQueued,
 		Type:      entities.FileXLSX,
 		Name:      name,
 	}
@@ -258,10 +509,114 @@ func (s *FileDownloadingService) AggregatedByCountryXLSX(ctx context.Context, se
 		return id, err
 	}
 
-	go s.generateAggregatedByCountryXLSX(ctx, session, req, file)
+	s.jobs.submit(session, file, func(ctx context.Context) error {
+		return s.generateAggregatedByCountryXLSX(ctx, session, req, file)
+	})
 
 	return id, nil
 }
+
+// Generate builds a report synchronously, used by report schedules where nobody waits on the websocket.
+func (s *FileDownloadingService) Generate(ctx context.Context, session *entities.Session, reportType string, payload []byte) (*entities.File, error) {
+	file := &entities.File{
+		ID:        uuid.New(),
+		CreatedAt: time.Now(),
+		Status:    entities.FileStatusQueued,
+		Type:      entities.ReportFileType(reportType),
+	}
+
+	name := strings.TrimSuffix(reportType, "_"+string(file.Type))
+	file.Name = fmt.Sprintf("%s-%s.%s", name, time.Now().UTC().Format("20060102150405"), file.Type)
+
+	if err := s.fileRepo.Create(ctx, session.ID, file, s.cfg.TTL); err != nil {
+		return nil, err
+	}
+
+	financial, aggregated := &entities.FinancialBase{}, &entities.AggregateFilters{}
+
+	var err error
+	if entities.IsFinancialReportType(reportType) {
+		err = json.Unmarshal(payload, financial)
+	} else {
+		err = json.Unmarshal(payload, aggregated)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var run func(ctx context.Context) error
+
+	switch reportType {
+	case entities.ReportTypeFinancialCSV:
+		run = func(ctx context.Context) error {
+			return s.generateFinancialCVS(ctx, session, financial, file)
+		}
+	case entities.ReportTypeFinancialXLSX:
+		run = func(ctx context.Context) error {
+			return s.generateFinancialXLSX(ctx, session, financial, file)
+		}
+	case entities.ReportTypeSpinsCSV:
+		run = func(ctx context.Context) error {
+			return s.generateSpinsCVS(ctx, session, financial, file)
+		}
+	case entities.ReportTypeSpinsXLSX:
+		run = func(ctx context.Context) error {
+			return s.generateSpinsXLSX(ctx, session, financial, file)
+		}
+	case entities.ReportTypeSessionsCSV:
+		run = func(ctx context.Context) error {
+			return s.generateSessionCSV(ctx, session, financial, file)
+		}
+	case entities.ReportTypeSessionsXLSX:
+		run = func(ctx context.Context) error {
+			return s.generateSessionXLSX(ctx, session, financial, file)
+		}
+	case entities.ReportTypeAggregatedByGameCSV:
+		run = func(ctx context.Context) error {
+			return s.generateAggregatedByGameCSV(ctx, session, aggregated, file)
+		}
+	case entities.ReportTypeAggregatedByGameXLSX:
+		run = func(ctx context.Context) error {
+			return s.generateAggregatedByGameXLSX(ctx, session, aggregated, file)
+		}
+	case entities.ReportTypeAggregatedByCountryCSV:
+		run = func(ctx context.Context) error {
+			return s.generateAggregatedByCountryCSV(ctx, session, aggregated, file)
+		}
+	case entities.ReportTypeAggregatedByCountryXLSX:
+		run = func(ctx context.Context) error {
+			return s.generateAggregatedByCountryXLSX(ctx, session, aggregated, file)
+		}
+	default:
+		return nil, fmt.Errorf("unknown report type: %s", reportType)
+	}
+
+	done := s.jobs.submit(session, file, run)
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		s.jobs.cancel(file.ID)
+
+		return nil, ctx.Err()
+	}
+
+	file, err = s.fileRepo.Get(ctx, session.ID, file.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch file.Status {
+	case entities.FileStatusError:
+		return nil, errors.New(string(file.Data))
+	case entities.FileStatusReady:
+		return file, nil
+	default:
+		return nil, fmt.Errorf("report is %s", file.Status)
+	}
+}
+
 func (s *FileDownloadingService) ExportCurrencyXLSX(currencyInfo *entities.CurrencyInfo) (*excelize.File, string, error) {
 
 	if len(currencyInfo.Table) == 0 {
@@ -327,276 +682,393 @@ func generateFileName(params ...string) string {
 	return fmt.Sprintf("%s.xlsx", strings.Join(params, "_"))
 }
 
-func (s *FileDownloadingService) generateFinancialXLSX(ctx context.Context, session *entities.Session, req *entities.FinancialBase, file *entities.File) {
-	groupedReport, err := s.spinService.FinancialReport(ctx, &session.OrganizationID, req)
+func (s *FileDownloadingService) generateFinancialXLSX(ctx context.Context, session *entities.Session, req *entities.FinancialBase, file *entities.File) error {
+	groupedReport, err := s.spinService.FinancialReport(ctx, session.ReportAccess(), req)
 	if err != nil {
-		s.saveFileWithError(ctx, session.ID, file, err)
-
-		return
+		return err
 	}
 
-	spins, err := s.spinService.AllSpins(ctx, &session.OrganizationID, req)
-	if err != nil {
-		s.saveFileWithError(ctx, session.ID, file, err)
-
-		return
+	var series []*entities.ReportSeriesItem
+	if req.IsSeries() {
+		if series, err = s.spinService.FinancialSeries(ctx, session.ReportAccess(), req); err != nil {
+			return err
+		}
 	}
 
-	lo.ForEach(spins, func(item *entities.Spin, index int) {
-		item.Prettify()
-	})
-
-	var pages []utils.Page
-	separator := make([][]string, 3)
+	return s.exportXLSX(ctx, file, func(x *utils.XLSXStream) error {
+		if err := x.NewSheet("total"); err != nil {
+			return err
+		}
 
-	exchangeInfo := make([][]string, 2)
-	exchangeInfo[0] = []string{"Exchange currency", *req.Currency}
-	totalPageData := []*entities.FinancialReport{groupedReport.Prettify()}
+		if err := x.WriteRow([]string{"Exchange currency", *req.Currency}); err != nil {
+			return err
+		}
 
-	table := exchangeInfo
-	table = append(table, utils.ExtractTable(totalPageData, "xlsx")...)
-	table = append(table, separator...)
-	table = append(table, utils.ExtractTable(spins, "xlsx")...)
+		if err := x.WriteRow(nil); err != nil {
+			return err
+		}
 
-	pages = append([]utils.Page{{
-		Name:  "total",
-		Table: table,
-	}}, pages...)
+		if err := x.WriteTable(utils.ExtractTable([]*entities.FinancialReport{groupedReport.Prettify()}, "xlsx")); err != nil {
+			return err
+		}
 
-	xlsx, err := utils.ExportMultiPageXLSX(pages)
-	if err != nil {
-		s.saveFileWithError(ctx, session.ID, file, err)
+		for i := 0; i < 3; i++ {
+			if err := x.WriteRow(nil); err != nil {
+				return err
+			}
+		}
 
-		return
-	}
+		if err := streamXLSX(x, s.spinPages(ctx, session, req), s.progress(ctx, session.ID, file)); err != nil {
+			return err
+		}
 
-	s.saveFileXLSX(ctx, session.ID, file, xlsx)
+		return writeSeriesSheet(x, series)
+	})
 }
 
-func (s *FileDownloadingService) generateSpinsXLSX(ctx context.Context, session *entities.Session, req *entities.FinancialBase, file *entities.File) {
-	spins, err := s.spinService.AllSpins(ctx, &session.OrganizationID, req)
+func (s *FileDownloadingService) generateConsolidatedFinancialXLSX(ctx context.Context, session *entities.Session, req *entities.ConsolidatedFinancialFilters, file *entities.File) error {
+	rep, err := s.spinService.ConsolidatedFinancialReport(ctx, session.ReportAccess(), req)
 	if err != nil {
-		s.saveFileWithError(ctx, session.ID, file, err)
+		return err
+	}
+
+	rep.Prettify()
 
-		return
+	exchangeInfo := [][]string{{"Target currency", rep.Currency}, {"Rate mode", rep.RateMode}}
+	if rep.RateAt != nil {
+		exchangeInfo = append(exchangeInfo, []string{"Rate at", rep.RateAt.Format(time.RFC3339)})
 	}
 
-	data := lo.Map(spins, func(item *entities.Spin, index int) *entities.Spin {
-		return item.Prettify()
-	})
+	return s.exportXLSX(ctx, file, func(x *utils.XLSXStream) error {
+		if err := x.NewSheet("total"); err != nil {
+			return err
+		}
 
-	table := utils.ExtractTable(data, "xlsx")
-	xlsx, err := utils.ExportXLSX(table)
-	if err != nil {
-		s.saveFileWithError(ctx, session.ID, file, err)
+		for _, row := range append(exchangeInfo, nil) {
+			if err := x.WriteRow(row); err != nil {
+				return err
+			}
+		}
 
-		return
-	}
+		if err := x.WriteTable(utils.ExtractTable([]*entities.FinancialReport{rep.Total}, "xlsx")); err != nil {
+			return err
+		}
 
-	s.saveFileXLSX(ctx, session.ID, file, xlsx)
-}
+		for i := 0; i < 3; i++ {
+			if err := x.WriteRow(nil); err != nil {
+				return err
+			}
+		}
 
-func (s *FileDownloadingService) generateSessionXLSX(ctx context.Context, session *entities.Session, req *entities.FinancialBase, file *entities.File) {
-	gamingSessions, err := s.spinService.AllGamingSessions(ctx, &session.OrganizationID, req)
-	if err != nil {
-		s.saveFileWithError(ctx, session.ID, file, err)
+		if err := x.WriteTable(utils.ExtractTable(rep.Items, "xlsx")); err != nil {
+			return err
+		}
 
-		return
-	}
+		if err := x.NewSheet("rates"); err != nil {
+			return err
+		}
 
-	lo.ForEach(gamingSessions, func(item *entities.GamingSession, index int) {
-		item.Prettify()
+		return x.WriteTable(utils.ExtractTable(rep.RateRows(), "xlsx"))
 	})
+}
 
-	table := utils.ExtractTable(gamingSessions, "xlsx")
-	xlsx, err := utils.ExportXLSX(table)
-	if err != nil {
-		s.saveFileWithError(ctx, session.ID, file, err)
-
-		return
-	}
+func (s *FileDownloadingService) generateSpinsXLSX(ctx context.Context, session *entities.Session, req *entities.FinancialBase, file *entities.File) error {
+	return s.exportXLSX(ctx, file, func(x *utils.XLSXStream) error {
+		return streamXLSX(x, s.spinPages(ctx, session, req), s.progress(ctx, session.ID, file))
+	})
+}
 
-	s.saveFileXLSX(ctx, session.ID, file, xlsx)
+func (s *FileDownloadingService) generateSessionXLSX(ctx context.Context, session *entities.Session, req *entities.FinancialBase, file *entities.File) error {
+	return s.exportXLSX(ctx, file, func(x *utils.XLSXStream) error {
+		return streamXLSX(x, s.sessionPages(ctx, session, req), s.progress(ctx, session.ID, file))
+	})
 }
 
-func (s *FileDownloadingService) generateAggregatedByGameXLSX(ctx context.Context, session *entities.Session, req *entities.AggregateFilters, file *entities.File) {
-	aggregatedReps, err := s.spinService.AggregatedReportByGame(ctx, &session.OrganizationID, *req.Currency, nil, req)
+func (s *FileDownloadingService) generateAggregatedByGameXLSX(ctx context.Context, session *entities.Session, req *entities.AggregateFilters, file *entities.File) error {
+	aggregatedReps, err := s.spinService.AggregatedReportByGame(ctx, session.ReportAccess(), *req.Currency, nil, req)
 	if err != nil {
-		s.saveFileWithError(ctx, session.ID, file, err)
-
-		return
+		return err
 	}
 
 	lo.ForEach(aggregatedReps, func(item *entities.AggregatedReportByGame, index int) {
 		item.Prettify()
 	})
 
-	table := utils.ExtractTable(aggregatedReps, "xlsx")
-	xlsx, err := utils.ExportXLSX(table)
-	if err != nil {
-		s.saveFileWithError(ctx, session.ID, file, err)
-
-		return
-	}
-
-	s.saveFileXLSX(ctx, session.ID, file, xlsx)
+	return s.generateAggregatedXLSX(ctx, session, req, file, utils.ExtractTable(aggregatedReps, "xlsx"))
 }
 
-func (s *FileDownloadingService) generateAggregatedByCountryXLSX(ctx context.Context, session *entities.Session, req *entities.AggregateFilters, file *entities.File) {
-	aggregatedReps, err := s.spinService.AggregatedReportByCountry(ctx, &session.OrganizationID, *req.Currency, nil, req)
+func (s *FileDownloadingService) generateAggregatedByCountryXLSX(ctx context.Context, session *entities.Session, req *entities.AggregateFilters, file *entities.File) error {
+	aggregatedReps, err := s.spinService.AggregatedReportByCountry(ctx, session.ReportAccess(), *req.Currency, nil, req)
 	if err != nil {
-		s.saveFileWithError(ctx, session.ID, file, err)
-
-		return
+		return err
 	}
 
 	lo.ForEach(aggregatedReps, func(item *entities.AggregatedReportByCountry, index int) {
 		item.Prettify()
 	})
 
-	table := utils.ExtractTable(aggregatedReps, "xlsx")
-	xlsx, err := utils.ExportXLSX(table)
-	if err != nil {
-		s.saveFileWithError(ctx, session.ID, file, err)
+	return s.generateAggregatedXLSX(ctx, session, req, file, utils.ExtractTable(aggregatedReps, "xlsx"))
+}
+
+func (s *FileDownloadingService) generateAggregatedXLSX(ctx context.Context, session *entities.Session, req *entities.AggregateFilters, file *entities.File, table [][]string) error {
+	var (
+		series []*entities.ReportSeriesItem
+		err    error
+	)
 
-		return
+	if req.IsSeries() {
+		if series, err = s.spinService.AggregatedSeries(ctx, session.ReportAccess(), req); err != nil {
+			return err
+		}
 	}
 
-	s.saveFileXLSX(ctx, session.ID, file, xlsx)
+	return s.exportXLSX(ctx, file, func(x *utils.XLSXStream) error {
+		if err := x.NewSheet("report"); err != nil {
+			return err
+		}
+
+		if err := x.WriteTable(table); err != nil {
+			return err
+		}
+
+		return writeSeriesSheet(x, series)
+	})
 }
 
-func (s *FileDownloadingService) generateFinancialCVS(ctx context.Context, session *entities.Session, req *entities.FinancialBase, file *entities.File) {
-	rep, err := s.spinService.FinancialReport(ctx, &session.OrganizationID, req)
+func (s *FileDownloadingService) generateFinancialCVS(ctx context.Context, session *entities.Session, req *entities.FinancialBase, file *entities.File) error {
+	rep, err := s.spinService.FinancialReport(ctx, session.ReportAccess(), req)
 	if err != nil {
-		s.saveFileWithError(ctx, session.ID, file, err)
-
-		return
+		return err
 	}
 
-	file.Status = entities.FileStatusReady
-	file.Array = append(file.Array, rep.Prettify())
-	file.ReflectType = reflect.TypeOf(file.Array[0]).Name()
-	err = s.fileRepo.Update(ctx, session.ID, file, s.cfg.TTL)
+	return s.exportCSV(ctx, file, utils.ExtractTable([]*entities.FinancialReport{rep.Prettify()}, "csv"))
+}
+
+func (s *FileDownloadingService) generateConsolidatedFinancialCSV(ctx context.Context, session *entities.Session, req *entities.ConsolidatedFinancialFilters, file *entities.File) error {
+	rep, err := s.spinService.ConsolidatedFinancialReport(ctx, session.ReportAccess(), req)
 	if err != nil {
-		zap.S().Error(err)
+		return err
 	}
+
+	return s.exportCSV(ctx, file, utils.ExtractTable(rep.Prettify().Table(), "csv"))
 }
 
-func (s *FileDownloadingService) generateAggregatedByGameCSV(ctx context.Context, session *entities.Session, req *entities.AggregateFilters, file *entities.File) {
-	aggregatedReps, err := s.spinService.AggregatedReportByGame(ctx, &session.OrganizationID, *req.Currency, nil, req)
+func (s *FileDownloadingService) generateAggregatedByGameCSV(ctx context.Context, session *entities.Session, req *entities.AggregateFilters, file *entities.File) error {
+	aggregatedReps, err := s.spinService.AggregatedReportByGame(ctx, session.ReportAccess(), *req.Currency, nil, req)
 	if err != nil {
-		s.saveFileWithError(ctx, session.ID, file, err)
-
-		return
+		return err
 	}
 
 	lo.ForEach(aggregatedReps, func(item *entities.AggregatedReportByGame, index int) {
 		item.Prettify()
 	})
 
-	file.Status = entities.FileStatusReady
-
-	for _, elem := range aggregatedReps {
-		file.Array = append(file.Array, elem)
-	}
-
-	file.ReflectType = reflect.TypeOf(file.Array[0]).Name()
-	err = s.fileRepo.Update(ctx, session.ID, file, s.cfg.TTL)
-	if err != nil {
-		zap.S().Error(err)
-	}
+	return s.exportCSV(ctx, file, utils.ExtractTable(aggregatedReps, "csv"))
 }
-func (s *FileDownloadingService) generateAggregatedByCountryCSV(ctx context.Context, session *entities.Session, req *entities.AggregateFilters, file *entities.File) {
-	aggregatedReps, err := s.spinService.AggregatedReportByCountry(ctx, &session.OrganizationID, *req.Currency, nil, req)
-	if err != nil {
-		s.saveFileWithError(ctx, session.ID, file, err)
 
-		return
+func (s *FileDownloadingService) generateAggregatedByCountryCSV(ctx context.Context, session *entities.Session, req *entities.AggregateFilters, file *entities.File) error {
+	aggregatedReps, err := s.spinService.AggregatedReportByCountry(ctx, session.ReportAccess(), *req.Currency, nil, req)
+	if err != nil {
+		return err
 	}
 
 	lo.ForEach(aggregatedReps, func(item *entities.AggregatedReportByCountry, index int) {
 		item.Prettify()
 	})
 
-	file.Status = entities.FileStatusReady
+	return s.exportCSV(ctx, file, utils.ExtractTable(aggregatedReps, "csv"))
+}
 
-	for _, elem := range aggregatedReps {
-		file.Array = append(file.Array, elem)
-	}
+func (s *FileDownloadingService) generateSpinsCVS(ctx context.Context, session *entities.Session, req *entities.FinancialBase, file *entities.File) error {
+	return s.export(ctx, file, func(w io.Writer) error {
+		return streamCSV(w, s.spinPages(ctx, session, req), s.progress(ctx, session.ID, file))
+	})
+}
 
-	file.ReflectType = reflect.TypeOf(file.Array[0]).Name()
-	err = s.fileRepo.Update(ctx, session.ID, file, s.cfg.TTL)
-	if err != nil {
-		zap.S().Error(err)
-	}
+func (s *FileDownloadingService) generateSessionCSV(ctx context.Context, session *entities.Session, req *entities.FinancialBase, file *entities.File) error {
+	return s.export(ctx, file, func(w io.Writer) error {
+		return streamCSV(w, s.sessionPages(ctx, session, req), s.progress(ctx, session.ID, file))
+	})
 }
 
-func (s *FileDownloadingService) generateSpinsCVS(ctx context.Context, session *entities.Session, req *entities.FinancialBase, file *entities.File) {
-	spins, err := s.spinService.AllSpins(ctx, &session.OrganizationID, req)
-	if err != nil {
-		s.saveFileWithError(ctx, session.ID, file, err)
+func (s *FileDownloadingService) spinPages(ctx context.Context, session *entities.Session, req *entities.FinancialBase) pageFunc[entities.Spin] {
+	return func(page int) ([]*entities.Spin, int, error) {
+		pagination, err := s.spinService.Paginate(ctx, session.ReportAccess(), req, "", exportPageSize, page)
+		if err != nil {
+			return nil, 0, err
+		}
 
-		return
+		return lo.Map(pagination.Items, func(item *entities.Spin, index int) *entities.Spin {
+			return item.Prettify()
+		}), pagination.Total, nil
 	}
+}
 
-	file.Status = entities.FileStatusReady
+func (s *FileDownloadingService) sessionPages(ctx context.Context, session *entities.Session, req *entities.FinancialBase) pageFunc[entities.GamingSession] {
+	return func(page int) ([]*entities.GamingSession, int, error) {
+		pagination, err := s.spinService.PaginateGamingSession(ctx, session.ReportAccess(), req, "", exportPageSize, page)
+		if err != nil {
+			return nil, 0, err
+		}
 
-	for _, item := range spins {
-		file.Array = append(file.Array, item.Prettify())
+		return lo.Map(pagination.Items, func(item *entities.GamingSession, index int) *entities.GamingSession {
+			return item.Prettify()
+		}), pagination.Total, nil
 	}
+}
 
-	file.ReflectType = reflect.TypeOf(file.Array[0]).Name()
+// progress publishes an in-progress event per written page, the percentage stays below 100 until the file is stored.
+func (s *FileDownloadingService) progress(ctx context.Context, sessionID uuid.UUID, file *entities.File) progressFunc {
+	return func(rows, total int) {
+		percent := 0
+		if total > 0 {
+			percent = lo.Min([]int{rows * 100 / total, 99})
+		}
 
-	err = s.fileRepo.Update(ctx, session.ID, file, s.cfg.TTL)
-	if err != nil {
+		file.Rows = rows
+		s.publish(ctx, sessionID, file.Event(percent))
+	}
+}
+
+func (s *FileDownloadingService) publish(ctx context.Context, sessionID uuid.UUID, event *entities.FileEvent) {
+	if err := s.eventRepo.Publish(ctx, sessionID, event); err != nil {
 		zap.S().Error(err)
 	}
 }
 
-func (s *FileDownloadingService) generateSessionCSV(ctx context.Context, session *entities.Session, req *entities.FinancialBase, file *entities.File) {
-	gamingSessions, err := s.spinService.AllGamingSessions(ctx, &session.OrganizationID, req)
+// export streams the file content into storage, a failed export leaves nothing in storage.
+func (s *FileDownloadingService) export(ctx context.Context, file *entities.File, write func(w io.Writer) error) error {
+	w, err := s.storage.Create(ctx, file.ID.String())
 	if err != nil {
-		s.saveFileWithError(ctx, session.ID, file, err)
-
-		return
+		return err
 	}
 
-	file.Status = entities.FileStatusReady
+	err = write(w)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
 
-	for _, item := range gamingSessions {
-		file.Array = append(file.Array, item.Prettify())
+	if err != nil {
+		if err := s.storage.Delete(context.Background(), file.ID.String()); err != nil {
+			zap.S().Error(err)
+		}
 	}
 
-	file.ReflectType = reflect.TypeOf(file.Array[0]).Name()
+	return err
+}
 
-	err = s.fileRepo.Update(ctx, session.ID, file, s.cfg.TTL)
-	if err != nil {
+func (s *FileDownloadingService) exportCSV(ctx context.Context, file *entities.File, table [][]string) error {
+	return s.export(ctx, file, func(w io.Writer) error {
+		return csv.NewWriter(w).WriteAll(table)
+	})
+}
+
+func (s *FileDownloadingService) exportXLSX(ctx context.Context, file *entities.File, write func(x *utils.XLSXStream) error) error {
+	return s.export(ctx, file, func(w io.Writer) error {
+		x, err := utils.NewXLSXStream()
+		if err != nil {
+			return err
+		}
+
+		if err = write(x); err != nil {
+			_ = x.Close()
+
+			return err
+		}
+
+		_, err = x.WriteTo(w)
+
+		return err
+	})
+}
+
+// saveFile stores the file status and notifies subscribers.
+func (s *FileDownloadingService) saveFile(ctx context.Context, sessionID uuid.UUID, file *entities.File, progress int) {
+	if err := s.fileRepo.Update(ctx, sessionID, file, s.cfg.TTL); err != nil {
 		zap.S().Error(err)
 	}
+
+	s.publish(ctx, sessionID, file.Event(progress))
 }
 
 func (s *FileDownloadingService) saveFileWithError(ctx context.Context, sessionID uuid.UUID, file *entities.File, err error) {
 	file.Status = entities.FileStatusError
 	file.Data = []byte(err.Error())
 
-	err = s.fileRepo.Update(ctx, sessionID, file, s.cfg.TTL)
-	if err != nil {
-		zap.S().Error(err)
+	s.saveFile(ctx, sessionID, file, 0)
+}
+
+// pageFunc returns one page of export rows together with the total number of rows.
+type pageFunc[T any] func(page int) ([]*T, int, error)
+
+// eachPage requests pages until a short one and passes every page to fn as a table with a header row.
+func eachPage[T any](tag string, next pageFunc[T], progress progressFunc, fn func(table [][]string, first bool) error) error {
+	rows := 0
+
+	for page := 1; ; page++ {
+		items, total, err := next(page)
+		if err != nil {
+			return err
+		}
+
+		if len(items) == 0 {
+			return nil
+		}
+
+		if err = fn(utils.ExtractTable(items, tag), page == 1); err != nil {
+			return err
+		}
+
+		rows += len(items)
+		progress(rows, total)
+
+		if len(items) < exportPageSize {
+			return nil
+		}
 	}
 }
 
-func (s *FileDownloadingService) saveFileXLSX(ctx context.Context, sessionID uuid.UUID, file *entities.File, xlsx *excelize.File) {
-	var b bytes.Buffer
-	if err := xlsx.Write(&b); err != nil {
-		s.saveFileWithError(ctx, sessionID, file, err)
-		return
+func streamCSV[T any](w io.Writer, next pageFunc[T], progress progressFunc) error {
+	writer := csv.NewWriter(w)
+
+	return eachPage("csv", next, progress, func(table [][]string, first bool) error {
+		if !first {
+			table = table[1:]
+		}
+
+		return writer.WriteAll(table)
+	})
+}
+
+func streamXLSX[T any](x *utils.XLSXStream, next pageFunc[T], progress progressFunc) error {
+	return eachPage("xlsx", next, progress, func(table [][]string, first bool) error {
+		if first {
+			return x.WriteTable(table)
+		}
+
+		for _, row := range table[1:] {
+			if err := x.WriteRow(row); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// writeSeriesSheet puts a report time series on its own sheet so it can be charted in place.
+func writeSeriesSheet(x *utils.XLSXStream, series []*entities.ReportSeriesItem) error {
+	if len(series) == 0 {
+		return nil
 	}
 
-	file.Status = entities.FileStatusReady
-	file.Data = b.Bytes()
+	lo.ForEach(series, func(item *entities.ReportSeriesItem, index int) {
+		item.Prettify()
+	})
 
-	err := s.fileRepo.Update(ctx, sessionID, file, s.cfg.TTL)
-	if err != nil {
-		zap.S().Error(err)
+	if err := x.NewSheet("series"); err != nil {
+		return err
 	}
+
+	return x.WriteTable(utils.ExtractTable(series, "xlsx"))
 }
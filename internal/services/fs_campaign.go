@@ -0,0 +1,288 @@
+package services
+
+import (
+	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
+	"backoffice/internal/repositories"
+	"backoffice/internal/transport/http/requests"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"time"
+)
+
+var (
+	ErrCampaignGameIsNotFreeSpins   = errors.New("game does not support free spins")
+	ErrCampaignIntegratorNotAllowed = errors.New("integrator is not available for current organization")
+	ErrCampaignInvalidDates         = errors.New("campaign end date must be after start date")
+	ErrCampaignInvalidStatus        = errors.New("invalid campaign status")
+	ErrCampaignStatusTransition     = errors.New("campaign status transition is not allowed")
+	ErrCampaignNotEditable          = errors.New("campaign can not be changed in current status")
+	ErrCampaignCurrencyNotSupported = errors.New("currency is not supported by campaign")
+)
+
+type FreeSpinCampaignService struct {
+	campaignRepo        repositories.BaseRepository[entities.FreeSpinCampaign]
+	userRepo            repositories.BaseRepository[entities.FreeSpinUser]
+	gameService         *GameService
+	organizationService *OrganizationService
+}
+
+func NewFreeSpinCampaignService(
+	campaignRepo repositories.BaseRepository[entities.FreeSpinCampaign],
+	userRepo repositories.BaseRepository[entities.FreeSpinUser],
+	gameService *GameService,
+	organizationService *OrganizationService,
+) *FreeSpinCampaignService {
+	return &FreeSpinCampaignService{
+		campaignRepo:        campaignRepo,
+		userRepo:            userRepo,
+		gameService:         gameService,
+		organizationService: organizationService,
+	}
+}
+
+func (s *FreeSpinCampaignService) Paginate(ctx context.Context, organizationID uuid.UUID, filters map[string]interface{}, limit int, page int) (
+	pagination entities.Pagination[entities.FreeSpinCampaign], err error) {
+	integratorIDs, err := s.visibleIntegratorIDs(ctx, organizationID)
+	if err != nil {
+		return pagination, err
+	}
+
+	if integratorID, ok := filters["integrator_id"].(uuid.UUID); ok {
+		if !lo.Contains(integratorIDs, integratorID) {
+			return pagination, ErrCampaignIntegratorNotAllowed
+		}
+	} else {
+		filters["integrator_id"] = integratorIDs
+	}
+
+	return s.campaignRepo.Paginate(ctx, filters, "created_at desc", limit, page)
+}
+
+func (s *FreeSpinCampaignService) Get(ctx context.Context, organizationID uuid.UUID, id uuid.UUID) (*entities.FreeSpinCampaign, error) {
+	campaign, err := s.campaignRepo.FindBy(ctx, map[string]interface{}{"id": id})
+	if err != nil {
+		return nil, err
+	}
+
+	integratorIDs, err := s.visibleIntegratorIDs(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !lo.Contains(integratorIDs, campaign.IntegratorID) {
+		return nil, e.ErrEntityNotFound
+	}
+
+	return campaign, nil
+}
+
+func (s *FreeSpinCampaignService) Create(ctx context.Context, session *entities.Session, req *requests.CampaignRequest) (*entities.FreeSpinCampaign, error) {
+	if req.Status == "" {
+		req.Status = entities.FreeSpinCampaignStatusDraft
+	}
+
+	if req.Status != entities.FreeSpinCampaignStatusDraft && req.Status != entities.FreeSpinCampaignStatusScheduled {
+		return nil, ErrCampaignInvalidStatus
+	}
+
+	if err := s.validate(ctx, session.OrganizationID, req); err != nil {
+		return nil, err
+	}
+
+	campaign := &entities.FreeSpinCampaign{
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+
+		ID:        uuid.New(),
+		CreatedBy: session.Account.ID,
+		Token:     uuid.NewString(),
+		Status:    req.Status,
+	}
+
+	fillCampaign(campaign, req)
+
+	return s.campaignRepo.Create(ctx, campaign)
+}
+
+func (s *FreeSpinCampaignService) Update(ctx context.Context, organizationID uuid.UUID, id uuid.UUID, req *requests.CampaignRequest) (*entities.FreeSpinCampaign, error) {
+	campaign, err := s.Get(ctx, organizationID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Status == "" {
+		req.Status = campaign.Status
+	}
+
+	if !entities.IsFreeSpinCampaignStatus(req.Status) {
+		return nil, ErrCampaignInvalidStatus
+	}
+
+	if !campaign.CanTransitTo(req.Status) {
+		return nil, ErrCampaignStatusTransition
+	}
+
+	// running or closed campaigns only accept a status change
+	if campaign.IsEditable() {
+		if err = s.validate(ctx, organizationID, req); err != nil {
+			return nil, err
+		}
+
+		fillCampaign(campaign, req)
+	}
+
+	campaign.Status = req.Status
+	campaign.UpdatedAt = time.Now()
+
+	return s.campaignRepo.Save(ctx, campaign)
+}
+
+func (s *FreeSpinCampaignService) Delete(ctx context.Context, organizationID uuid.UUID, id uuid.UUID) error {
+	campaign, err := s.Get(ctx, organizationID, id)
+	if err != nil {
+		return err
+	}
+
+	if campaign.Status != entities.FreeSpinCampaignStatusDraft {
+		return ErrCampaignNotEditable
+	}
+
+	return s.campaignRepo.Delete(ctx, campaign)
+}
+
+func (s *FreeSpinCampaignService) Users(ctx context.Context, organizationID uuid.UUID, id uuid.UUID) ([]*entities.FreeSpinUser, error) {
+	campaign, err := s.Get(ctx, organizationID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.userRepo.Find(ctx, map[string]interface{}{"fs_campaign_id": campaign.ID})
+}
+
+func (s *FreeSpinCampaignService) AddUsers(ctx context.Context, organizationID uuid.UUID, id uuid.UUID, users []requests.CampaignUser) ([]*entities.FreeSpinUser, error) {
+	campaign, err := s.Get(ctx, organizationID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if campaign.Status == entities.FreeSpinCampaignStatusFinished || campaign.Status == entities.FreeSpinCampaignStatusCancelled {
+		return nil, ErrCampaignNotEditable
+	}
+
+	existing, err := s.userRepo.Find(ctx, map[string]interface{}{"fs_campaign_id": campaign.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		if !lo.Contains(campaign.Currencies, user.Currency) {
+			return nil, ErrCampaignCurrencyNotSupported
+		}
+
+		if lo.ContainsBy(existing, func(item *entities.FreeSpinUser) bool { return item.UserID == user.UserID }) {
+			continue
+		}
+
+		fsUser, err := s.userRepo.Create(ctx, &entities.FreeSpinUser{
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+
+			ID:         uuid.New(),
+			CampaignID: campaign.ID,
+			UserID:     user.UserID,
+			Currency:   user.Currency,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		existing = append(existing, fsUser)
+	}
+
+	return existing, nil
+}
+
+func (s *FreeSpinCampaignService) RemoveUser(ctx context.Context, organizationID uuid.UUID, id uuid.UUID, userID uuid.UUID) error {
+	campaign, err := s.Get(ctx, organizationID, id)
+	if err != nil {
+		return err
+	}
+
+	if !campaign.IsEditable() {
+		return ErrCampaignNotEditable
+	}
+
+	user, err := s.userRepo.FindBy(ctx, map[string]interface{}{"id": userID, "fs_campaign_id": campaign.ID})
+	if err != nil {
+		return err
+	}
+
+	return s.userRepo.Delete(ctx, user)
+}
+
+func (s *FreeSpinCampaignService) validate(ctx context.Context, organizationID uuid.UUID, req *requests.CampaignRequest) error {
+	if !req.EndDate.After(req.StartDate) {
+		return ErrCampaignInvalidDates
+	}
+
+	if req.CoinSize <= 0 {
+		return e.ErrValidationFailed("coin_size")
+	}
+
+	integratorIDs, err := s.visibleIntegratorIDs(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+
+	if !lo.Contains(integratorIDs, req.IntegratorID) {
+		return ErrCampaignIntegratorNotAllowed
+	}
+
+	game, err := s.gameService.GetGame(ctx, req.GameID)
+	if err != nil {
+		return err
+	}
+
+	if !game.IsFreespins {
+		return ErrCampaignGameIsNotFreeSpins
+	}
+
+	if err = s.organizationService.HasAccess(ctx, req.IntegratorID, game.Name); err != nil {
+		return err
+	}
+
+	for _, currency := range req.Currencies {
+		if !lo.Contains(game.Currencies, currency) {
+			return ErrCampaignCurrencyNotSupported
+		}
+	}
+
+	return nil
+}
+
+func (s *FreeSpinCampaignService) visibleIntegratorIDs(ctx context.Context, organizationID uuid.UUID) ([]uuid.UUID, error) {
+	integrators, err := s.organizationService.GetVisibleIntegrators(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(integrators, func(item *entities.Organization, index int) uuid.UUID {
+		return item.ID
+	}), nil
+}
+
+func fillCampaign(campaign *entities.FreeSpinCampaign, req *requests.CampaignRequest) {
+	campaign.Name = req.Name
+	campaign.Description = req.Description
+	campaign.Type = req.Type
+	campaign.StartDate = req.StartDate
+	campaign.EndDate = req.EndDate
+	campaign.IntegratorID = req.IntegratorID
+	campaign.GameID = req.GameID
+	campaign.FSType = req.FSType
+	campaign.Currencies = req.Currencies
+	campaign.CoinSize = req.CoinSize
+}
@@ -14,36 +14,20 @@ import (
 )
 
 type GameService struct {
-	gameRepo         repositories.GameRepository
-	organizationRepo repositories.OrganizationRepository
+	gameRepo               repositories.GameRepository
+	organizationRepo       repositories.OrganizationRepository
+	gameHistoryService     *GameHistoryService
+	wagerConstraintService *WagerConstraintService
 }
 
-func NewGameService(gameRepo repositories.GameRepository, organizationRepo repositories.OrganizationRepository) *GameService {
-	return &GameService{gameRepo: gameRepo, organizationRepo: organizationRepo}
-}
-
-/*
-gameDivisors is a map that defines the divisor values for specific games.
-
-	These values are used to validate the wager levels for each game.
-*/
-var gameDivisors = map[string]int{
-	"admiral-wilds":            90,
-	"frozen-fruits-flexiways":  30,
-	"lucky-skulls-bonanza":     200,
-	"irish-riches-bonanza":     200,
-	"wild-dragon-respin":       100,
-	"coral-reef-flexiways":     200,
-	"lucky-santa-bonanza":      200,
-	"fortune-777-respin":       100,
-	"cleos-riches-flexiways":   200,
-	"sweet-mystery-flexiways":  100,
-	"quest-of-ra":              200,
-	"vampire-vault-hold-n-win": 200,
-	"yakuza-clash-hold-n-win":  200,
-	"double-fortune-panda":     200,
-	"squid-gold-x2":            200,
-	"brazilian-mask-fire":      200,
+func NewGameService(gameRepo repositories.GameRepository, organizationRepo repositories.OrganizationRepository,
+	gameHistoryService *GameHistoryService, wagerConstraintService *WagerConstraintService) *GameService {
+	return &GameService{
+		gameRepo:               gameRepo,
+		organizationRepo:       organizationRepo,
+		gameHistoryService:     gameHistoryService,
+		wagerConstraintService: wagerConstraintService,
+	}
 }
 
 func (s *GameService) Create(ctx context.Context, req *requests.GameRequest) (*entities.Game, error) {
@@ -67,12 +51,6 @@ func (s *GameService) Create(ctx context.Context, req *requests.GameRequest) (*e
 		return nil, e.ErrOrganizationIsNotProvider
 	}
 
-	divisor, exists := gameDivisors[req.Name]
-	if exists {
-		if err := s.validateWagerSet(ctx, req.Name, req.WagerSetID, divisor); err != nil {
-			return nil, fmt.Errorf("invalid wager set values for game %s", req.Name)
-		}
-	}
 	game := &entities.Game{
 		ID:                   uuid.New(),
 		OrganizationID:       req.OrganizationID,
@@ -97,11 +75,21 @@ func (s *GameService) Create(ctx context.Context, req *requests.GameRequest) (*e
 		GambleDoubleUp:       req.GambleDoubleUp,
 	}
 
+	if err = s.wagerConstraintService.ValidateGame(ctx, game.ID, game.Name, gameWagerSets(req), req.WagerConstraints); err != nil {
+		return nil, err
+	}
+
 	g, err := s.gameRepo.Create(ctx, game)
 	if err != nil {
 		return nil, err
 	}
 
+	if req.WagerConstraints != nil {
+		if _, err = s.wagerConstraintService.Set(ctx, g.ID, req.WagerConstraints); err != nil {
+			return nil, err
+		}
+	}
+
 	return g, nil
 }
 
@@ -222,7 +210,12 @@ func (s *GameService) Paginate(ctx context.Context, filters map[string]interface
 	return s.gameRepo.Paginate(ctx, filters, order, limit, offset)
 }
 
-func (s *GameService) Update(ctx context.Context, gameID uuid.UUID, req *requests.GameRequest) (*entities.Game, error) {
+func (s *GameService) Update(ctx context.Context, author *entities.Account, gameID uuid.UUID, req *requests.GameRequest) (*entities.Game, error) {
+	before, err := s.gameRepo.GetBy(ctx, map[string]interface{}{"id": gameID})
+	if err != nil {
+		return nil, err
+	}
+
 	organization, err := s.organizationRepo.Get(ctx, map[string]interface{}{"id": req.OrganizationID})
 	if err != nil {
 		zap.S().Error(err)
@@ -234,14 +227,47 @@ func (s *GameService) Update(ctx context.Context, gameID uuid.UUID, req *request
 		return nil, e.ErrOrganizationIsNotProvider
 	}
 
-	divisor, exists := gameDivisors[req.Name]
-	if exists {
-		if err := s.validateWagerSet(ctx, req.Name, req.WagerSetID, divisor); err != nil {
-			return nil, fmt.Errorf("invalid wager set values for game %s", req.Name)
+	if err = s.wagerConstraintService.ValidateGame(ctx, gameID, req.Name, gameWagerSets(req), req.WagerConstraints); err != nil {
+		return nil, err
+	}
+
+	var g *entities.Game
+
+	err = s.gameHistoryService.Change(ctx, []uuid.UUID{gameID}, func(ctx context.Context) (err error) {
+		if g, err = s.gameRepo.Update(ctx, gameID, gameColumns(req)); err != nil {
+			return err
+		}
+
+		return s.gameHistoryService.Record(ctx, author, gameID, nil, gameConfig(before), gameConfig(g), nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if req.WagerConstraints != nil {
+		if _, err = s.wagerConstraintService.Set(ctx, gameID, req.WagerConstraints); err != nil {
+			return nil, err
 		}
 	}
 
-	gameParam := map[string]interface{}{
+	return g, nil
+}
+
+// gameWagerSets are the default and the available wager sets of the game.
+func gameWagerSets(req *requests.GameRequest) []uuid.UUID {
+	wagerSets := []uuid.UUID{req.WagerSetID}
+
+	for _, id := range req.AvailableWagerSetsID {
+		if wagerSetID, err := uuid.Parse(id); err == nil {
+			wagerSets = append(wagerSets, wagerSetID)
+		}
+	}
+
+	return wagerSets
+}
+
+func gameColumns(req *requests.GameRequest) map[string]interface{} {
+	return map[string]interface{}{
 		"organization_id":         req.OrganizationID,
 		"name":                    req.Name,
 		"jurisdictions":           req.Jurisdictions,
@@ -263,13 +289,32 @@ func (s *GameService) Update(ctx context.Context, gameID uuid.UUID, req *request
 		"available_wager_sets_id": req.AvailableWagerSetsID,
 		"gamble_double_up":        req.GambleDoubleUp,
 	}
+}
 
-	g, err := s.gameRepo.Update(ctx, gameID, gameParam)
-	if err != nil {
-		return nil, err
+// gameConfig is the configuration of the game as it is updated, it is what game versions store.
+func gameConfig(game *entities.Game) *requests.GameRequest {
+	return &requests.GameRequest{
+		Name:                 game.Name,
+		Jurisdictions:        game.Jurisdictions,
+		Currencies:           game.Currencies,
+		Languages:            game.Languages,
+		UserLocales:          game.UserLocales,
+		ApiURL:               game.ApiUrl,
+		ClientURL:            game.ClientUrl,
+		OrganizationID:       game.OrganizationID,
+		WagerSetID:           game.WagerSetID,
+		IsPublic:             lo.ToPtr(game.IsPublic),
+		IsStatisticShown:     lo.ToPtr(game.IsStatisticShown),
+		IsDemo:               lo.ToPtr(game.IsDemo),
+		IsFreeSpins:          lo.ToPtr(game.IsFreespins),
+		RTP:                  game.RTP,
+		Volatility:           game.Volatility,
+		AvailableRTP:         game.AvailableRTP,
+		AvailableVolatility:  game.AvailableVolatility,
+		OnlineVolatility:     lo.ToPtr(game.OnlineVolatility),
+		AvailableWagerSetsID: game.AvailableWagerSetsID,
+		GambleDoubleUp:       game.GambleDoubleUp,
 	}
-
-	return g, nil
 }
 
 func (s *GameService) Delete(ctx context.Context, gameID uuid.UUID) error {
@@ -336,18 +381,3 @@ func (s *GameService) GetIntegratorGame(ctx context.Context, organizationID uuid
 func (s *GameService) GetAvailableWagerSetsByIDs(ctx context.Context, game *entities.Game) (wagerSets []entities.WagerSet, err error) {
 	return s.gameRepo.GetAvailableWagerSetsByIDs(ctx, game)
 }
-
-func (s *GameService) validateWagerSet(ctx context.Context, gameName string, wagerSetID uuid.UUID, divisor int) error {
-	wagerSet, err := s.gameRepo.GetWagerSetByID(ctx, wagerSetID)
-	if err != nil {
-		return err
-	}
-
-	for _, value := range wagerSet.WagerLevels {
-		if value%int64(divisor) != 0 {
-			return fmt.Errorf("invalid wager set values for game %s", gameName)
-		}
-	}
-
-	return nil
-}
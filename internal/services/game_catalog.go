@@ -0,0 +1,477 @@
+package services
+
+import (
+	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
+	"backoffice/internal/repositories"
+	"backoffice/internal/transport/http/requests"
+	"backoffice/pkg/validator"
+	"backoffice/utils"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/samber/lo"
+	"github.com/xuri/excelize/v2"
+)
+
+var (
+	ErrGameCatalogColumns = errors.New("game catalog misses columns")
+	ErrGameCatalogInvalid = errors.New("game catalog has invalid rows")
+)
+
+// gameCatalogColumns are the columns of the game catalog spreadsheet, lists are comma separated.
+var gameCatalogColumns = []string{
+	"name", "organization_id", "wager_set_id", "jurisdictions", "currencies", "languages", "user_locales",
+	"api_url", "client_url", "is_public", "is_statistic_shown", "is_demo", "is_freespins", "rtp", "volatility",
+	"available_rtp", "available_volatility", "online_volatility", "available_wager_sets_id", "gamble_double_up",
+}
+
+// GameCatalogService exports games to a spreadsheet and imports them back, games are matched by name.
+// An import is checked row by row first and applied in one transaction only when every row is valid.
+type GameCatalogService struct {
+	gameRepo               repositories.GameRepository
+	organizationRepo       repositories.OrganizationRepository
+	gameHistoryService     *GameHistoryService
+	wagerConstraintService *WagerConstraintService
+	validator              *validator.Validator
+}
+
+func NewGameCatalogService(gameRepo repositories.GameRepository, organizationRepo repositories.OrganizationRepository,
+	gameHistoryService *GameHistoryService, wagerConstraintService *WagerConstraintService, validator *validator.Validator) *GameCatalogService {
+	return &GameCatalogService{
+		gameRepo:               gameRepo,
+		organizationRepo:       organizationRepo,
+		gameHistoryService:     gameHistoryService,
+		wagerConstraintService: wagerConstraintService,
+		validator:              validator,
+	}
+}
+
+// Export writes the games of the filter to a spreadsheet, all games when it is empty.
+func (s *GameCatalogService) Export(ctx context.Context, filter map[string]interface{}) (*excelize.File, string, error) {
+	games, err := s.gameRepo.GetAllByFilter(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	table := [][]string{gameCatalogColumns}
+
+	for _, game := range games {
+		table = append(table, gameCatalogRow(gameConfig(game)))
+	}
+
+	file, err := utils.ExportXLSX(table)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create Excel file: %s", err.Error())
+	}
+
+	return file, generateFileName("games", time.Now().Format("20060102150405")), nil
+}
+
+// Import checks every row of the spreadsheet and, unless it is a dry run, creates and updates the games in
+// one transaction. The report is returned with ErrGameCatalogInvalid when a row is invalid.
+func (s *GameCatalogService) Import(ctx context.Context, author *entities.Account, r io.Reader, dryRun bool) (
+	*entities.GameCatalogReport, error) {
+	rows, err := utils.ReadDataXLSX(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, ErrGameCatalogColumns
+	}
+
+	columns := map[string]int{}
+	for i, column := range rows[0] {
+		columns[strings.TrimSpace(column)] = i
+	}
+
+	if missing, _ := lo.Difference(gameCatalogColumns, lo.Keys(columns)); len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrGameCatalogColumns, strings.Join(missing, ", "))
+	}
+
+	report := &entities.GameCatalogReport{DryRun: dryRun, Rows: []*entities.GameCatalogRow{}}
+	check := &gameCatalogCheck{names: map[string]int{}, organizations: map[uuid.UUID]error{}, wagerSets: map[uuid.UUID]error{}}
+	created := []*entities.Game{}
+	updated := map[uuid.UUID]map[string]interface{}{}
+	before := map[uuid.UUID]*entities.Game{}
+
+	for i := 1; i < len(rows); i++ {
+		cells := lo.MapValues(columns, func(index int, _ string) string {
+			if index < len(rows[i]) {
+				return strings.TrimSpace(rows[i][index])
+			}
+
+			return ""
+		})
+
+		if lo.EveryBy(lo.Values(cells), func(item string) bool { return item == "" }) {
+			continue
+		}
+
+		row := &entities.GameCatalogRow{Row: i + 1, Name: cells["name"]}
+		report.Rows = append(report.Rows, row)
+
+		req, errs := parseGameCatalogRow(cells)
+
+		var game *entities.Game
+
+		if len(errs) == 0 {
+			game, err = s.gameRepo.GetBy(ctx, map[string]interface{}{"name": req.Name})
+			if err != nil && !errors.Is(err, e.ErrEntityNotFound) {
+				return nil, err
+			}
+
+			if errs, err = s.check(ctx, check, row, req, game); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(errs) > 0 {
+			row.Errors = lo.Map(errs, func(item error, _ int) string { return item.Error() })
+			report.Invalid++
+
+			continue
+		}
+
+		if game == nil {
+			row.Action = entities.GameCatalogCreate
+			report.Created++
+			created = append(created, newGame(req))
+
+			continue
+		}
+
+		if row.Changes, err = gameConfigChanges(gameConfig(game), req); err != nil {
+			return nil, err
+		}
+
+		if len(row.Changes) == 0 {
+			row.Action = entities.GameCatalogUnchanged
+			report.Unchanged++
+
+			continue
+		}
+
+		row.Action = entities.GameCatalogUpdate
+		report.Updated++
+		updated[game.ID] = gameColumns(req)
+		before[game.ID] = game
+	}
+
+	if report.Invalid > 0 {
+		return report, ErrGameCatalogInvalid
+	}
+
+	if dryRun || len(created)+len(updated) == 0 {
+		return report, nil
+	}
+
+	err = s.gameHistoryService.Change(ctx, lo.Keys(updated), func(ctx context.Context) error {
+		if err := s.gameRepo.SaveCatalog(ctx, created, updated); err != nil {
+			return err
+		}
+
+		for gameID, game := range before {
+			after, err := s.gameRepo.GetBy(ctx, map[string]interface{}{"id": gameID})
+			if err != nil {
+				return err
+			}
+
+			if err = s.gameHistoryService.Record(ctx, author, gameID, nil, gameConfig(game), gameConfig(after), nil); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report.Applied = true
+
+	return report, nil
+}
+
+// gameCatalogCheck remembers what earlier rows of an import found.
+type gameCatalogCheck struct {
+	names         map[string]int
+	organizations map[uuid.UUID]error
+	wagerSets     map[uuid.UUID]error
+}
+
+// check validates the row as a game request, the organization and wager sets it refers to and the wager
+// constraints of the game, game is nil for a new one.
+func (s *GameCatalogService) check(ctx context.Context, check *gameCatalogCheck, row *entities.GameCatalogRow,
+	req *requests.GameRequest, game *entities.Game) ([]error, error) {
+	var errs []error
+
+	if err := s.validator.ValidateStruct(req); err != nil {
+		for _, taggedError := range validator.CheckValidationErrors(err) {
+			errs = append(errs, taggedError.Err)
+		}
+
+		return errs, nil
+	}
+
+	if first, ok := check.names[req.Name]; ok {
+		errs = append(errs, fmt.Errorf("game %s is already in row %d", req.Name, first))
+	} else {
+		check.names[req.Name] = row.Row
+	}
+
+	err, ok := check.organizations[req.OrganizationID]
+	if !ok {
+		err = s.checkOrganization(ctx, req.OrganizationID)
+		check.organizations[req.OrganizationID] = err
+	}
+
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	for _, wagerSetID := range gameWagerSets(req) {
+		err, ok = check.wagerSets[wagerSetID]
+		if !ok {
+			_, err = s.gameRepo.GetWagerSetByID(ctx, wagerSetID)
+			check.wagerSets[wagerSetID] = err
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("wager set %s: %w", wagerSetID, err))
+		}
+	}
+
+	if len(errs) > 0 || game == nil {
+		return errs, nil
+	}
+
+	err = s.wagerConstraintService.ValidateGame(ctx, game.ID, game.Name, gameWagerSets(req), nil)
+
+	var constraintErr *WagerConstraintError
+	if errors.As(err, &constraintErr) {
+		return constraintErr.Violations, nil
+	}
+
+	return nil, err
+}
+
+func (s *GameCatalogService) checkOrganization(ctx context.Context, organizationID uuid.UUID) error {
+	organization, err := s.organizationRepo.Get(ctx, map[string]interface{}{"id": organizationID})
+	if err != nil {
+		return fmt.Errorf("organization %s: %w", organizationID, err)
+	}
+
+	if organization.IsIntegrator() {
+		return fmt.Errorf("organization %s: %w", organizationID, e.ErrOrganizationIsNotProvider)
+	}
+
+	return nil
+}
+
+func newGame(req *requests.GameRequest) *entities.Game {
+	return &entities.Game{
+		ID:                   uuid.New(),
+		OrganizationID:       req.OrganizationID,
+		Name:                 req.Name,
+		Jurisdictions:        req.Jurisdictions,
+		Currencies:           req.Currencies,
+		Languages:            req.Languages,
+		UserLocales:          req.UserLocales,
+		ApiUrl:               req.ApiURL,
+		ClientUrl:            req.ClientURL,
+		WagerSetID:           req.WagerSetID,
+		IsPublic:             *req.IsPublic,
+		IsStatisticShown:     *req.IsStatisticShown,
+		IsDemo:               *req.IsDemo,
+		IsFreespins:          *req.IsFreeSpins,
+		RTP:                  req.RTP,
+		Volatility:           req.Volatility,
+		AvailableRTP:         req.AvailableRTP,
+		AvailableVolatility:  req.AvailableVolatility,
+		OnlineVolatility:     *req.OnlineVolatility,
+		AvailableWagerSetsID: req.AvailableWagerSetsID,
+		GambleDoubleUp:       req.GambleDoubleUp,
+	}
+}
+
+func gameConfigChanges(before, after *requests.GameRequest) ([]entities.GameVersionDiff, error) {
+	from, err := json.Marshal(before)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := json.Marshal(after)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := diffSnapshots(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// an empty list in the spreadsheet is the list the game does not have
+	return lo.Reject(diff, func(item entities.GameVersionDiff, _ int) bool {
+		return emptyCatalogValue(item.Before) && emptyCatalogValue(item.After)
+	}), nil
+}
+
+func emptyCatalogValue(value interface{}) bool {
+	list, ok := value.([]interface{})
+
+	return value == nil || ok && len(list) == 0
+}
+
+func gameCatalogRow(config *requests.GameRequest) []string {
+	return []string{
+		config.Name,
+		config.OrganizationID.String(),
+		config.WagerSetID.String(),
+		strings.Join(config.Jurisdictions, ","),
+		strings.Join(config.Currencies, ","),
+		strings.Join(config.Languages, ","),
+		strings.Join(config.UserLocales, ","),
+		config.ApiURL,
+		config.ClientURL,
+		strconv.FormatBool(*config.IsPublic),
+		strconv.FormatBool(*config.IsStatisticShown),
+		strconv.FormatBool(*config.IsDemo),
+		strconv.FormatBool(*config.IsFreeSpins),
+		lo.Ternary(config.RTP == nil, "", strconv.FormatInt(lo.FromPtr(config.RTP), 10)),
+		lo.FromPtr(config.Volatility),
+		strings.Join(lo.Map(config.AvailableRTP, func(item int64, _ int) string { return strconv.FormatInt(item, 10) }), ","),
+		strings.Join(config.AvailableVolatility, ","),
+		strconv.FormatBool(*config.OnlineVolatility),
+		strings.Join(config.AvailableWagerSetsID, ","),
+		strconv.FormatInt(config.GambleDoubleUp, 10),
+	}
+}
+
+// parseGameCatalogRow reads the cells of a row into a game request, every cell that can not be read is an error.
+func parseGameCatalogRow(cells map[string]string) (*requests.GameRequest, []error) {
+	var errs []error
+
+	parse := func(column string, fn func(value string) error) {
+		if err := fn(cells[column]); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", column, err))
+		}
+	}
+
+	flag := func(column string) *bool {
+		var value *bool
+
+		parse(column, func(cell string) error {
+			if cell == "" {
+				return nil
+			}
+
+			v, err := strconv.ParseBool(cell)
+			value = &v
+
+			return err
+		})
+
+		return value
+	}
+
+	req := &requests.GameRequest{
+		Name:                cells["name"],
+		Jurisdictions:       splitCatalogCell(cells["jurisdictions"]),
+		Currencies:          splitCatalogCell(cells["currencies"]),
+		Languages:           splitCatalogCell(cells["languages"]),
+		UserLocales:         splitCatalogCell(cells["user_locales"]),
+		ApiURL:              cells["api_url"],
+		ClientURL:           cells["client_url"],
+		IsPublic:            flag("is_public"),
+		IsStatisticShown:    flag("is_statistic_shown"),
+		IsDemo:              flag("is_demo"),
+		IsFreeSpins:         flag("is_freespins"),
+		AvailableVolatility: splitCatalogCell(cells["available_volatility"]),
+		OnlineVolatility:    flag("online_volatility"),
+		AvailableRTP:        pq.Int64Array{},
+	}
+
+	parse("organization_id", func(cell string) (err error) {
+		req.OrganizationID, err = uuid.Parse(cell)
+
+		return
+	})
+
+	parse("wager_set_id", func(cell string) (err error) {
+		req.WagerSetID, err = uuid.Parse(cell)
+
+		return
+	})
+
+	parse("available_wager_sets_id", func(cell string) error {
+		req.AvailableWagerSetsID = splitCatalogCell(cell)
+
+		for _, id := range req.AvailableWagerSetsID {
+			if _, err := uuid.Parse(id); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	parse("rtp", func(cell string) error {
+		if cell == "" {
+			return nil
+		}
+
+		rtp, err := strconv.ParseInt(cell, 10, 64)
+		req.RTP = &rtp
+
+		return err
+	})
+
+	parse("available_rtp", func(cell string) error {
+		for _, item := range splitCatalogCell(cell) {
+			rtp, err := strconv.ParseInt(item, 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req.AvailableRTP = append(req.AvailableRTP, rtp)
+		}
+
+		return nil
+	})
+
+	parse("gamble_double_up", func(cell string) (err error) {
+		if cell != "" {
+			req.GambleDoubleUp, err = strconv.ParseInt(cell, 10, 64)
+		}
+
+		return
+	})
+
+	if cells["volatility"] != "" {
+		req.Volatility = lo.ToPtr(cells["volatility"])
+	}
+
+	return req, errs
+}
+
+func splitCatalogCell(cell string) pq.StringArray {
+	values := pq.StringArray{}
+
+	for _, item := range strings.Split(cell, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+
+	return values
+}
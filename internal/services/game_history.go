@@ -0,0 +1,293 @@
+package services
+
+import (
+	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
+	"backoffice/internal/repositories"
+	"backoffice/internal/transport/http/requests"
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+var ErrGameVersionCurrent = errors.New("game version is the current configuration")
+
+// GameHistoryService keeps the versions of the configuration of games and of games for integrators, shows
+// what every change did and restores earlier versions. Restored configurations still have to be sent to
+// overlord by the caller.
+type GameHistoryService struct {
+	repo                   repositories.GameVersionRepository
+	gameRepo               repositories.GameRepository
+	organizationRepo       repositories.OrganizationRepository
+	wagerConstraintService *WagerConstraintService
+}
+
+func NewGameHistoryService(repo repositories.GameVersionRepository, gameRepo repositories.GameRepository,
+	organizationRepo repositories.OrganizationRepository, wagerConstraintService *WagerConstraintService) *GameHistoryService {
+	return &GameHistoryService{
+		repo:                   repo,
+		gameRepo:               gameRepo,
+		organizationRepo:       organizationRepo,
+		wagerConstraintService: wagerConstraintService,
+	}
+}
+
+// Change locks the games and runs apply in a transaction, the versions apply stores with Record are
+// committed together with the change or not at all.
+func (s *GameHistoryService) Change(ctx context.Context, gameIDs []uuid.UUID, apply func(ctx context.Context) error) error {
+	return s.repo.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Lock(ctx, gameIDs...); err != nil {
+			return err
+		}
+
+		return apply(ctx)
+	})
+}
+
+// History lists the versions newest first with the fields each of them changed.
+func (s *GameHistoryService) History(ctx context.Context, gameID uuid.UUID, integratorID *uuid.UUID, limit, page int) (
+	entities.Pagination[entities.GameVersionChange], error) {
+	versions, err := s.repo.History(ctx, gameID, integratorID)
+	if err != nil {
+		return entities.Pagination[entities.GameVersionChange]{}, err
+	}
+
+	changes := make([]*entities.GameVersionChange, 0, len(versions))
+
+	for i := len(versions) - 1; i >= 0; i-- {
+		var previous json.RawMessage
+		if i > 0 {
+			previous = versions[i-1].Snapshot
+		}
+
+		diff, err := diffSnapshots(previous, versions[i].Snapshot)
+		if err != nil {
+			return entities.Pagination[entities.GameVersionChange]{}, err
+		}
+
+		changes = append(changes, &entities.GameVersionChange{GameVersion: versions[i], Changes: diff})
+	}
+
+	return entities.Pagination[entities.GameVersionChange]{
+		Items:       lo.Subset(changes, limit*(page-1), uint(limit)),
+		CurrentPage: page,
+		Limit:       limit,
+		Total:       len(changes),
+	}, nil
+}
+
+// RollbackGame restores the game configuration of the version as a new version.
+func (s *GameHistoryService) RollbackGame(ctx context.Context, author *entities.Account, gameID uuid.UUID, version int) (*entities.Game, error) {
+	target, err := s.version(ctx, gameID, nil, version)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &requests.GameRequest{}
+	if err = json.Unmarshal(target.Snapshot, config); err != nil {
+		return nil, err
+	}
+
+	// the wager constraints may have changed since
+	if err = s.wagerConstraintService.ValidateGame(ctx, gameID, config.Name, gameWagerSets(config), nil); err != nil {
+		return nil, err
+	}
+
+	var game *entities.Game
+
+	err = s.Change(ctx, []uuid.UUID{gameID}, func(ctx context.Context) (err error) {
+		if game, err = s.gameRepo.Update(ctx, gameID, gameColumns(config)); err != nil {
+			return err
+		}
+
+		return s.Record(ctx, author, gameID, nil, nil, gameConfig(game), &target.Version)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return game, nil
+}
+
+// RollbackIntegratorGame restores the configuration of the game for the integrator of the version as a new version.
+func (s *GameHistoryService) RollbackIntegratorGame(ctx context.Context, author *entities.Account, gameID, integratorID uuid.UUID,
+	version int) (*entities.IntegratorGame, error) {
+	target, err := s.version(ctx, gameID, &integratorID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &entities.IntegratorGameConfig{}
+	if err = json.Unmarshal(target.Snapshot, config); err != nil {
+		return nil, err
+	}
+
+	if config.WagerSetID != uuid.Nil {
+		err = s.wagerConstraintService.ValidateAssignment(ctx, integratorID, gameID, config.WagerSetID, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var ig *entities.IntegratorGame
+
+	err = s.Change(ctx, []uuid.UUID{gameID}, func(ctx context.Context) (err error) {
+		if err = s.organizationRepo.RestoreIntegratorGame(ctx, &entities.IntegratorGame{
+			OrganizationID: integratorID,
+			GameID:         gameID,
+			WagerSetID:     config.WagerSetID,
+			RTP:            config.RTP,
+			Volatility:     config.Volatility,
+			ShortLink:      config.ShortLink,
+		}); err != nil {
+			return err
+		}
+
+		if ig, err = s.organizationRepo.GetIntegratorGame(ctx, integratorID, gameID); err != nil {
+			return err
+		}
+
+		return s.Record(ctx, author, gameID, &integratorID, nil, integratorGameConfig(ig), &target.Version)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ig, nil
+}
+
+// version finds the version to restore, restoring the latest configuration would change nothing.
+func (s *GameHistoryService) version(ctx context.Context, gameID uuid.UUID, integratorID *uuid.UUID, version int) (
+	*entities.GameVersion, error) {
+	versions, err := s.repo.History(ctx, gameID, integratorID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, ok := lo.Find(versions, func(item *entities.GameVersion) bool { return item.Version == version })
+	if !ok {
+		return nil, e.ErrEntityNotFound
+	}
+
+	equal, err := equalSnapshots(target.Snapshot, versions[len(versions)-1].Snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	if equal {
+		return nil, ErrGameVersionCurrent
+	}
+
+	return target, nil
+}
+
+// Record stores the configuration after a change, the first change also stores the configuration before
+// it. It is called by apply of Change, which numbers the versions of a game one change at a time.
+func (s *GameHistoryService) Record(ctx context.Context, author *entities.Account, gameID uuid.UUID, integratorID *uuid.UUID,
+	before, after interface{}, rollbackOf *int) error {
+	snapshot, err := json.Marshal(after)
+	if err != nil {
+		return err
+	}
+
+	versions, err := s.repo.History(ctx, gameID, integratorID)
+	if err != nil {
+		return err
+	}
+
+	next := 1
+
+	if len(versions) > 0 {
+		latest := versions[len(versions)-1]
+
+		if equal, err := equalSnapshots(latest.Snapshot, snapshot); err != nil || (equal && rollbackOf == nil) {
+			return err
+		}
+
+		next = latest.Version + 1
+	} else if before != nil {
+		initial, err := json.Marshal(before)
+		if err != nil {
+			return err
+		}
+
+		if err = s.create(ctx, nil, gameID, integratorID, next, initial, nil); err != nil {
+			return err
+		}
+
+		next++
+	}
+
+	return s.create(ctx, author, gameID, integratorID, next, snapshot, rollbackOf)
+}
+
+func (s *GameHistoryService) create(ctx context.Context, author *entities.Account, gameID uuid.UUID, integratorID *uuid.UUID,
+	version int, snapshot json.RawMessage, rollbackOf *int) error {
+	v := &entities.GameVersion{
+		CreatedAt:    time.Now(),
+		ID:           uuid.New(),
+		GameID:       gameID,
+		IntegratorID: integratorID,
+		Version:      version,
+		Snapshot:     snapshot,
+		RollbackOf:   rollbackOf,
+	}
+
+	if author != nil {
+		v.AuthorID = &author.ID
+		v.Author = author.AuthProviderID
+	}
+
+	return s.repo.CreateNoReturn(ctx, v)
+}
+
+func integratorGameConfig(ig *entities.IntegratorGame) *entities.IntegratorGameConfig {
+	return &entities.IntegratorGameConfig{
+		WagerSetID: ig.WagerSetID,
+		RTP:        ig.RTP,
+		Volatility: ig.Volatility,
+		ShortLink:  ig.ShortLink,
+	}
+}
+
+// diffSnapshots lists the fields that differ, every field of after is new when there is no before.
+func diffSnapshots(before, after json.RawMessage) ([]entities.GameVersionDiff, error) {
+	from, to := map[string]interface{}{}, map[string]interface{}{}
+
+	if before != nil {
+		if err := json.Unmarshal(before, &from); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := json.Unmarshal(after, &to); err != nil {
+		return nil, err
+	}
+
+	fields := lo.Uniq(append(lo.Keys(from), lo.Keys(to)...))
+	sort.Strings(fields)
+
+	diff := []entities.GameVersionDiff{}
+
+	for _, field := range fields {
+		if before != nil && reflect.DeepEqual(from[field], to[field]) {
+			continue
+		}
+
+		diff = append(diff, entities.GameVersionDiff{Field: field, Before: from[field], After: to[field]})
+	}
+
+	return diff, nil
+}
+
+func equalSnapshots(a, b json.RawMessage) (bool, error) {
+	diff, err := diffSnapshots(a, b)
+
+	return len(diff) == 0, err
+}
@@ -0,0 +1,192 @@
+package services
+
+import (
+	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
+	"backoffice/internal/repositories"
+	"backoffice/pkg/auth"
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const defaultImpersonationTTL = 30 * time.Minute
+
+var (
+	ErrImpersonationForbidden = errors.New("only root and admin accounts can sign in as another account")
+	ErrImpersonateSelf        = errors.New("can not sign in as your own account")
+	ErrImpersonateRoot        = errors.New("root accounts can not be impersonated")
+	ErrImpersonateAdmin       = errors.New("admin accounts can only be impersonated by root")
+	ErrImpersonateInactive    = errors.New("locked and pending accounts can not be impersonated")
+	ErrImpersonationNested    = errors.New("end the impersonation before starting another one")
+	ErrNotImpersonating       = errors.New("session is not an impersonation")
+	ErrImpersonationReadOnly  = errors.New("changes are not allowed while impersonating an account")
+)
+
+// impersonationWritable are the endpoints that change nothing but the impersonated session itself.
+var impersonationWritable = []string{"/auth/logout", "/auth/organization", "/auth/impersonation"}
+
+type ImpersonationConfig struct {
+	// TTL of an impersonated session, it can not be refreshed.
+	TTL time.Duration
+	// Writable endpoints accept POST, PUT and DELETE requests of impersonated sessions, e.g. "/reports/export".
+	Writable []string
+}
+
+// ImpersonationService signs root and admin accounts in as other accounts to see the backoffice as they
+// do. The impersonated session has the permissions of the account, names the administrator and expires
+// after a short time. Start and end are security events of the account, requests made meanwhile are
+// audited with the administrator.
+type ImpersonationService struct {
+	cfg            *ImpersonationConfig
+	authProvider   auth.Authorizer
+	accountService *AccountService
+	sessionService *SessionService
+	eventRepo      repositories.SecurityEventRepository
+}
+
+func NewImpersonationService(cfg *ImpersonationConfig, authProvider auth.Authorizer, accountService *AccountService,
+	sessionService *SessionService, eventRepo repositories.SecurityEventRepository) *ImpersonationService {
+	c := *cfg
+
+	if c.TTL <= 0 {
+		c.TTL = defaultImpersonationTTL
+	}
+
+	c.Writable = lo.Uniq(append(append([]string{}, impersonationWritable...), c.Writable...))
+
+	return &ImpersonationService{
+		cfg:            &c,
+		authProvider:   authProvider,
+		accountService: accountService,
+		sessionService: sessionService,
+		eventRepo:      eventRepo,
+	}
+}
+
+// Allowed tells whether an impersonated session may make the request, reading is always allowed.
+func (s *ImpersonationService) Allowed(method, endpoint string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+
+	return lo.Contains(s.cfg.Writable, endpoint)
+}
+
+// Start opens a session of the account on behalf of the actor. Only an access token is issued, the
+// session ends with it.
+func (s *ImpersonationService) Start(ctx context.Context, actor *entities.Session, accountID uuid.UUID,
+	device entities.Device) (*auth.Auth, error) {
+	if actor.Impersonated() {
+		return nil, ErrImpersonationNested
+	}
+
+	if !actor.Account.IsRoot() && !actor.Account.IsAdmin() {
+		return nil, ErrImpersonationForbidden
+	}
+
+	account, err := s.accountService.FindBy(ctx, map[string]interface{}{"id": accountID})
+	if err != nil {
+		return nil, err
+	}
+
+	if !actor.Account.IsRoot() && !account.InOrganization(actor.OrganizationID) {
+		return nil, e.ErrEntityNotFound
+	}
+
+	switch {
+	case account.ID == actor.Account.ID:
+		return nil, ErrImpersonateSelf
+	case account.IsRoot():
+		return nil, ErrImpersonateRoot
+	case account.IsAdmin() && !actor.Account.IsRoot():
+		return nil, ErrImpersonateAdmin
+	case account.IsLocked() || account.IsPending():
+		return nil, ErrImpersonateInactive
+	}
+
+	jti := uuid.New()
+	token, err := s.authProvider.Token(auth.WithExpiry(s.cfg.TTL), auth.WithTokenID(jti.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	organizationID := account.GetDefaultOrganizationID()
+	if account.InOrganization(actor.OrganizationID) {
+		organizationID = actor.OrganizationID
+	}
+
+	now := time.Now()
+	session := &entities.Session{
+		ID:             jti,
+		Account:        account,
+		OrganizationID: organizationID,
+		CreatedAt:      now,
+		LastUsedAt:     now,
+		Device:         device,
+		Impersonator: &entities.Impersonator{
+			ID:        actor.Account.ID,
+			Login:     actor.Account.AuthProviderID,
+			SessionID: actor.ID,
+			ExpiresAt: token.ExpiredAt,
+		},
+	}
+
+	if err = s.sessionService.Create(ctx, jti, session, token.ExpiredAt); err != nil {
+		return nil, err
+	}
+
+	s.record(ctx, entities.SecurityEventImpersonationStarted, session)
+
+	zap.S().Infow("impersonation started", "impersonator", actor.Account.AuthProviderID,
+		"account", account.AuthProviderID, "session", jti, "expires_at", token.ExpiredAt)
+
+	return &auth.Auth{
+		AccessToken: token.Token,
+		CreatedAt:   token.CreatedAt,
+		ExpiredAt:   token.ExpiredAt,
+	}, nil
+}
+
+// End closes the impersonated session, the session of the administrator is left as it was.
+func (s *ImpersonationService) End(ctx context.Context, session *entities.Session) error {
+	if !session.Impersonated() {
+		return ErrNotImpersonating
+	}
+
+	if err := s.sessionService.Delete(ctx, session.ID); err != nil && !errors.Is(err, e.ErrEntityNotFound) {
+		return err
+	}
+
+	s.record(ctx, entities.SecurityEventImpersonationEnded, session)
+
+	zap.S().Infow("impersonation ended", "impersonator", session.Impersonator.Login,
+		"account", session.Account.AuthProviderID, "session", session.ID)
+
+	return nil
+}
+
+// record stores the event, a failure to store it must not change the outcome of the request.
+func (s *ImpersonationService) record(ctx context.Context, eventType string, session *entities.Session) {
+	event := &entities.SecurityEvent{
+		CreatedAt:      time.Now(),
+		ID:             uuid.New(),
+		Type:           eventType,
+		Login:          session.Account.AuthProviderID,
+		AccountID:      &session.Account.ID,
+		OrganizationID: &session.OrganizationID,
+		ActorID:        &session.Impersonator.ID,
+		IP:             session.IP,
+		UserAgent:      session.UserAgent,
+	}
+
+	if err := s.eventRepo.Create(ctx, event); err != nil {
+		zap.S().Error(err)
+	}
+}
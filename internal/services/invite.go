@@ -0,0 +1,285 @@
+package services
+
+import (
+	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
+	"backoffice/internal/transport/http/requests"
+	"backoffice/pkg/auth"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultInviteTTL  = 72 * time.Hour
+	inviteNonceLength = 24
+)
+
+var (
+	ErrInviteDisabled     = errors.New("invites are not configured")
+	ErrInviteInvalid      = errors.New("invite link is invalid or expired")
+	ErrInvitePending      = errors.New("account has a pending invite, ask an administrator to resend it")
+	ErrInviteOrganization = errors.New("can not invite to an organization you are not a member of")
+)
+
+type InviteConfig struct {
+	// Secret signs invite links, invites are disabled while it is empty.
+	Secret string
+	// URL of the page accepting invites, the token is appended as the last path segment.
+	URL string
+	TTL time.Duration
+}
+
+// InviteService onboards accounts through emailed links. The invited account is pending and has no
+// password, the link carries a nonce kept in the reset password token of the account and a signature
+// over the account, the nonce and the expiry. Resending replaces the nonce, so earlier links stop working.
+type InviteService struct {
+	cfg                   *InviteConfig
+	accountService        *AccountService
+	organizationService   *OrganizationService
+	authorizationService  *AuthorizationService
+	authenticationService *AuthenticationService
+	roleGrantService      *RoleGrantService
+	mailingService        *MailingService
+}
+
+func NewInviteService(cfg *InviteConfig, accountService *AccountService, organizationService *OrganizationService,
+	authorizationService *AuthorizationService, authenticationService *AuthenticationService,
+	roleGrantService *RoleGrantService, mailingService *MailingService) *InviteService {
+	c := *cfg
+
+	if c.TTL <= 0 {
+		c.TTL = defaultInviteTTL
+	}
+
+	return &InviteService{
+		cfg:                   &c,
+		accountService:        accountService,
+		organizationService:   organizationService,
+		authorizationService:  authorizationService,
+		authenticationService: authenticationService,
+		roleGrantService:      roleGrantService,
+		mailingService:        mailingService,
+	}
+}
+
+func (s *InviteService) Enabled() bool {
+	return s.cfg.Secret != "" && s.cfg.URL != ""
+}
+
+func (s *InviteService) Invites(ctx context.Context, organizationID uuid.UUID, order string, limit int, offset int) (
+	[]*entities.Invite, int64, error) {
+	accounts, total, err := s.accountService.Paginate(ctx, organizationID,
+		map[string]interface{}{"accounts.status": entities.AccountStatusPending}, order, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return lo.Map(accounts, func(item *entities.Account, index int) *entities.Invite {
+		return entities.NewInvite(item)
+	}), total, nil
+}
+
+// Invite creates a pending account with the roles and organizations of the request and emails the link.
+// The account is added to the current organization of the actor and to the organizations of the roles.
+func (s *InviteService) Invite(ctx context.Context, actor *entities.Session, req *requests.CreateInviteRequest) (*entities.Invite, error) {
+	if !s.Enabled() {
+		return nil, ErrInviteDisabled
+	}
+
+	roles := make([]*entities.Role, 0, len(req.RoleIDs))
+	organizations := []uuid.UUID{actor.OrganizationID}
+
+	for _, id := range lo.Uniq(req.RoleIDs) {
+		role, err := s.authorizationService.GetRole(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if role.Type == entities.RootRoleTypeName {
+			return nil, fmt.Errorf("%v: %v", ErrCanNotAssignRole, role.Type)
+		}
+
+		if err = s.roleGrantService.Check(actor, role, req.RoleExpiresAt); err != nil {
+			return nil, err
+		}
+
+		roles, organizations = append(roles, role), append(organizations, role.OrganizationID)
+	}
+
+	organizations = lo.Uniq(append(organizations, req.OrganizationIDs...))
+
+	if !actor.Account.IsRoot() {
+		for _, id := range organizations {
+			if id != actor.OrganizationID && !actor.Account.InOrganization(id) {
+				return nil, ErrInviteOrganization
+			}
+		}
+	}
+
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+
+	account, err := s.accountService.CreatePending(ctx, email, req.FirstName, req.LastName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range organizations {
+		_, err = s.organizationService.Assign(ctx, account.ID, id)
+		if err != nil && !errors.Is(err, e.ErrOrganizationAlreadyAssigned) {
+			return nil, err
+		}
+	}
+
+	// roles that need an approval wait for it like any other grant
+	for _, role := range roles {
+		if _, err = s.roleGrantService.Request(ctx, actor, account, role.ID, req.RoleExpiresAt); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.OperatorID != "" {
+		if _, err = s.organizationService.AssignOperator(ctx, account.ID.String(), req.OperatorID, actor.OrganizationID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.send(ctx, account)
+}
+
+// Resend emails a new link, the previous link stops working.
+func (s *InviteService) Resend(ctx context.Context, actor *entities.Session, id uuid.UUID) (*entities.Invite, error) {
+	if !s.Enabled() {
+		return nil, ErrInviteDisabled
+	}
+
+	account, err := s.Pending(ctx, actor, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.send(ctx, account)
+}
+
+// Revoke deletes the pending account, its link stops working.
+func (s *InviteService) Revoke(ctx context.Context, actor *entities.Session, id uuid.UUID) error {
+	account, err := s.Pending(ctx, actor, id)
+	if err != nil {
+		return err
+	}
+
+	return s.accountService.Delete(ctx, actor.Account, account.ID.String())
+}
+
+// Pending finds the pending account of the invite, invites outside of the current organization are not
+// found unless the actor is root.
+func (s *InviteService) Pending(ctx context.Context, actor *entities.Session, id uuid.UUID) (*entities.Account, error) {
+	account, err := s.accountService.FindBy(ctx, map[string]interface{}{"id": id})
+	if err != nil {
+		return nil, err
+	}
+
+	if !account.IsPending() || (!actor.Account.IsRoot() && !account.InOrganization(actor.OrganizationID)) {
+		return nil, e.ErrEntityNotFound
+	}
+
+	return account, nil
+}
+
+// Find returns the invite of a link to the invitee, without its roles and organizations.
+func (s *InviteService) Find(ctx context.Context, token string) (*entities.Invite, error) {
+	account, err := s.resolve(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	invite := entities.NewInvite(account)
+	invite.Roles, invite.Organizations = nil, nil
+
+	return invite, nil
+}
+
+// Accept sets the password chosen by the invitee, activates the account and signs it in. The invitee
+// can enroll a second factor with the issued tokens.
+func (s *InviteService) Accept(ctx context.Context, token, password string, device entities.Device) (*auth.Auth, error) {
+	account, err := s.resolve(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if account, err = s.accountService.Activate(ctx, account, password); err != nil {
+		return nil, err
+	}
+
+	return s.authenticationService.Authenticate(ctx, account, device)
+}
+
+func (s *InviteService) send(ctx context.Context, account *entities.Account) (*entities.Invite, error) {
+	buf := make([]byte, inviteNonceLength)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, err
+	}
+
+	nonce := base64.RawURLEncoding.EncodeToString(buf)
+	expiresAt := time.Now().Add(s.cfg.TTL)
+
+	account, err := s.accountService.SetInviteToken(ctx, account, nonce, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	link := strings.TrimRight(s.cfg.URL, "/") + "/" + nonce + "." + s.sign(account.ID, nonce, expiresAt)
+
+	if err = s.mailingService.Invite(account.Email, account.AuthProviderID, link, expiresAt); err != nil {
+		return nil, err
+	}
+
+	return entities.NewInvite(account), nil
+}
+
+// resolve finds the pending account of a link and checks its signature and expiry.
+func (s *InviteService) resolve(ctx context.Context, token string) (*entities.Account, error) {
+	if !s.Enabled() {
+		return nil, ErrInviteDisabled
+	}
+
+	nonce, signature, ok := strings.Cut(token, ".")
+	if !ok || nonce == "" || signature == "" {
+		return nil, ErrInviteInvalid
+	}
+
+	account, err := s.accountService.FindBy(ctx, map[string]interface{}{"reset_password_token": nonce})
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			return nil, ErrInviteInvalid
+		}
+
+		return nil, err
+	}
+
+	if !account.IsPending() || account.ResetPasswordExpiresAt == nil || !account.ResetPasswordExpiresAt.After(time.Now()) {
+		return nil, ErrInviteInvalid
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(s.sign(account.ID, nonce, *account.ResetPasswordExpiresAt))) {
+		return nil, ErrInviteInvalid
+	}
+
+	return account, nil
+}
+
+func (s *InviteService) sign(accountID uuid.UUID, nonce string, expiresAt time.Time) string {
+	mac := hmac.New(sha256.New, []byte(s.cfg.Secret))
+	mac.Write([]byte(fmt.Sprintf("%s.%s.%d", accountID, nonce, expiresAt.Unix())))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,276 @@
+package services
+
+import (
+	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
+	"backoffice/internal/repositories"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultLockoutThreshold    = 3
+	defaultLockoutIPThreshold  = 20
+	defaultLockoutBaseDelay    = 30 * time.Second
+	defaultLockoutMaxDelay     = time.Hour
+	defaultLockoutAccountLimit = 10
+	defaultLockoutWindow       = time.Hour
+)
+
+var (
+	ErrTooManyAttempts    = errors.New("too many failed attempts, try again later")
+	ErrAccountLocked      = errors.New("account is locked, ask an administrator to unlock it")
+	ErrAccountNotLocked   = errors.New("account is not locked")
+	ErrLockoutRootAccount = errors.New("root accounts can not be unlocked through the api")
+)
+
+type LockoutConfig struct {
+	// Threshold failures of a login are tolerated, every further failure locks the login out twice as long.
+	Threshold int
+	// IPThreshold failures of a client ip are tolerated. The ip is the peer address or the one set by
+	// the trusted proxies of the server, clients can not pick it.
+	IPThreshold int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	// AccountLimit failures lock the account until an administrator unlocks it. Root accounts can not be
+	// unlocked through the api, so they are only locked out for a while like any login.
+	AccountLimit int
+	// Window is how long failures are remembered after the last one.
+	Window time.Duration
+}
+
+// LockoutService counts failed password and TOTP attempts per login and per client address. Past the
+// thresholds attempts are refused for exponentially growing periods, past the account limit the account
+// is locked for good and its sessions revoked.
+type LockoutService struct {
+	cfg                   *LockoutConfig
+	attemptRepo           repositories.LoginAttemptRepository
+	eventRepo             repositories.SecurityEventRepository
+	accountService        *AccountService
+	authenticationService *AuthenticationService
+	mailingService        *MailingService
+}
+
+func NewLockoutService(cfg *LockoutConfig, attemptRepo repositories.LoginAttemptRepository,
+	eventRepo repositories.SecurityEventRepository, accountService *AccountService,
+	authenticationService *AuthenticationService, mailingService *MailingService) *LockoutService {
+	c := *cfg
+
+	if c.Threshold <= 0 {
+		c.Threshold = defaultLockoutThreshold
+	}
+
+	if c.IPThreshold <= 0 {
+		c.IPThreshold = defaultLockoutIPThreshold
+	}
+
+	if c.BaseDelay <= 0 {
+		c.BaseDelay = defaultLockoutBaseDelay
+	}
+
+	if c.MaxDelay <= 0 {
+		c.MaxDelay = defaultLockoutMaxDelay
+	}
+
+	if c.AccountLimit <= 0 {
+		c.AccountLimit = defaultLockoutAccountLimit
+	}
+
+	if c.Window <= 0 {
+		c.Window = defaultLockoutWindow
+	}
+
+	return &LockoutService{
+		cfg:                   &c,
+		attemptRepo:           attemptRepo,
+		eventRepo:             eventRepo,
+		accountService:        accountService,
+		authenticationService: authenticationService,
+		mailingService:        mailingService,
+	}
+}
+
+// Check refuses attempts of a locked out login or address, the returned duration is when to retry.
+func (s *LockoutService) Check(ctx context.Context, login, ip string) (time.Duration, error) {
+	keys := []string{loginKey(login)}
+	if ip != "" {
+		keys = append(keys, ipKey(ip))
+	}
+
+	for _, key := range keys {
+		retryAfter, err := s.attemptRepo.LockedFor(ctx, key)
+		if err != nil {
+			return 0, err
+		}
+
+		if retryAfter > 0 {
+			return retryAfter, ErrTooManyAttempts
+		}
+	}
+
+	return 0, nil
+}
+
+// Failure counts a failed attempt of the login from the device and records it as a security event.
+func (s *LockoutService) Failure(ctx context.Context, eventType, login string, device entities.Device) error {
+	failures, err := s.attemptRepo.Fail(ctx, loginKey(login), s.cfg.Window)
+	if err != nil {
+		return err
+	}
+
+	// without an address all clients would share one counter
+	var ipFailures int64
+	if device.IP != "" {
+		if ipFailures, err = s.attemptRepo.Fail(ctx, ipKey(device.IP), s.cfg.Window); err != nil {
+			return err
+		}
+	}
+
+	account, err := s.accountService.FindBy(ctx, map[string]interface{}{"auth_provider_id": login})
+	if err != nil && !errors.Is(err, e.ErrEntityNotFound) {
+		return err
+	}
+
+	s.record(ctx, eventType, login, account, nil, device, failures)
+
+	if over := failures - int64(s.cfg.Threshold); over > 0 {
+		if err = s.attemptRepo.Lock(ctx, loginKey(login), s.delay(over)); err != nil {
+			return err
+		}
+
+		s.record(ctx, entities.SecurityEventLockedOut, login, account, nil, device, failures)
+	}
+
+	if over := ipFailures - int64(s.cfg.IPThreshold); over > 0 {
+		if err = s.attemptRepo.Lock(ctx, ipKey(device.IP), s.delay(over)); err != nil {
+			return err
+		}
+
+		s.record(ctx, entities.SecurityEventLockedOut, "", nil, nil, device, ipFailures)
+	}
+
+	if account != nil && !account.IsRoot() && !account.IsLocked() && failures >= int64(s.cfg.AccountLimit) {
+		return s.lock(ctx, account, device, failures)
+	}
+
+	return nil
+}
+
+// Success forgets the failures of the login, failures of the address are kept.
+func (s *LockoutService) Success(ctx context.Context, login string) error {
+	return s.attemptRepo.Reset(ctx, loginKey(login))
+}
+
+// Unlock reactivates a locked account on behalf of an administrator of its organization.
+func (s *LockoutService) Unlock(ctx context.Context, actor *entities.Session, accountID uuid.UUID) (*entities.Account, error) {
+	account, err := s.accountService.FindBy(ctx, map[string]interface{}{"id": accountID})
+	if err != nil {
+		return nil, err
+	}
+
+	if !actor.Account.IsRoot() && !account.InOrganization(actor.OrganizationID) {
+		return nil, e.ErrEntityNotFound
+	}
+
+	if account.IsRoot() {
+		return nil, ErrLockoutRootAccount
+	}
+
+	if !account.IsLocked() {
+		return nil, ErrAccountNotLocked
+	}
+
+	if account, err = s.accountService.UpdateStatus(ctx, account.ID, entities.AccountStatusActive); err != nil {
+		return nil, err
+	}
+
+	if err = s.attemptRepo.Reset(ctx, loginKey(account.AuthProviderID)); err != nil {
+		return nil, err
+	}
+
+	s.record(ctx, entities.SecurityEventAccountUnlocked, account.AuthProviderID, account, &actor.Account.ID, actor.Device, 0)
+
+	return account, nil
+}
+
+func (s *LockoutService) Events(ctx context.Context, filters *entities.SecurityEventFilters, limit int, page int) (
+	entities.Pagination[entities.SecurityEvent], error) {
+	return s.eventRepo.Paginate(ctx, filters, limit, page)
+}
+
+func (s *LockoutService) lock(ctx context.Context, account *entities.Account, device entities.Device, failures int64) error {
+	if _, err := s.accountService.UpdateStatus(ctx, account.ID, entities.AccountStatusLocked); err != nil {
+		return err
+	}
+
+	if err := s.authenticationService.RevokeOthers(ctx, account.ID, uuid.Nil); err != nil {
+		return err
+	}
+
+	s.record(ctx, entities.SecurityEventAccountLocked, account.AuthProviderID, account, nil, device, failures)
+
+	email := account.Email
+	if email == "" {
+		email = account.AuthProviderID
+	}
+
+	if err := s.mailingService.NotifyAccountLocked(email, account.AuthProviderID, device.IP, failures); err != nil {
+		zap.S().Error(err)
+	}
+
+	return nil
+}
+
+// record stores the event, a failure to store it must not change the outcome of the attempt.
+func (s *LockoutService) record(ctx context.Context, eventType, login string, account *entities.Account,
+	actorID *uuid.UUID, device entities.Device, failures int64) {
+	event := &entities.SecurityEvent{
+		CreatedAt: time.Now(),
+		ID:        uuid.New(),
+		Type:      eventType,
+		Login:     login,
+		ActorID:   actorID,
+		IP:        device.IP,
+		UserAgent: device.UserAgent,
+		Failures:  failures,
+	}
+
+	if account != nil {
+		event.AccountID = &account.ID
+
+		if len(account.Organizations) > 0 {
+			event.OrganizationID = &account.Organizations[0].ID
+		}
+	}
+
+	if err := s.eventRepo.Create(ctx, event); err != nil {
+		zap.S().Error(err)
+	}
+}
+
+// delay of the n-th lockout: the base delay doubled n-1 times, capped by the max delay.
+func (s *LockoutService) delay(n int64) time.Duration {
+	d := s.cfg.BaseDelay
+	for i := int64(1); i < n && d < s.cfg.MaxDelay; i++ {
+		d *= 2
+	}
+
+	if d > s.cfg.MaxDelay {
+		return s.cfg.MaxDelay
+	}
+
+	return d
+}
+
+func loginKey(login string) string {
+	return "login:" + strings.ToLower(strings.TrimSpace(login))
+}
+
+func ipKey(ip string) string {
+	return "ip:" + ip
+}
@@ -4,6 +4,7 @@ import (
 	"backoffice/internal/constants"
 	"backoffice/pkg/mailgun"
 	"bytes"
+	"time"
 )
 
 type MailingService struct {
@@ -17,10 +18,10 @@ func NewMailingService(mailgunClient *mailgun.Client, frontURL, sendEmail, reset
 	return &MailingService{mailgun: mailgunClient, frontURL: frontURL, sendEmail: sendEmail, resetPasswordURL: resetPasswordURL}
 }
 
-func (s *MailingService) NotifyUserEmail(email, login, password string) error {
+func (s *MailingService) NotifyUserEmail(email, login string) error {
 	buf := bytes.NewBufferString("")
 	err := constants.MailNotifyUserTemplate.
-		Execute(buf, constants.MailNotifyUserContent{FrontURL: s.frontURL, Login: login, Password: password})
+		Execute(buf, constants.MailNotifyUserContent{FrontURL: s.frontURL, Login: login})
 
 	if err != nil {
 		return err
@@ -43,3 +44,59 @@ func (s *MailingService) ResetUserPassword(email, token string) error {
 
 	return nil
 }
+
+func (s *MailingService) SendReport(recipients []string, name, from, to string, fileName string, data []byte) error {
+	buf := bytes.NewBufferString("")
+	err := constants.MailScheduledReportTemplate.
+		Execute(buf, constants.MailScheduledReportContent{FrontURL: s.frontURL, Name: name, From: from, To: to})
+	if err != nil {
+		return err
+	}
+
+	attachment := mailgun.Attachment{Name: fileName, Data: data}
+
+	for _, recipient := range recipients {
+		s.mailgun.Send(constants.MailScheduledReportSubject+name, recipient, s.sendEmail, buf.String(), nil, []interface{}{attachment})
+	}
+
+	return nil
+}
+
+func (s *MailingService) NotifyAccountLocked(email, login, ip string, failures int64) error {
+	buf := bytes.NewBufferString("")
+	err := constants.MailAccountLockedTemplate.
+		Execute(buf, constants.MailAccountLockedContent{FrontURL: s.frontURL, Login: login, IP: ip, Failures: failures})
+	if err != nil {
+		return err
+	}
+
+	s.mailgun.Send(constants.MailAccountLockedSubject, email, s.sendEmail, buf.String(), nil, nil)
+
+	return nil
+}
+
+func (s *MailingService) NotifyTwoFactorReset(email, login string) error {
+	buf := bytes.NewBufferString("")
+	err := constants.MailTwoFactorResetTemplate.
+		Execute(buf, constants.MailTwoFactorResetContent{FrontURL: s.frontURL, Login: login})
+	if err != nil {
+		return err
+	}
+
+	s.mailgun.Send(constants.MailTwoFactorResetSubject, email, s.sendEmail, buf.String(), nil, nil)
+
+	return nil
+}
+
+func (s *MailingService) Invite(email, login, url string, expiresAt time.Time) error {
+	buf := bytes.NewBufferString("")
+	err := constants.MailInviteTemplate.
+		Execute(buf, constants.MailInviteContent{FrontURL: s.frontURL, Login: login, URL: url, ExpiresAt: expiresAt.UTC().Format(time.RFC1123)})
+	if err != nil {
+		return err
+	}
+
+	s.mailgun.Send(constants.MailInviteSubject, email, s.sendEmail, buf.String(), nil, nil)
+
+	return nil
+}
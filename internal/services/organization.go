@@ -13,16 +13,21 @@ import (
 )
 
 type OrganizationService struct {
-	repo           repositories.OrganizationRepository
-	accountService *AccountService
-	gameService    *GameService
+	repo                   repositories.OrganizationRepository
+	accountService         *AccountService
+	gameService            *GameService
+	gameHistoryService     *GameHistoryService
+	wagerConstraintService *WagerConstraintService
 }
 
-func NewOrganizationService(repo repositories.OrganizationRepository, accountService *AccountService, gameService *GameService) *OrganizationService {
+func NewOrganizationService(repo repositories.OrganizationRepository, accountService *AccountService, gameService *GameService,
+	gameHistoryService *GameHistoryService, wagerConstraintService *WagerConstraintService) *OrganizationService {
 	return &OrganizationService{
-		repo:           repo,
-		accountService: accountService,
-		gameService:    gameService,
+		repo:                   repo,
+		accountService:         accountService,
+		gameService:            gameService,
+		gameHistoryService:     gameHistoryService,
+		wagerConstraintService: wagerConstraintService,
 	}
 }
 
@@ -51,6 +56,20 @@ func (s *OrganizationService) Update(ctx context.Context, id uuid.UUID, status u
 	})
 }
 
+// SetUnsignedPublicReports switches the public reports of the integrator without a signed token on or off.
+func (s *OrganizationService) SetUnsignedPublicReports(ctx context.Context, id uuid.UUID, allowed bool) (*entities.Organization, error) {
+	organization, err := s.repo.Get(ctx, map[string]interface{}{"id": id})
+	if err != nil {
+		return nil, err
+	}
+
+	if !organization.IsIntegrator() {
+		return nil, e.ErrOrganizationIsNotIntegrator
+	}
+
+	return s.repo.UpdateUnsignedPublicReports(ctx, organization, allowed)
+}
+
 func (s *OrganizationService) Delete(ctx context.Context, accountID, organizationID uuid.UUID) error {
 	organization, err := s.repo.Get(ctx, map[string]interface{}{"id": organizationID})
 	if err != nil {
@@ -72,6 +91,25 @@ func (s *OrganizationService) GetIntegratorsByProvider(ctx context.Context, prov
 	return s.repo.GetIntegratorsByProvider(ctx, providerID)
 }
 
+// GetVisibleIntegrators returns integrators the organization may manage: an integrator sees itself,
+// a provider sees every integrator paired with it.
+func (s *OrganizationService) GetVisibleIntegrators(ctx context.Context, organizationID uuid.UUID) ([]*entities.Organization, error) {
+	organization, err := s.repo.Get(ctx, map[string]interface{}{"id": organizationID})
+	if err != nil {
+		return nil, err
+	}
+
+	if organization.IsIntegrator() {
+		return []*entities.Organization{organization}, nil
+	}
+
+	if organization.Type != entities.OrganizationTypeProvider {
+		return nil, e.ErrOrganizationIsNotProvider
+	}
+
+	return s.repo.GetIntegratorsByProvider(ctx, organizationID)
+}
+
 func (s *OrganizationService) Assign(ctx context.Context, accountID, organizationID uuid.UUID) (*entities.Account, error) {
 	account, err := s.accountService.FindBy(ctx, map[string]interface{}{"id": accountID})
 	if err != nil {
@@ -244,6 +282,12 @@ func (s *OrganizationService) AssignGames(ctx context.Context, integratorID uuid
 		return nil, e.ErrOrganizationIsNotIntegrator
 	}
 
+	for _, gameID := range gameIDs {
+		if err = s.validateWagerSet(ctx, integratorID, gameID, wagerSetID, ""); err != nil {
+			return nil, err
+		}
+	}
+
 	err = s.repo.AssignGames(ctx, integratorID, wagerSetID, gameIDs)
 	if err != nil {
 		return nil, err
@@ -252,7 +296,7 @@ func (s *OrganizationService) AssignGames(ctx context.Context, integratorID uuid
 	return s.repo.GetIntegratorGameList(ctx, integratorID)
 }
 
-func (s *OrganizationService) UpdateGame(ctx context.Context, integratorID uuid.UUID, gameID uuid.UUID, wagerSetID uuid.UUID, rtp *int64, volatility *string, shortLink bool) (*entities.IntegratorGame, error) {
+func (s *OrganizationService) UpdateGame(ctx context.Context, author *entities.Account, integratorID uuid.UUID, gameID uuid.UUID, wagerSetID uuid.UUID, rtp *int64, volatility *string, shortLink bool) (*entities.IntegratorGame, error) {
 	organization, err := s.repo.Get(ctx, map[string]interface{}{"id": integratorID})
 	if err != nil {
 		zap.S().Error(err)
@@ -264,7 +308,24 @@ func (s *OrganizationService) UpdateGame(ctx context.Context, integratorID uuid.
 		return nil, e.ErrOrganizationIsNotIntegrator
 	}
 
-	ig, err := s.repo.UpdateIntegratorGame(ctx, integratorID, gameID, wagerSetID, rtp, volatility, shortLink)
+	before, err := s.repo.GetIntegratorGame(ctx, integratorID, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.validateWagerSet(ctx, integratorID, gameID, wagerSetID, ""); err != nil {
+		return nil, err
+	}
+
+	var ig *entities.IntegratorGame
+
+	err = s.gameHistoryService.Change(ctx, []uuid.UUID{gameID}, func(ctx context.Context) (err error) {
+		if ig, err = s.repo.UpdateIntegratorGame(ctx, integratorID, gameID, wagerSetID, rtp, volatility, shortLink); err != nil {
+			return err
+		}
+
+		return s.gameHistoryService.Record(ctx, author, gameID, &integratorID, integratorGameConfig(before), integratorGameConfig(ig), nil)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -465,6 +526,10 @@ func (s *OrganizationService) CreateIntegratorGameWagerSet(ctx context.Context,
 		return nil, e.ErrOrganizationIsNotIntegrator
 	}
 
+	if err = s.validateWagerSet(ctx, integratorID, gameID, wagerSetID, currency); err != nil {
+		return nil, err
+	}
+
 	err = s.repo.CreateIntegratorGameWagerSet(ctx, integratorID, wagerSetID, currency, gameID)
 	if err != nil {
 		return nil, err
@@ -488,6 +553,10 @@ func (s *OrganizationService) UpdateGameWagerSet(ctx context.Context,
 		return nil, e.ErrOrganizationIsNotIntegrator
 	}
 
+	if err = s.validateWagerSet(ctx, integratorID, gameID, wagerSetID, lo.Ternary(newCurrency != "", newCurrency, currency)); err != nil {
+		return nil, err
+	}
+
 	igws, err := s.repo.UpdateIntegratorGameWagerSet(ctx, integratorID, gameID, wagerSetID, currency, newCurrency)
 	if err != nil {
 		return nil, err
@@ -515,3 +584,13 @@ func (s *OrganizationService) DeleteGameWagerSet(ctx context.Context, integrator
 
 	return nil
 }
+
+// validateWagerSet checks the wager set the integrator gives the game against the wager constraints of the
+// game, games without a wager set of their own for the integrator are left to the wager set of the game.
+func (s *OrganizationService) validateWagerSet(ctx context.Context, integratorID, gameID, wagerSetID uuid.UUID, currency string) error {
+	if wagerSetID == uuid.Nil {
+		return nil
+	}
+
+	return s.wagerConstraintService.ValidateAssignment(ctx, integratorID, gameID, wagerSetID, currency)
+}
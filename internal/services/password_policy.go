@@ -0,0 +1,222 @@
+package services
+
+import (
+	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
+	"backoffice/internal/repositories"
+	"backoffice/internal/transport/http/requests"
+	"backoffice/pkg/password"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var ErrPasswordExpired = errors.New("password expired, set a new one")
+
+type PasswordConfig struct {
+	// Wordlist is a file of breached passwords, one per line, the builtin list is used when empty.
+	Wordlist string
+}
+
+// PasswordPolicyError lists every rule the new password breaks, each one is reported as a validation error.
+type PasswordPolicyError struct {
+	Violations []error
+}
+
+func (err *PasswordPolicyError) Error() string {
+	return errors.Join(err.Violations...).Error()
+}
+
+func (err *PasswordPolicyError) Unwrap() []error {
+	return err.Violations
+}
+
+// PasswordPolicyService keeps password policies of organizations and checks new passwords against the
+// strictest policy of the organizations of an account. Replaced passwords are kept as hashes as long as
+// the policy forbids their reuse.
+type PasswordPolicyService struct {
+	policyRepo  repositories.BaseRepository[entities.PasswordPolicy]
+	historyRepo repositories.PasswordHistoryRepository
+	wordlist    *password.Wordlist
+}
+
+func NewPasswordPolicyService(policyRepo repositories.BaseRepository[entities.PasswordPolicy],
+	historyRepo repositories.PasswordHistoryRepository, wordlist *password.Wordlist) *PasswordPolicyService {
+	return &PasswordPolicyService{
+		policyRepo:  policyRepo,
+		historyRepo: historyRepo,
+		wordlist:    wordlist,
+	}
+}
+
+// Get returns the policy of the organization, organizations without one get the default policy.
+func (s *PasswordPolicyService) Get(ctx context.Context, organizationID uuid.UUID) (*entities.PasswordPolicy, error) {
+	policy, err := s.policyRepo.FindBy(ctx, map[string]interface{}{"organization_id": organizationID})
+	if errors.Is(err, e.ErrEntityNotFound) {
+		return entities.DefaultPasswordPolicy(organizationID), nil
+	}
+
+	return policy, err
+}
+
+func (s *PasswordPolicyService) Update(ctx context.Context, organizationID uuid.UUID, req *requests.PasswordPolicyRequest) (
+	*entities.PasswordPolicy, error) {
+	policy, err := s.Get(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	policy.MinLength = req.MinLength
+	policy.RequireUpper = req.RequireUpper
+	policy.RequireLower = req.RequireLower
+	policy.RequireDigit = req.RequireDigit
+	policy.RequireSymbol = req.RequireSymbol
+	policy.CheckBreached = req.CheckBreached
+	policy.MaxAgeDays = req.MaxAgeDays
+	policy.History = req.History
+
+	return s.policyRepo.Save(ctx, policy)
+}
+
+// Policy combines the policies of the organizations into the strictest one.
+func (s *PasswordPolicyService) Policy(ctx context.Context, organizationIDs []uuid.UUID) (*entities.PasswordPolicy, error) {
+	policy := entities.DefaultPasswordPolicy(uuid.Nil)
+
+	if len(organizationIDs) == 0 {
+		return policy, nil
+	}
+
+	policies, err := s.policyRepo.Find(ctx, map[string]interface{}{"organization_id": organizationIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range policies {
+		policy = policy.Merge(item)
+	}
+
+	return policy, nil
+}
+
+// Check returns the policy the new password of the account was checked against, account is nil for
+// accounts that are not created yet.
+func (s *PasswordPolicyService) Check(ctx context.Context, organizationIDs []uuid.UUID, account *entities.Account,
+	pass string) (*entities.PasswordPolicy, error) {
+	policy, err := s.Policy(ctx, organizationIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := s.violations(policy, pass)
+
+	if account != nil && policy.History > 0 {
+		reused, err := s.reused(ctx, policy, account, pass)
+		if err != nil {
+			return nil, err
+		}
+
+		if reused {
+			violations = append(violations, fmt.Errorf("field password must not repeat any of the last %d passwords", policy.History))
+		}
+	}
+
+	if len(violations) > 0 {
+		return nil, &PasswordPolicyError{Violations: violations}
+	}
+
+	return policy, nil
+}
+
+// Remember keeps the password the account is about to replace when the policy forbids its reuse.
+func (s *PasswordPolicyService) Remember(ctx context.Context, policy *entities.PasswordPolicy, account *entities.Account) error {
+	entry := &entities.PasswordHistory{
+		CreatedAt: time.Now(),
+		ID:        uuid.New(),
+		AccountID: account.ID,
+		Hash:      account.AuthProviderToken,
+	}
+
+	// the current password counts as one of the last passwords
+	keep := policy.History - 1
+	if entry.Hash == "" {
+		keep = 0
+	}
+
+	return s.historyRepo.Add(ctx, entry, keep)
+}
+
+func (s *PasswordPolicyService) violations(policy *entities.PasswordPolicy, pass string) []error {
+	violations := make([]error, 0)
+
+	if utf8.RuneCountInString(pass) < policy.MinLength {
+		violations = append(violations, fmt.Errorf("field password must have at least %d characters", policy.MinLength))
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range pass {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsLetter(r):
+			symbol = true
+		}
+	}
+
+	if policy.RequireUpper && !upper {
+		violations = append(violations, errors.New("field password must have at least one big character"))
+	}
+
+	if policy.RequireLower && !lower {
+		violations = append(violations, errors.New("field password must have at least one small character"))
+	}
+
+	if policy.RequireDigit && !digit {
+		violations = append(violations, errors.New("field password must have at least one digit"))
+	}
+
+	if policy.RequireSymbol && !symbol {
+		violations = append(violations, errors.New("field password must have at least one special character"))
+	}
+
+	if policy.CheckBreached && s.wordlist.Contains(pass) {
+		violations = append(violations, errors.New("field password is a known breached password"))
+	}
+
+	return violations
+}
+
+func (s *PasswordPolicyService) reused(ctx context.Context, policy *entities.PasswordPolicy, account *entities.Account, pass string) (bool, error) {
+	hashes := make([]string, 0, policy.History)
+	if account.AuthProviderToken != "" {
+		hashes = append(hashes, account.AuthProviderToken)
+	}
+
+	if policy.History > 1 {
+		entries, err := s.historyRepo.Recent(ctx, account.ID, policy.History-1)
+		if err != nil {
+			return false, err
+		}
+
+		for _, entry := range entries {
+			hashes = append(hashes, entry.Hash)
+		}
+	}
+
+	for _, hash := range hashes {
+		if bcrypt.CompareHashAndPassword([]byte(hash), []byte(pass)) == nil {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,162 @@
+package services
+
+import (
+	"backoffice/internal/entities"
+	"backoffice/internal/repositories"
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const permissionRegistrySubject = "backoffice"
+
+type PermissionsConfig struct {
+	// Sync creates the permissions of unmapped routes at startup, otherwise they are only reported.
+	Sync bool
+}
+
+// PermissionRegistryService keeps the permissions in line with the routes of the http server. A permission
+// grants a route when its endpoint is the route path and its action matches the route method.
+type PermissionRegistryService struct {
+	cfg            *PermissionsConfig
+	permissionRepo repositories.PermissionRepository
+
+	mu     sync.RWMutex
+	routes []entities.Route
+}
+
+func NewPermissionRegistryService(cfg *PermissionsConfig, permissionRepo repositories.PermissionRepository) *PermissionRegistryService {
+	return &PermissionRegistryService{
+		cfg:            cfg,
+		permissionRepo: permissionRepo,
+	}
+}
+
+// SetRoutes takes the routes of the http server once its handlers are registered.
+func (s *PermissionRegistryService) SetRoutes(routes []entities.Route) {
+	sorted := append([]entities.Route{}, routes...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Endpoint == sorted[j].Endpoint {
+			return sorted[i].Method < sorted[j].Method
+		}
+
+		return sorted[i].Endpoint < sorted[j].Endpoint
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.routes = sorted
+}
+
+func (s *PermissionRegistryService) Routes() []entities.Route {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.routes
+}
+
+func (s *PermissionRegistryService) Coverage(ctx context.Context) (*entities.PermissionCoverage, error) {
+	permissions, err := s.permissionRepo.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := s.Routes()
+	coverage := &entities.PermissionCoverage{
+		Unprotected: []entities.Route{},
+		Unmapped:    []entities.Route{},
+	}
+
+	for _, route := range routes {
+		switch {
+		case !route.Protected:
+			coverage.Unprotected = append(coverage.Unprotected, route)
+		case !lo.ContainsBy(permissions, func(item *entities.Permission) bool { return grants(item, route) }):
+			coverage.Unmapped = append(coverage.Unmapped, route)
+		}
+	}
+
+	coverage.Orphaned = lo.Filter(permissions, func(item *entities.Permission, index int) bool {
+		return !lo.ContainsBy(routes, func(route entities.Route) bool { return grants(item, route) })
+	})
+
+	return coverage, nil
+}
+
+// Sync creates a permission for every unmapped route that has an action and grants them to the admin
+// roles. Orphaned permissions are reported, not deleted, roles may still hold them.
+func (s *PermissionRegistryService) Sync(ctx context.Context) (*entities.PermissionSync, error) {
+	coverage, err := s.Coverage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entities.PermissionSync{Created: []*entities.Permission{}, Orphaned: coverage.Orphaned}
+
+	for _, route := range coverage.Unmapped {
+		if route.Action() == "" {
+			continue
+		}
+
+		permission, err := s.permissionRepo.Create(ctx, &entities.Permission{
+			ID:          uuid.New(),
+			Name:        fmt.Sprintf("%s %s", route.Action(), route.Endpoint),
+			Description: fmt.Sprintf("Generated from route %s", route),
+			Subject:     permissionRegistrySubject,
+			Endpoint:    route.Endpoint,
+			Action:      route.Action(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result.Created = append(result.Created, permission)
+	}
+
+	if len(result.Created) > 0 {
+		if err = s.permissionRepo.RefreshAdminPermissions(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// Verify runs at startup, it syncs the permissions when configured to and logs what is left uncovered.
+func (s *PermissionRegistryService) Verify(ctx context.Context) error {
+	if s.cfg.Sync {
+		result, err := s.Sync(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, permission := range result.Created {
+			zap.S().Infof("permission created: %s", permission.Name)
+		}
+	}
+
+	coverage, err := s.Coverage(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, route := range coverage.Unmapped {
+		zap.S().Warnf("route is not granted by any permission: %s", route)
+	}
+
+	for _, permission := range coverage.Orphaned {
+		zap.S().Warnf("permission grants no route: %s %s (%s)", permission.Action, permission.Endpoint, permission.Name)
+	}
+
+	return nil
+}
+
+func grants(permission *entities.Permission, route entities.Route) bool {
+	return permission.Endpoint == route.Endpoint && permission.IsActionMatched(route.Method)
+}
@@ -0,0 +1,249 @@
+package services
+
+import (
+	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultPublicReportTTL    = 24 * time.Hour
+	defaultPublicReportMaxTTL = 30 * 24 * time.Hour
+)
+
+var (
+	ErrPublicReportLinksDisabled = errors.New("public report links are disabled")
+	ErrPublicReportKind          = errors.New("public report is a session or a spin")
+	ErrPublicReportTTL           = errors.New("public report link lifetime is too long")
+	ErrPublicReportTokenInvalid  = errors.New("invalid public report token")
+	ErrPublicReportTokenExpired  = errors.New("public report token has expired")
+	ErrPublicReportTokenRequired = errors.New("public report requires a signed token")
+)
+
+type PublicReportConfig struct {
+	// Secret signs public report links, links can not be created while it is empty.
+	Secret string
+	// TTL of a link unless the request sets one, at most MaxTTL.
+	TTL    time.Duration
+	MaxTTL time.Duration
+}
+
+// PublicReportService shares sessions and spins with players through links. A link carries a token signed
+// with the secret over the session or round, its game, currency and expiry, so it opens nothing else.
+// Integrators that still allow unsigned public reports are read by id, game and currency alone.
+type PublicReportService struct {
+	cfg                 *PublicReportConfig
+	spinService         *SpinService
+	organizationService *OrganizationService
+}
+
+func NewPublicReportService(cfg *PublicReportConfig, spinService *SpinService, organizationService *OrganizationService) *PublicReportService {
+	c := *cfg
+
+	if c.TTL <= 0 {
+		c.TTL = defaultPublicReportTTL
+	}
+
+	if c.MaxTTL <= 0 {
+		c.MaxTTL = defaultPublicReportMaxTTL
+	}
+
+	return &PublicReportService{
+		cfg:                 &c,
+		spinService:         spinService,
+		organizationService: organizationService,
+	}
+}
+
+// Link signs a link to a session or a spin the session can see in reports, ttl zero takes the default.
+func (s *PublicReportService) Link(ctx context.Context, session *entities.Session, kind, id, currency string,
+	ttl time.Duration) (*entities.PublicReportLink, error) {
+	if s.cfg.Secret == "" {
+		return nil, ErrPublicReportLinksDisabled
+	}
+
+	if ttl <= 0 {
+		ttl = s.cfg.TTL
+	}
+
+	if ttl > s.cfg.MaxTTL {
+		return nil, fmt.Errorf("%w: at most %s", ErrPublicReportTTL, s.cfg.MaxTTL)
+	}
+
+	var game, path string
+
+	switch kind {
+	case entities.PublicReportSession:
+		sessionID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+
+		gamingSession, err := s.spinService.Session(ctx, session.ReportAccess(), sessionID, currency)
+		if err != nil {
+			return nil, err
+		}
+
+		game, path = gamingSession.Game, "/public_reports/sessions/"+id
+	case entities.PublicReportSpin:
+		spin, err := s.spinService.Spin(ctx, session.ReportAccess(), id, currency)
+		if err != nil {
+			return nil, err
+		}
+
+		game, path = spin.Game, "/public_reports/spins/"+id
+	default:
+		return nil, ErrPublicReportKind
+	}
+
+	expiresAt := time.Now().Add(ttl).Truncate(time.Second)
+	token, err := s.sign(&entities.PublicReportClaims{
+		Kind:      kind,
+		ID:        id,
+		Game:      game,
+		Currency:  currency,
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.PublicReportLink{
+		Token:     token,
+		Path:      path + "?token=" + url.QueryEscape(token),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// Session reads a public session, game and currency come from the token when there is one.
+func (s *PublicReportService) Session(ctx context.Context, id uuid.UUID, game, currency, token string) (*entities.GamingSession, error) {
+	if token != "" {
+		claims, err := s.verify(token, entities.PublicReportSession, id.String())
+		if err != nil {
+			return nil, err
+		}
+
+		return s.spinService.GameSession(ctx, claims.Game, id, claims.Currency)
+	}
+
+	gamingSession, err := s.spinService.GameSession(ctx, game, id, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.unsigned(ctx, gamingSession.Integrator); err != nil {
+		return nil, err
+	}
+
+	return gamingSession, nil
+}
+
+// Spin reads a public spin, the currency comes from the token when there is one.
+func (s *PublicReportService) Spin(ctx context.Context, id, currency, token string) (*entities.Spin, error) {
+	if token != "" {
+		claims, err := s.verify(token, entities.PublicReportSpin, id)
+		if err != nil {
+			return nil, err
+		}
+
+		spin, err := s.spinService.GetSpin(ctx, id, claims.Currency)
+		if err != nil {
+			return nil, err
+		}
+
+		if spin.Game != claims.Game {
+			return nil, ErrPublicReportTokenInvalid
+		}
+
+		return spin, nil
+	}
+
+	spin, err := s.spinService.GetSpin(ctx, id, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.unsigned(ctx, spin.Integrator); err != nil {
+		return nil, err
+	}
+
+	return spin, nil
+}
+
+// unsigned tells whether the integrator still allows public reports without a token.
+func (s *PublicReportService) unsigned(ctx context.Context, integrator string) error {
+	organization, err := s.organizationService.GetByName(ctx, integrator)
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			return ErrPublicReportTokenRequired
+		}
+
+		return err
+	}
+
+	if !organization.UnsignedPublicReports {
+		return ErrPublicReportTokenRequired
+	}
+
+	return nil
+}
+
+func (s *PublicReportService) sign(claims *entities.PublicReportClaims) (string, error) {
+	data, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	payload := base64.RawURLEncoding.EncodeToString(data)
+
+	return payload + "." + s.signature(payload), nil
+}
+
+func (s *PublicReportService) verify(token, kind, id string) (*entities.PublicReportClaims, error) {
+	if s.cfg.Secret == "" {
+		return nil, ErrPublicReportLinksDisabled
+	}
+
+	payload, signature, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(s.signature(payload))) {
+		return nil, ErrPublicReportTokenInvalid
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, ErrPublicReportTokenInvalid
+	}
+
+	claims := &entities.PublicReportClaims{}
+	if err = json.Unmarshal(data, claims); err != nil {
+		return nil, ErrPublicReportTokenInvalid
+	}
+
+	if claims.Kind != kind || claims.ID != id {
+		return nil, ErrPublicReportTokenInvalid
+	}
+
+	if time.Now().Unix() >= claims.ExpiresAt {
+		return nil, ErrPublicReportTokenExpired
+	}
+
+	return claims, nil
+}
+
+func (s *PublicReportService) signature(payload string) string {
+	mac := hmac.New(sha256.New, []byte(s.cfg.Secret))
+	mac.Write([]byte(payload))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
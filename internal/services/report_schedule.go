@@ -0,0 +1,293 @@
+package services
+
+import (
+	"backoffice/internal/constants"
+	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
+	"backoffice/internal/repositories"
+	"backoffice/internal/transport/http/requests"
+	"backoffice/pkg/cron"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const reportScheduleTick = time.Minute
+
+var (
+	ErrReportScheduleUnknownType     = errors.New("unknown report type")
+	ErrReportScheduleUnknownPeriod   = errors.New("unknown report period")
+	ErrReportScheduleCurrency        = errors.New("report payload currency is required")
+	ErrReportScheduleRecipients      = errors.New("at least one valid recipient is required")
+	ErrReportScheduleNeverActivating = errors.New("cron expression never activates")
+)
+
+type ReportScheduleService struct {
+	repo           repositories.ReportScheduleRepository
+	fileService    *FileDownloadingService
+	mailingService *MailingService
+	accountService *AccountService
+}
+
+func NewReportScheduleService(repo repositories.ReportScheduleRepository, fileService *FileDownloadingService,
+	mailingService *MailingService, accountService *AccountService) *ReportScheduleService {
+	return &ReportScheduleService{
+		repo:           repo,
+		fileService:    fileService,
+		mailingService: mailingService,
+		accountService: accountService,
+	}
+}
+
+func (s *ReportScheduleService) Paginate(ctx context.Context, organizationID uuid.UUID, limit int, page int) (
+	entities.Pagination[entities.ReportSchedule], error) {
+	return s.repo.Paginate(ctx, map[string]interface{}{"organization_id": organizationID}, "created_at desc", limit, page)
+}
+
+func (s *ReportScheduleService) Get(ctx context.Context, organizationID uuid.UUID, id uuid.UUID) (*entities.ReportSchedule, error) {
+	return s.repo.FindBy(ctx, map[string]interface{}{"id": id, "organization_id": organizationID})
+}
+
+func (s *ReportScheduleService) Create(ctx context.Context, session *entities.Session, req *requests.ReportScheduleRequest) (*entities.ReportSchedule, error) {
+	schedule := &entities.ReportSchedule{
+		CreatedAt: time.Now(),
+
+		ID:             uuid.New(),
+		OrganizationID: session.OrganizationID,
+		CreatedBy:      session.Account.ID,
+	}
+
+	if err := s.fill(schedule, req); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Create(ctx, schedule)
+}
+
+func (s *ReportScheduleService) Update(ctx context.Context, organizationID uuid.UUID, id uuid.UUID, req *requests.ReportScheduleRequest) (*entities.ReportSchedule, error) {
+	schedule, err := s.Get(ctx, organizationID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.fill(schedule, req); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Save(ctx, schedule)
+}
+
+func (s *ReportScheduleService) Delete(ctx context.Context, organizationID uuid.UUID, id uuid.UUID) error {
+	schedule, err := s.Get(ctx, organizationID, id)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, schedule)
+}
+
+// Run polls for due schedules until ctx is cancelled.
+func (s *ReportScheduleService) Run(ctx context.Context) {
+	ticker := time.NewTicker(reportScheduleTick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.runDue(ctx)
+		}
+	}
+}
+
+func (s *ReportScheduleService) runDue(ctx context.Context) {
+	schedules, err := s.repo.FindDue(ctx, time.Now())
+	if err != nil {
+		zap.S().Error(err)
+
+		return
+	}
+
+	for _, schedule := range schedules {
+		loc, err := time.LoadLocation(schedule.Timezone)
+		if err != nil {
+			zap.S().Error(err)
+
+			continue
+		}
+
+		expr, err := cron.Parse(schedule.Cron)
+		if err != nil {
+			zap.S().Error(err)
+
+			continue
+		}
+
+		// another instance may run the same schedule, only the one that moved next_run_at sends the report
+		claimed, err := s.repo.Claim(ctx, schedule, expr.Next(time.Now().In(loc)).UTC())
+		if err != nil {
+			zap.S().Error(err)
+
+			continue
+		}
+
+		if claimed {
+			go s.execute(ctx, schedule, loc)
+		}
+	}
+}
+
+func (s *ReportScheduleService) execute(ctx context.Context, schedule *entities.ReportSchedule, loc *time.Location) {
+	lastError := ""
+	if err := s.send(ctx, schedule, time.Now().In(loc)); err != nil {
+		zap.S().Errorf("report schedule %s: %v", schedule.ID, err)
+		lastError = err.Error()
+	}
+
+	if _, err := s.repo.Update(ctx, &entities.ReportSchedule{}, map[string]interface{}{"last_error": lastError},
+		map[string]interface{}{"id": schedule.ID}); err != nil {
+		zap.S().Error(err)
+	}
+}
+
+func (s *ReportScheduleService) send(ctx context.Context, schedule *entities.ReportSchedule, now time.Time) error {
+	payload := map[string]interface{}{}
+	if err := json.Unmarshal(schedule.Payload, &payload); err != nil {
+		return err
+	}
+
+	if schedule.Period != "" {
+		from, to := entities.ReportPeriodRange(schedule.Period, now)
+		payload["starting_from"] = from.Format(constants.TimeLayout)
+		payload["ending_at"] = to.Format(constants.TimeLayout)
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	account, err := s.accountService.FindBy(ctx, map[string]interface{}{"id": schedule.CreatedBy})
+	if err != nil {
+		return err
+	}
+
+	// reports run on behalf of the schedule author, files are kept apart from interactive sessions
+	session := &entities.Session{
+		ID:             schedule.ID,
+		Account:        account,
+		OrganizationID: schedule.OrganizationID,
+	}
+
+	file, err := s.fileService.Generate(ctx, session, schedule.ReportType, data)
+	if err != nil {
+		return err
+	}
+
+	reader, err := s.fileService.Open(ctx, file)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+
+	from, _ := payload["starting_from"].(string)
+	to, _ := payload["ending_at"].(string)
+
+	return s.mailingService.SendReport(schedule.Recipients, schedule.Name, from, to, file.FileName(), content)
+}
+
+func (s *ReportScheduleService) fill(schedule *entities.ReportSchedule, req *requests.ReportScheduleRequest) error {
+	if err := validateReportPayload(req.ReportType, req.Payload); err != nil {
+		return err
+	}
+
+	if req.Period != "" && !entities.IsReportPeriod(req.Period) {
+		return ErrReportScheduleUnknownPeriod
+	}
+
+	if len(req.Recipients) == 0 {
+		return ErrReportScheduleRecipients
+	}
+
+	for _, recipient := range req.Recipients {
+		if _, err := mail.ParseAddress(recipient); err != nil {
+			return fmt.Errorf("%w: %s", ErrReportScheduleRecipients, recipient)
+		}
+	}
+
+	if req.Timezone == "" {
+		req.Timezone = time.UTC.String()
+	}
+
+	loc, err := time.LoadLocation(req.Timezone)
+	if err != nil {
+		return e.ErrValidationFailed("timezone")
+	}
+
+	expr, err := cron.Parse(req.Cron)
+	if err != nil {
+		return err
+	}
+
+	next := expr.Next(time.Now().In(loc))
+	if next.IsZero() {
+		return ErrReportScheduleNeverActivating
+	}
+
+	next = next.UTC()
+
+	schedule.UpdatedAt = time.Now()
+	schedule.Name = req.Name
+	schedule.ReportType = req.ReportType
+	schedule.Payload = entities.JSON(req.Payload)
+	schedule.Period = req.Period
+	schedule.Cron = req.Cron
+	schedule.Timezone = req.Timezone
+	schedule.Recipients = req.Recipients
+	schedule.IsActive = *req.IsActive
+	schedule.NextRunAt = &next
+
+	return nil
+}
+
+func validateReportPayload(reportType string, payload []byte) error {
+	var currency *string
+
+	switch {
+	case entities.IsFinancialReportType(reportType):
+		req := &entities.FinancialBase{}
+		if err := json.Unmarshal(payload, req); err != nil {
+			return err
+		}
+
+		currency = req.Currency
+	case entities.IsAggregatedReportType(reportType):
+		req := &entities.AggregateFilters{}
+		if err := json.Unmarshal(payload, req); err != nil {
+			return err
+		}
+
+		currency = req.Currency
+	default:
+		return ErrReportScheduleUnknownType
+	}
+
+	if currency == nil || *currency == "" {
+		return ErrReportScheduleCurrency
+	}
+
+	return nil
+}
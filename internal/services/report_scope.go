@@ -0,0 +1,182 @@
+package services
+
+import (
+	"backoffice/internal/entities"
+	"backoffice/pkg/history"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+var (
+	ErrOutsideReportScope = errors.New("report filters are outside of your report scope")
+	ErrReportScopeFilter  = errors.New("your report scope needs a filter")
+	// ErrReportScopeCountry is returned for spin reports, only aggregated reports can be filtered by country.
+	ErrReportScopeCountry = errors.New("your report scope is limited to countries, only aggregated reports are available")
+	// ErrReportScopeCurrency is returned for reports summing spins of all currencies, history can not select
+	// spins by currency. Aggregated reports are split by currency and keep the currencies of the scope.
+	ErrReportScopeCurrency = errors.New("your report scope is limited to currencies, only aggregated reports are available")
+)
+
+// applyReportScope limits the history filters to the scope. History filters take one integrator and one
+// operator, so a scope with several of them needs the request to pick one.
+func applyReportScope(scope *entities.ReportScope, fb *history.FinancialBase) error {
+	if scope.Empty() {
+		return nil
+	}
+
+	if len(scope.Countries) > 0 {
+		return ErrReportScopeCountry
+	}
+
+	if len(scope.Currencies) > 0 {
+		return ErrReportScopeCurrency
+	}
+
+	games, err := scopeGames(scope, fb.Games)
+	if err != nil {
+		return err
+	}
+
+	fb.Games = games
+
+	if fb.Filters == nil {
+		fb.Filters = &history.Filters{}
+	}
+
+	if fb.Filters.Integrator, err = scopeValue("integrator", scope.Integrators, fb.Filters.Integrator); err != nil {
+		return err
+	}
+
+	fb.Filters.Operator, err = scopeValue("operator", scope.Operators, fb.Filters.Operator)
+
+	return err
+}
+
+func applyAggregatedReportScope(scope *entities.ReportScope, filters *history.GetAggregatedReportFilters) error {
+	if scope.Empty() {
+		return nil
+	}
+
+	games, err := scopeGames(scope, filters.Games)
+	if err != nil {
+		return err
+	}
+
+	filters.Games = games
+
+	if filters.Integrator, err = scopeValue("integrator", scope.Integrators, filters.Integrator); err != nil {
+		return err
+	}
+
+	if filters.Operator, err = scopeValue("operator", scope.Operators, filters.Operator); err != nil {
+		return err
+	}
+
+	if len(scope.Countries) > 0 {
+		country, err := scopeValue("country", scope.Countries, lo.FromPtr(filters.Country))
+		if err != nil {
+			return err
+		}
+
+		filters.Country = &country
+	}
+
+	return nil
+}
+
+// scopeGames keeps the games of the scope, an empty list would not limit the games at all.
+func scopeGames(scope *entities.ReportScope, games []string) ([]string, error) {
+	if len(scope.Games) == 0 {
+		return games, nil
+	}
+
+	games = lo.Intersect(games, scope.Games)
+	if len(games) == 0 {
+		return nil, ErrOutsideReportScope
+	}
+
+	return games, nil
+}
+
+// scopeValue checks the filter value against the allowed values, a missing value is filled in when only
+// one is allowed.
+func scopeValue(field string, allowed []string, value string) (string, error) {
+	switch {
+	case len(allowed) == 0:
+		return value, nil
+	case value == "" && len(allowed) == 1:
+		return allowed[0], nil
+	case value == "":
+		return "", fmt.Errorf("%w: select the %s, one of %v", ErrReportScopeFilter, field, allowed)
+	case !lo.Contains(allowed, value):
+		return "", fmt.Errorf("%w: %s %s", ErrOutsideReportScope, field, value)
+	}
+
+	return value, nil
+}
+
+// scopeGamingSession checks a session found by id, sessions have no country so a country scope sees none.
+func scopeGamingSession(scope *entities.ReportScope, session *entities.GamingSession) error {
+	if scope.Empty() {
+		return nil
+	}
+
+	if len(scope.Countries) > 0 {
+		return ErrReportScopeCountry
+	}
+
+	if (len(scope.Integrators) > 0 && !lo.Contains(scope.Integrators, session.Integrator)) ||
+		(len(scope.Operators) > 0 && !lo.Contains(scope.Operators, session.Operator)) ||
+		!scopeCurrency(scope, session.Currency) {
+		return ErrOutsideReportScope
+	}
+
+	return nil
+}
+
+func scopeSpin(scope *entities.ReportScope, spin *entities.Spin) error {
+	if scope.Empty() {
+		return nil
+	}
+
+	if (len(scope.Games) > 0 && !lo.Contains(scope.Games, spin.GameID.String())) ||
+		(len(scope.Integrators) > 0 && !lo.Contains(scope.Integrators, spin.Integrator)) ||
+		(len(scope.Operators) > 0 && !lo.Contains(scope.Operators, spin.Operator)) ||
+		(len(scope.Countries) > 0 && !lo.Contains(scope.Countries, spin.Country)) ||
+		!scopeCurrency(scope, spin.Currency) {
+		return ErrOutsideReportScope
+	}
+
+	return nil
+}
+
+// scopeCurrency tells whether the scope allows the currency.
+func scopeCurrency(scope *entities.ReportScope, currency string) bool {
+	return scope.Empty() || len(scope.Currencies) == 0 ||
+		lo.ContainsBy(scope.Currencies, func(item string) bool { return strings.EqualFold(item, currency) })
+}
+
+// scopeIntegratorOperators drops the integrators and operators outside of the scope from the dictionary.
+func scopeIntegratorOperators(scope *entities.ReportScope, integrators map[string][]string) map[string][]string {
+	scoped := map[string][]string{}
+
+	for integrator, operators := range integrators {
+		if len(scope.Integrators) > 0 && !lo.Contains(scope.Integrators, integrator) {
+			continue
+		}
+
+		if len(scope.Operators) > 0 {
+			operators = lo.Intersect(operators, scope.Operators)
+			if len(operators) == 0 {
+				continue
+			}
+		}
+
+		scoped[integrator] = operators
+	}
+
+	return scoped
+}
@@ -0,0 +1,341 @@
+package services
+
+import (
+	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
+	"backoffice/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultRoleGrantMaxTTL   = 30 * 24 * time.Hour
+	defaultRoleGrantInterval = time.Minute
+)
+
+var (
+	ErrRoleGrantExpiry       = errors.New("role must be assigned with an expiry")
+	ErrRoleGrantExpiryPassed = errors.New("role grant expiry has passed")
+	ErrRoleGrantTooLong      = errors.New("role grant expiry is too far")
+	ErrRoleGrantPending      = errors.New("role grant is already waiting for approval")
+	ErrRoleGrantNotPending   = errors.New("role grant is not waiting for approval")
+	ErrRoleGrantApprover     = errors.New("only root and admin accounts can approve role grants")
+	ErrRoleGrantSelfApproval = errors.New("role grant must be approved by another administrator")
+	ErrRoleGrantUnattended   = errors.New("role needs an approval or an expiry, it can not be granted automatically")
+)
+
+type RoleGrantConfig struct {
+	// Approval are the role types a second administrator approves before they are granted, e.g. "admin".
+	Approval []string
+	// Expiring are the role types granted only until an expiry, no later than MaxTTL.
+	Expiring []string
+	MaxTTL   time.Duration
+	// Interval of the sweeper revoking expired grants.
+	Interval time.Duration
+}
+
+// RoleGrantService grants roles to accounts for a limited time and with the approval of a second
+// administrator where configured. Expired grants are revoked by Run and the account is logged out.
+type RoleGrantService struct {
+	cfg                   *RoleGrantConfig
+	grantRepo             repositories.RoleGrantRepository
+	roleRepo              repositories.RoleRepository
+	accountService        *AccountService
+	authorizationService  *AuthorizationService
+	authenticationService *AuthenticationService
+}
+
+func NewRoleGrantService(cfg *RoleGrantConfig, grantRepo repositories.RoleGrantRepository, roleRepo repositories.RoleRepository,
+	accountService *AccountService, authorizationService *AuthorizationService, authenticationService *AuthenticationService) *RoleGrantService {
+	c := *cfg
+
+	if c.MaxTTL <= 0 {
+		c.MaxTTL = defaultRoleGrantMaxTTL
+	}
+
+	if c.Interval <= 0 {
+		c.Interval = defaultRoleGrantInterval
+	}
+
+	return &RoleGrantService{
+		cfg:                   &c,
+		grantRepo:             grantRepo,
+		roleRepo:              roleRepo,
+		accountService:        accountService,
+		authorizationService:  authorizationService,
+		authenticationService: authenticationService,
+	}
+}
+
+// Request grants the role to the account, roles that need an approval are left pending.
+func (s *RoleGrantService) Request(ctx context.Context, session *entities.Session, account *entities.Account, roleID uuid.UUID,
+	expiresAt *time.Time) (*entities.RoleGrant, error) {
+	role, err := s.roleRepo.FindBy(ctx, map[string]interface{}{"id": roleID})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.Check(session, role, expiresAt); err != nil {
+		return nil, err
+	}
+
+	if lo.ContainsBy(account.Roles, func(item *entities.Role) bool { return item.ID == role.ID }) {
+		return nil, e.ErrRoleAlreadyAssigned
+	}
+
+	_, err = s.grantRepo.FindBy(ctx, map[string]interface{}{
+		"account_id": account.ID, "role_id": role.ID, "status": entities.RoleGrantPending,
+	})
+	if err == nil {
+		return nil, ErrRoleGrantPending
+	}
+
+	if !errors.Is(err, e.ErrEntityNotFound) {
+		return nil, err
+	}
+
+	now := time.Now()
+	grant := &entities.RoleGrant{
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		ID:             uuid.New(),
+		AccountID:      account.ID,
+		RoleID:         role.ID,
+		OrganizationID: &session.OrganizationID,
+		Status:         entities.RoleGrantPending,
+		ExpiresAt:      expiresAt,
+		RequestedBy:    &session.Account.ID,
+	}
+
+	if lo.Contains(s.cfg.Approval, role.Type) {
+		return s.grantRepo.Create(ctx, grant)
+	}
+
+	if err = s.authorizationService.ActivateRoleGrant(ctx, account, role, grant); err != nil {
+		return nil, err
+	}
+
+	return grant, nil
+}
+
+// Check tells whether the session can request the role until expiresAt, callers creating an account
+// check it before the account exists.
+func (s *RoleGrantService) Check(session *entities.Session, role *entities.Role, expiresAt *time.Time) error {
+	if role.Type == entities.RootRoleTypeName && !session.Account.IsRoot() {
+		return fmt.Errorf("%v: %v", ErrCanNotAssignRole, role.Type)
+	}
+
+	return s.validateExpiry(role, expiresAt)
+}
+
+// Assign grants the role without anyone requesting it, e.g. for identity provider groups. Roles that need
+// an approval or an expiry are refused.
+func (s *RoleGrantService) Assign(ctx context.Context, account *entities.Account, role *entities.Role) error {
+	if lo.Contains(s.cfg.Approval, role.Type) || lo.Contains(s.cfg.Expiring, role.Type) {
+		return fmt.Errorf("%w: %v", ErrRoleGrantUnattended, role.Type)
+	}
+
+	return s.authorizationService.AssignRole(ctx, account.ID.String(), role.ID.String())
+}
+
+// Approve activates a pending grant, the administrator approving it is neither the requester nor the account.
+func (s *RoleGrantService) Approve(ctx context.Context, session *entities.Session, grantID uuid.UUID) (*entities.RoleGrant, error) {
+	grant, err := s.decidable(ctx, session, grantID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	grant.DecidedBy = &session.Account.ID
+	grant.DecidedAt = &now
+
+	if grant.Expired(now) {
+		grant.Status = entities.RoleGrantExpired
+		grant.EndedAt = &now
+
+		if _, err = s.grantRepo.Save(ctx, grant); err != nil {
+			return nil, err
+		}
+
+		return nil, ErrRoleGrantExpiryPassed
+	}
+
+	account, err := s.accountService.FindBy(ctx, map[string]interface{}{"id": grant.AccountID})
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := s.roleRepo.FindBy(ctx, map[string]interface{}{"id": grant.RoleID})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.authorizationService.ActivateRoleGrant(ctx, account, role, grant); err != nil {
+		return nil, err
+	}
+
+	zap.S().Infow("role grant approved", "grant", grant.ID, "account", account.AuthProviderID, "role", role.Name,
+		"approver", session.Account.AuthProviderID)
+
+	return grant, nil
+}
+
+func (s *RoleGrantService) Reject(ctx context.Context, session *entities.Session, grantID uuid.UUID) (*entities.RoleGrant, error) {
+	grant, err := s.decidable(ctx, session, grantID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	grant.Status = entities.RoleGrantRejected
+	grant.DecidedBy = &session.Account.ID
+	grant.DecidedAt = &now
+	grant.EndedAt = &now
+	grant.UpdatedAt = now
+
+	return s.grantRepo.Save(ctx, grant)
+}
+
+// Account lists the grants of the account, all of them when status is empty.
+func (s *RoleGrantService) Account(ctx context.Context, accountID uuid.UUID, status string, limit, page int) (
+	entities.Pagination[entities.RoleGrant], error) {
+	filters := map[string]interface{}{"account_id": accountID}
+	if status != "" {
+		filters["status"] = status
+	}
+
+	return s.grantRepo.Paginate(ctx, filters, "created_at desc", limit, page)
+}
+
+// Role lists the grants of the role made in the organization of the session, root sees all of them.
+func (s *RoleGrantService) Role(ctx context.Context, session *entities.Session, roleID uuid.UUID, status string, limit, page int) (
+	entities.Pagination[entities.RoleGrant], error) {
+	filters := map[string]interface{}{"role_id": roleID}
+	if status != "" {
+		filters["status"] = status
+	}
+
+	if !session.Account.IsRoot() {
+		filters["organization_id"] = session.OrganizationID
+	}
+
+	return s.grantRepo.Paginate(ctx, filters, "created_at desc", limit, page)
+}
+
+// Run revokes expired grants until ctx is cancelled.
+func (s *RoleGrantService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.expire(ctx)
+		}
+	}
+}
+
+func (s *RoleGrantService) expire(ctx context.Context) {
+	now := time.Now()
+
+	if err := s.grantRepo.ExpirePending(ctx, now); err != nil {
+		zap.S().Error(err)
+	}
+
+	expired, err := s.roleRepo.FindExpired(ctx, now)
+	if err != nil {
+		zap.S().Error(err)
+
+		return
+	}
+
+	for _, accountRole := range expired {
+		if err = s.roleRepo.Revoke(ctx, &entities.Account{ID: accountRole.AccountID}, &entities.Role{ID: accountRole.RoleID}); err != nil {
+			zap.S().Error(err)
+
+			continue
+		}
+
+		// only the instance that ends the grant logs the account out
+		ended, err := s.grantRepo.End(ctx, accountRole.AccountID, accountRole.RoleID, entities.RoleGrantExpired)
+		if err != nil {
+			zap.S().Error(err)
+
+			continue
+		}
+
+		if !ended {
+			continue
+		}
+
+		if err = s.authenticationService.RevokeOthers(ctx, accountRole.AccountID, uuid.Nil); err != nil {
+			zap.S().Error(err)
+		}
+
+		zap.S().Infow("role grant expired", "account", accountRole.AccountID, "role", accountRole.RoleID,
+			"expires_at", accountRole.ExpiresAt)
+	}
+}
+
+func (s *RoleGrantService) validateExpiry(role *entities.Role, expiresAt *time.Time) error {
+	if expiresAt == nil {
+		if lo.Contains(s.cfg.Expiring, role.Type) {
+			return ErrRoleGrantExpiry
+		}
+
+		return nil
+	}
+
+	if !expiresAt.After(time.Now()) {
+		return ErrRoleGrantExpiryPassed
+	}
+
+	if lo.Contains(s.cfg.Expiring, role.Type) && expiresAt.After(time.Now().Add(s.cfg.MaxTTL)) {
+		return fmt.Errorf("%w: at most %s", ErrRoleGrantTooLong, s.cfg.MaxTTL)
+	}
+
+	return nil
+}
+
+func (s *RoleGrantService) decidable(ctx context.Context, session *entities.Session, grantID uuid.UUID) (*entities.RoleGrant, error) {
+	if !session.Account.IsRoot() && !session.Account.IsAdmin() {
+		return nil, ErrRoleGrantApprover
+	}
+
+	grant, err := s.grantRepo.FindBy(ctx, map[string]interface{}{"id": grantID})
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.Account.IsRoot() && (grant.OrganizationID == nil || *grant.OrganizationID != session.OrganizationID) {
+		return nil, e.ErrEntityNotFound
+	}
+
+	switch {
+	case !grant.IsPending():
+		return nil, ErrRoleGrantNotPending
+	case grant.AccountID == session.Account.ID, grant.RequestedBy != nil && *grant.RequestedBy == session.Account.ID:
+		return nil, ErrRoleGrantSelfApproval
+	}
+
+	if !session.Account.IsRoot() {
+		role, err := s.roleRepo.FindBy(ctx, map[string]interface{}{"id": grant.RoleID})
+		if err != nil {
+			return nil, err
+		}
+
+		if role.Type == entities.RootRoleTypeName {
+			return nil, fmt.Errorf("%v: %v", ErrCanNotAssignRole, role.Type)
+		}
+	}
+
+	return grant, nil
+}
@@ -12,6 +12,9 @@ import (
 	"time"
 )
 
+// sessionTouchInterval limits last use writes to one per session and interval.
+const sessionTouchInterval = time.Minute
+
 type SessionService struct {
 	sessionRepository repositories.SessionRepository
 }
@@ -38,6 +41,21 @@ func (s *SessionService) Create(ctx context.Context, key uuid.UUID, session *ent
 	return s.sessionRepository.Create(ctx, key, session, expiration)
 }
 
+func (s *SessionService) Find(ctx context.Context, accountID uuid.UUID) ([]*entities.Session, error) {
+	return s.sessionRepository.Find(ctx, accountID)
+}
+
+// Touch records the use of a session, writes are limited to one per sessionTouchInterval.
+func (s *SessionService) Touch(ctx context.Context, session *entities.Session, ip string) error {
+	if time.Since(session.LastUsedAt) < sessionTouchInterval && session.IP == ip {
+		return nil
+	}
+
+	session.LastUsedAt, session.IP = time.Now(), ip
+
+	return s.sessionRepository.Update(ctx, session.ID, session)
+}
+
 func (s *SessionService) SwitchOrganization(ctx context.Context, session *entities.Session, sessionID, organizationID uuid.UUID) error {
 	session.OrganizationID = organizationID
 
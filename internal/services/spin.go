@@ -1,51 +1,385 @@
 package services
 
 import (
+	"backoffice/internal/constants"
 	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
 	"backoffice/pkg/history"
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// exchangeRateLookback is how far before a range the rate in force at its start is searched for.
+	exchangeRateLookback = 7 * 24 * time.Hour
+	// consolidatedPeriodsLimit caps the periods between rate changes a spin time report queries history for,
+	// every period takes two calls.
+	consolidatedPeriodsLimit = 32
+)
+
+var (
+	ErrUnknownRateMode          = errors.New("unknown rate mode")
+	ErrRateRangeStartIsRequired = errors.New("starting_from is required to apply rates at spin time")
+	ErrExchangeRateNotFound     = errors.New("exchange rate not found")
+	ErrConsolidatedFilter       = errors.New("consolidated report can not be filtered by session, round, host, user or rtp")
+	ErrTooManyRatePeriods       = fmt.Errorf("rates change more than %d times in the range, "+
+		"shorten the range or use the snapshot rate mode", consolidatedPeriodsLimit-1)
 )
 
 type SpinService struct {
-	gameService   *GameService
-	historyClient history.Client
+	gameService     *GameService
+	currencyService *CurrencyService
+	historyClient   history.Client
 }
 
-func NewSpinService(gameService *GameService, historyClient history.Client) *SpinService {
-	return &SpinService{gameService: gameService, historyClient: historyClient}
+func NewSpinService(gameService *GameService, currencyService *CurrencyService, historyClient history.Client) *SpinService {
+	return &SpinService{gameService: gameService, currencyService: currencyService, historyClient: historyClient}
 }
 
-func (s *SpinService) FinancialReport(ctx context.Context, organizationID *uuid.UUID, filters *entities.FinancialBase) (*entities.FinancialReport, error) {
-	gameIDs, err := s.gameService.IDsString(ctx, organizationID)
+func (s *SpinService) FinancialReport(ctx context.Context, access *entities.ReportAccess, filters *entities.FinancialBase) (*entities.FinancialReport, error) {
+	fb, err := s.historyFilters(ctx, access, filters)
 	if err != nil {
 		return nil, err
 	}
 
-	fb, err := filters.ToHistoryFilters(gameIDs)
+	rep, err := s.historyClient.GetFinancialReport(ctx, fb)
 	if err != nil {
 		return nil, err
 	}
 
-	rep, err := s.historyClient.GetFinancialReport(ctx, fb)
+	return entities.FinancialReportFromHistory(rep), nil
+}
+
+func (s *SpinService) FinancialSeries(ctx context.Context, access *entities.ReportAccess, filters *entities.FinancialBase) ([]*entities.ReportSeriesItem, error) {
+	gameIDs, err := s.gameService.IDsString(ctx, access.OrganizationID)
 	if err != nil {
 		return nil, err
 	}
 
-	return entities.FinancialReportFromHistory(rep), nil
+	in, err := filters.ToHistorySeries(gameIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = applyReportScope(access.Scope, in.Base); err != nil {
+		return nil, err
+	}
+
+	out, err := s.historyClient.GetFinancialSeries(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return reportSeriesFromHistory(out, filters.Location()), nil
 }
 
-func (s *SpinService) Paginate(ctx context.Context, organizationID *uuid.UUID, filters *entities.FinancialBase, order string, limit int, page int) (
-	pagination entities.Pagination[entities.Spin], err error) {
-	gameIDs, err := s.gameService.IDsString(ctx, organizationID)
+func (s *SpinService) AggregatedSeries(ctx context.Context, access *entities.ReportAccess, filters *entities.AggregateFilters) ([]*entities.ReportSeriesItem, error) {
+	gameIDs, err := s.gameService.IDsString(ctx, access.OrganizationID)
 	if err != nil {
-		return pagination, err
+		return nil, err
 	}
 
-	fb, err := filters.ToHistoryFilters(gameIDs)
+	in, err := filters.ToHistorySeries(gameIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = applyAggregatedReportScope(access.Scope, in.Filters); err != nil {
+		return nil, err
+	}
+
+	// buckets sum up every currency
+	if !access.Scope.Empty() && len(access.Scope.Currencies) > 0 {
+		return nil, ErrReportScopeCurrency
+	}
+
+	out, err := s.historyClient.GetAggregatedSeries(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return reportSeriesFromHistory(out, filters.Location()), nil
+}
+
+func reportSeriesFromHistory(out []*history.SeriesItem, loc *time.Location) []*entities.ReportSeriesItem {
+	series := lo.Map(out, func(item *history.SeriesItem, index int) *entities.ReportSeriesItem {
+		return entities.ReportSeriesItemFromHistory(item, loc)
+	})
+
+	sort.Slice(series, func(i, j int) bool {
+		return series[i].Bucket.Before(series[j].Bucket)
+	})
+
+	return series
+}
+
+// ConsolidatedFinancialReport converts per-currency totals into the target currency.
+// In spin time mode the range is split at every rate change so each spin is converted at the rate in force when it was played,
+// in snapshot mode every currency is converted at the rate in force at rate_at. Ranges whose rates change more often than
+// consolidatedPeriodsLimit allows are refused in spin time mode.
+func (s *SpinService) ConsolidatedFinancialReport(ctx context.Context, access *entities.ReportAccess, filters *entities.ConsolidatedFinancialFilters) (
+	*entities.ConsolidatedFinancialReport, error) {
+	if filters.RateMode == "" {
+		filters.RateMode = entities.RateModeSpinTime
+	}
+
+	if !entities.IsRateMode(filters.RateMode) {
+		return nil, ErrUnknownRateMode
+	}
+
+	// per-currency totals come from the aggregated reports, which know none of these filters
+	if filters.SessionID != "" || filters.RoundID != "" || filters.Host != "" || filters.UserID != "" ||
+		filters.ExternalUserID != "" || filters.RTPFrom != 0 {
+		return nil, ErrConsolidatedFilter
+	}
+
+	from, to, err := consolidatedRateRange(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &entities.ConsolidatedFinancialReport{
+		Currency: strings.ToLower(filters.TargetCurrency),
+		RateMode: filters.RateMode,
+	}
+
+	if filters.RateMode == entities.RateModeSnapshot {
+		report.RateAt = &from
+	}
+
+	// converting does not change the counts, users playing in several currencies are counted once
+	total, err := s.FinancialReport(ctx, access, &entities.FinancialBase{Currency: &report.Currency, FinancialFilters: filters.FinancialFilters})
+	if err != nil {
+		return nil, err
+	}
+
+	report.Total = &entities.FinancialReport{UserQuantity: total.UserQuantity}
+
+	totals, err := s.consolidatedTotals(ctx, access, filters, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	currencies := lo.Keys(totals)
+	sort.Strings(currencies)
+
+	items := map[string]*entities.ConsolidatedFinancialReportItem{}
+	bounds := []time.Time{}
+
+	for _, currency := range currencies {
+		rates, err := s.appliedRates(ctx, currency, report.Currency, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		items[currency] = &entities.ConsolidatedFinancialReportItem{
+			Currency:       currency,
+			TargetCurrency: report.Currency,
+			Rates:          rates,
+			SpinQuantity:   totals[currency].SpinQuantity,
+			UserQuantity:   totals[currency].UserQuantity,
+		}
+
+		report.Items = append(report.Items, items[currency])
+
+		for _, rate := range rates[1:] {
+			bounds = append(bounds, rate.ValidFrom)
+		}
+	}
+
+	if len(bounds) == 0 {
+		for _, currency := range currencies {
+			items[currency].Add(totals[currency], items[currency].Rates[0].Rate)
+		}
+
+		return report.Compute(), nil
+	}
+
+	if err = s.consolidatedParts(ctx, access, filters, items, from, to, bounds); err != nil {
+		return nil, err
+	}
+
+	return report.Compute(), nil
+}
+
+// consolidatedParts converts the totals of every period between rate changes of any currency at the rates in force
+// in the period. Periods are half-open, a spin played at a rate change is counted in the period it starts.
+func (s *SpinService) consolidatedParts(ctx context.Context, access *entities.ReportAccess, filters *entities.ConsolidatedFinancialFilters,
+	items map[string]*entities.ConsolidatedFinancialReportItem, from, to time.Time, bounds []time.Time) error {
+	sort.Slice(bounds, func(i, j int) bool {
+		return bounds[i].Before(bounds[j])
+	})
+
+	starts := append([]time.Time{from}, lo.UniqBy(bounds, func(item time.Time) int64 { return item.UnixNano() })...)
+	if len(starts) > consolidatedPeriodsLimit {
+		return ErrTooManyRatePeriods
+	}
+
+	for i, start := range starts {
+		end := to
+		if i+1 < len(starts) {
+			end = starts[i+1].Add(-time.Nanosecond)
+		}
+
+		parts, err := s.consolidatedTotals(ctx, access, filters, func(f *history.GetAggregatedReportFilters) {
+			f.StartingFrom = timestamppb.New(start)
+			f.EndingAt = timestamppb.New(end)
+		})
+		if err != nil {
+			return err
+		}
+
+		for currency, part := range parts {
+			item, ok := items[currency]
+			if !ok {
+				continue
+			}
+
+			item.Add(part, item.RateAt(start))
+		}
+	}
+
+	return nil
+}
+
+// consolidatedTotals sums the aggregated reports of all countries by the currency the spins were played in,
+// with overrides the filters of the report.
+func (s *SpinService) consolidatedTotals(ctx context.Context, access *entities.ReportAccess, filters *entities.ConsolidatedFinancialFilters,
+	with func(f *history.GetAggregatedReportFilters)) (map[string]*entities.FinancialReport, error) {
+	af := &entities.AggregateFilters{
+		Integrator:   filters.Integrator,
+		Operator:     filters.Operator,
+		StartingFrom: filters.StartingFrom,
+		EndingAt:     filters.EndingAt,
+		IsDemo:       filters.IsDemo,
+	}
+
+	allFilter, pfrFilter, err := s.aggregatedReportFilters(ctx, access, af, func(f *history.GetAggregatedReportFilters) {
+		if filters.GameName != "" {
+			f.Game = &filters.GameName
+		}
+
+		if with != nil {
+			with(f)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	allReps, err := s.historyClient.GetAggregatedReportByCountry(ctx, allFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	pfrReps, err := s.historyClient.GetAggregatedReportByCountry(ctx, pfrFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := map[string]*entities.FinancialReport{}
+	total := func(currency string) *entities.FinancialReport {
+		if _, ok := totals[currency]; !ok {
+			totals[currency] = &entities.FinancialReport{}
+		}
+
+		return totals[currency]
+	}
+
+	for _, rep := range allReps {
+		t := total(rep.Currency)
+		t.Wager += rep.Wager
+		t.Award += rep.Award
+		t.WagerWithoutPFR += rep.Wager
+		t.AwardWithoutPFR += rep.Award
+		t.SpinQuantity += int(rep.RoundCount)
+		// a user plays from one country, so users of the countries add up
+		t.UserQuantity += int(rep.UserCount)
+	}
+
+	for _, rep := range pfrReps {
+		t := total(rep.Currency)
+		t.WagerWithoutPFR -= rep.Wager
+		t.AwardWithoutPFR -= rep.Award
+	}
+
+	return totals, nil
+}
+
+// appliedRates splits [from, to] into half-open intervals [ValidFrom, ValidTo) with a constant currency->target rate.
+func (s *SpinService) appliedRates(ctx context.Context, currency, target string, from, to time.Time) ([]*entities.AppliedRate, error) {
+	if strings.EqualFold(currency, target) {
+		return []*entities.AppliedRate{{ValidFrom: from, ValidTo: to, Rate: 1}}, nil
+	}
+
+	rates, err := s.currencyService.Rates(ctx, currency, target, from.Add(-exchangeRateLookback), to)
+	if err != nil {
+		return nil, err
+	}
+
+	_, start, ok := lo.FindLastIndexOf(rates, func(item *entities.CurrencyExchange) bool {
+		return !item.CreatedAt.After(from)
+	})
+	if !ok {
+		return nil, fmt.Errorf("%w: %s->%s at %s", ErrExchangeRateNotFound, currency, target, from.Format(constants.TimeLayout))
+	}
+
+	applied := []*entities.AppliedRate{{ValidFrom: from, ValidTo: to, Rate: rates[start].Rate}}
+
+	for _, rate := range rates[start+1:] {
+		if !rate.CreatedAt.Before(to) {
+			break
+		}
+
+		applied[len(applied)-1].ValidTo = rate.CreatedAt
+		applied = append(applied, &entities.AppliedRate{ValidFrom: rate.CreatedAt, ValidTo: to, Rate: rate.Rate})
+	}
+
+	return applied, nil
+}
+
+// consolidatedRateRange returns the period rates are applied to, in snapshot mode both ends are rate_at.
+func consolidatedRateRange(filters *entities.ConsolidatedFinancialFilters) (from, to time.Time, err error) {
+	if filters.RateMode == entities.RateModeSnapshot {
+		from = time.Now()
+		if filters.RateAt != "" {
+			if from, err = time.Parse(constants.TimeLayout, filters.RateAt); err != nil {
+				return
+			}
+		}
+
+		return from, from, nil
+	}
+
+	if filters.StartingFrom == "" {
+		return from, to, ErrRateRangeStartIsRequired
+	}
+
+	if from, err = time.Parse(constants.TimeLayout, filters.StartingFrom); err != nil {
+		return
+	}
+
+	to = time.Now()
+	if filters.EndingAt != "" {
+		if to, err = time.Parse(constants.TimeLayout, filters.EndingAt); err != nil {
+			return
+		}
+	}
+
+	return from, to, nil
+}
+
+func (s *SpinService) Paginate(ctx context.Context, access *entities.ReportAccess, filters *entities.FinancialBase, order string, limit int, page int) (
+	pagination entities.Pagination[entities.Spin], err error) {
+	fb, err := s.historyFilters(ctx, access, filters)
 	if err != nil {
 		return pagination, err
 	}
@@ -71,14 +405,9 @@ func (s *SpinService) Paginate(ctx context.Context, organizationID *uuid.UUID, f
 	return pagination, nil
 }
 
-func (s *SpinService) PaginateGrouped(ctx context.Context, organizationID *uuid.UUID, filters *entities.FinancialBase, order string, limit, page int, groupBy []string) (
+func (s *SpinService) PaginateGrouped(ctx context.Context, access *entities.ReportAccess, filters *entities.FinancialBase, order string, limit, page int, groupBy []string) (
 	pagination entities.Pagination[entities.GroupedSpin], err error) {
-	gameIDs, err := s.gameService.IDsString(ctx, organizationID)
-	if err != nil {
-		return
-	}
-
-	fb, err := filters.ToHistoryFilters(gameIDs)
+	fb, err := s.historyFilters(ctx, access, filters)
 	if err != nil {
 		return pagination, err
 	}
@@ -131,14 +460,9 @@ func (s *SpinService) PaginateGrouped(ctx context.Context, organizationID *uuid.
 	return pagination, nil
 }
 
-func (s *SpinService) PaginateGamingSession(ctx context.Context, organizationID *uuid.UUID, filters *entities.FinancialBase, order string, limit, page int) (
+func (s *SpinService) PaginateGamingSession(ctx context.Context, access *entities.ReportAccess, filters *entities.FinancialBase, order string, limit, page int) (
 	pagination entities.Pagination[entities.GamingSession], err error) {
-	gameIDs, err := s.gameService.IDsString(ctx, organizationID)
-	if err != nil {
-		return
-	}
-
-	fb, err := filters.ToHistoryFilters(gameIDs)
+	fb, err := s.historyFilters(ctx, access, filters)
 	if err != nil {
 		return pagination, err
 	}
@@ -164,18 +488,29 @@ func (s *SpinService) PaginateGamingSession(ctx context.Context, organizationID
 	return pagination, nil
 }
 
-func (s *SpinService) Session(ctx context.Context, organizationID *uuid.UUID, id uuid.UUID, currency string) (*entities.GamingSession, error) {
-	gameIDs, err := s.gameService.IDsString(ctx, organizationID)
+func (s *SpinService) Session(ctx context.Context, access *entities.ReportAccess, id uuid.UUID, currency string) (*entities.GamingSession, error) {
+	gameIDs, err := s.gameService.IDsString(ctx, access.OrganizationID)
 	if err != nil {
 		return nil, err
 	}
 
+	if !access.Scope.Empty() {
+		if gameIDs, err = scopeGames(access.Scope, gameIDs); err != nil {
+			return nil, err
+		}
+	}
+
 	out, err := s.historyClient.GetSession(ctx, gameIDs, id.String(), currency)
 	if err != nil {
 		return nil, err
 	}
 
-	return entities.GamingSessionFromHistory(out), nil
+	session := entities.GamingSessionFromHistory(out)
+	if err = scopeGamingSession(access.Scope, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
 }
 
 func (s *SpinService) GameSession(ctx context.Context, gameName string, id uuid.UUID, currency string) (*entities.GamingSession, error) {
@@ -195,13 +530,8 @@ func (s *SpinService) GameSession(ctx context.Context, gameName string, id uuid.
 	return entities.GamingSessionFromHistory(out), nil
 }
 
-func (s *SpinService) AllSpins(ctx context.Context, organizationID *uuid.UUID, filters *entities.FinancialBase) ([]*entities.Spin, error) {
-	gameIDs, err := s.gameService.IDsString(ctx, organizationID)
-	if err != nil {
-		return nil, err
-	}
-
-	fb, err := filters.ToHistoryFilters(gameIDs)
+func (s *SpinService) AllSpins(ctx context.Context, access *entities.ReportAccess, filters *entities.FinancialBase) ([]*entities.Spin, error) {
+	fb, err := s.historyFilters(ctx, access, filters)
 	if err != nil {
 		return nil, err
 	}
@@ -216,13 +546,8 @@ func (s *SpinService) AllSpins(ctx context.Context, organizationID *uuid.UUID, f
 	}), nil
 }
 
-func (s *SpinService) AllGamingSessions(ctx context.Context, organizationID *uuid.UUID, filters *entities.FinancialBase) ([]*entities.GamingSession, error) {
-	gameIDs, err := s.gameService.IDsString(ctx, organizationID)
-	if err != nil {
-		return nil, err
-	}
-
-	fb, err := filters.ToHistoryFilters(gameIDs)
+func (s *SpinService) AllGamingSessions(ctx context.Context, access *entities.ReportAccess, filters *entities.FinancialBase) ([]*entities.GamingSession, error) {
+	fb, err := s.historyFilters(ctx, access, filters)
 	if err != nil {
 		return nil, err
 	}
@@ -237,43 +562,100 @@ func (s *SpinService) AllGamingSessions(ctx context.Context, organizationID *uui
 	}), nil
 }
 
-func (s *SpinService) Currencies(ctx context.Context, organizationID *uuid.UUID, filters *entities.FinancialBase) ([]string, error) {
-	gameIDs, err := s.gameService.IDsString(ctx, organizationID)
+// Currencies lists the currencies of the spins, a scope limited to currencies keeps its currencies.
+func (s *SpinService) Currencies(ctx context.Context, access *entities.ReportAccess, filters *entities.FinancialBase) ([]string, error) {
+	scope := access.Scope
+	if !scope.Empty() && len(scope.Currencies) > 0 {
+		unlimited := *scope
+		unlimited.Currencies = nil
+		access = &entities.ReportAccess{OrganizationID: access.OrganizationID, Scope: &unlimited}
+	}
+
+	fb, err := s.historyFilters(ctx, access, filters)
 	if err != nil {
 		return nil, err
 	}
 
-	fb, err := filters.ToHistoryFilters(gameIDs)
+	currencies, err := s.historyClient.GetCurrencies(ctx, fb)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.historyClient.GetCurrencies(ctx, fb)
+	return lo.Filter(currencies, func(item string, _ int) bool { return scopeCurrency(scope, item) }), nil
 }
 
-func (s *SpinService) Hosts(ctx context.Context, organizationID *uuid.UUID) ([]string, error) {
-	gameIDs, err := s.gameService.IDsString(ctx, organizationID)
+func (s *SpinService) Hosts(ctx context.Context, access *entities.ReportAccess) ([]string, error) {
+	fb, err := s.historyFilters(ctx, access, &entities.FinancialBase{})
 	if err != nil {
 		return nil, err
 	}
 
-	filters := &entities.FinancialBase{}
+	return s.historyClient.GetHosts(ctx, fb)
+}
+
+func (s *SpinService) IntegratorOperatorsMap(ctx context.Context, access *entities.ReportAccess) (map[string][]string, error) {
+	gameIDs, err := s.gameService.IDsString(ctx, access.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if access.Scope.Empty() {
+		return s.historyClient.IntegratorOperatorsMap(ctx, gameIDs)
+	}
+
+	if gameIDs, err = scopeGames(access.Scope, gameIDs); err != nil {
+		return nil, err
+	}
+
+	integrators, err := s.historyClient.IntegratorOperatorsMap(ctx, gameIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return scopeIntegratorOperators(access.Scope, integrators), nil
+}
+
+// historyFilters builds the history filters of the report limited to the games of the organization and the scope.
+func (s *SpinService) historyFilters(ctx context.Context, access *entities.ReportAccess, filters *entities.FinancialBase) (
+	*history.FinancialBase, error) {
+	gameIDs, err := s.gameService.IDsString(ctx, access.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
 
 	fb, err := filters.ToHistoryFilters(gameIDs)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.historyClient.GetHosts(ctx, fb)
+	if err = applyReportScope(access.Scope, fb); err != nil {
+		return nil, err
+	}
+
+	return fb, nil
 }
 
-func (s *SpinService) IntegratorOperatorsMap(ctx context.Context, organizationID *uuid.UUID) (map[string][]string, error) {
-	gameIDs, err := s.gameService.IDsString(ctx, organizationID)
+// Spin finds a round of the games of the organization within the report scope.
+func (s *SpinService) Spin(ctx context.Context, access *entities.ReportAccess, roundID, currency string) (*entities.Spin, error) {
+	spin, err := s.GetSpin(ctx, roundID, currency)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.historyClient.IntegratorOperatorsMap(ctx, gameIDs)
+	gameIDs, err := s.gameService.IDsString(ctx, access.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !lo.Contains(gameIDs, spin.GameID.String()) {
+		return nil, e.ErrEntityNotFound
+	}
+
+	if err = scopeSpin(access.Scope, spin); err != nil {
+		return nil, err
+	}
+
+	return spin, nil
 }
 
 func (s *SpinService) GetSpin(ctx context.Context, roundID, currency string) (*entities.Spin, error) {
@@ -286,17 +668,16 @@ func (s *SpinService) GetSpin(ctx context.Context, roundID, currency string) (*e
 }
 
 // TODO: make generic
-func (s *SpinService) AggregatedReportByGame(ctx context.Context, organizationID *uuid.UUID, currency string, country *string, filters *entities.AggregateFilters) ([]*entities.AggregatedReportByGame, error) {
-	allFilter, pfrFilter, err := s.aggregatedReportFilters(ctx, organizationID, filters)
+func (s *SpinService) AggregatedReportByGame(ctx context.Context, access *entities.ReportAccess, currency string, country *string, filters *entities.AggregateFilters) ([]*entities.AggregatedReportByGame, error) {
+	allFilter, pfrFilter, err := s.aggregatedReportFilters(ctx, access, filters, func(f *history.GetAggregatedReportFilters) {
+		if country != nil {
+			f.Country = country
+		}
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if country != nil {
-		allFilter.Country = country
-		pfrFilter.Country = country
-	}
-
 	allReps, err := s.historyClient.GetAggregatedReportByGame(ctx, allFilter)
 	if err != nil {
 		return nil, err
@@ -326,7 +707,9 @@ func (s *SpinService) AggregatedReportByGame(ctx context.Context, organizationID
 		finalReps[aggregatedReportByGameKey(rep)] = exRep
 	}
 
-	final := lo.Values(finalReps)
+	final := lo.Filter(lo.Values(finalReps), func(item *entities.AggregatedReportByGame, _ int) bool {
+		return scopeCurrency(access.Scope, item.Currency)
+	})
 
 	lo.ForEach(final, func(item *entities.AggregatedReportByGame, index int) {
 		item.Compute()
@@ -335,17 +718,16 @@ func (s *SpinService) AggregatedReportByGame(ctx context.Context, organizationID
 	return final, nil
 }
 
-func (s *SpinService) AggregatedReportByCountry(ctx context.Context, organizationID *uuid.UUID, currency string, game *string, filters *entities.AggregateFilters) ([]*entities.AggregatedReportByCountry, error) {
-	allFilter, pfrFilter, err := s.aggregatedReportFilters(ctx, organizationID, filters)
+func (s *SpinService) AggregatedReportByCountry(ctx context.Context, access *entities.ReportAccess, currency string, game *string, filters *entities.AggregateFilters) ([]*entities.AggregatedReportByCountry, error) {
+	allFilter, pfrFilter, err := s.aggregatedReportFilters(ctx, access, filters, func(f *history.GetAggregatedReportFilters) {
+		if game != nil {
+			f.Game = game
+		}
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if game != nil {
-		allFilter.Game = game
-		pfrFilter.Game = game
-	}
-
 	allReps, err := s.historyClient.GetAggregatedReportByCountry(ctx, allFilter)
 	if err != nil {
 		return nil, err
@@ -374,7 +756,9 @@ func (s *SpinService) AggregatedReportByCountry(ctx context.Context, organizatio
 		finalReps[aggregatedReportByCountryKey(rep)] = exRep
 	}
 
-	final := lo.Values(finalReps)
+	final := lo.Filter(lo.Values(finalReps), func(item *entities.AggregatedReportByCountry, _ int) bool {
+		return scopeCurrency(access.Scope, item.Currency)
+	})
 
 	lo.ForEach(final, func(item *entities.AggregatedReportByCountry, index int) {
 		item.Compute()
@@ -396,9 +780,11 @@ func aggregatedReportByGameKey(ar *history.GetAggregatedReportByGameItem) string
 	return fmt.Sprintf("%v/%v", ar.GameId, ar.Currency)
 }
 
-func (s *SpinService) aggregatedReportFilters(ctx context.Context, organizationID *uuid.UUID, filters *entities.AggregateFilters) (
-	*history.GetAggregatedReportFilters, *history.GetAggregatedReportFilters, error) {
-	gameIDs, err := s.gameService.IDsString(ctx, organizationID)
+// aggregatedReportFilters builds the filters of all spins and of free spins, with sets filters of the report
+// before the scope is applied.
+func (s *SpinService) aggregatedReportFilters(ctx context.Context, access *entities.ReportAccess, filters *entities.AggregateFilters,
+	with func(f *history.GetAggregatedReportFilters)) (*history.GetAggregatedReportFilters, *history.GetAggregatedReportFilters, error) {
+	gameIDs, err := s.gameService.IDsString(ctx, access.OrganizationID)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -416,16 +802,24 @@ func (s *SpinService) aggregatedReportFilters(ctx context.Context, organizationI
 		return nil, nil, err
 	}
 
+	for _, f := range []*history.GetAggregatedReportFilters{allFilter, pfrFilter} {
+		with(f)
+
+		if err = applyAggregatedReportScope(access.Scope, f); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	return allFilter, pfrFilter, nil
 }
 
-func (s *SpinService) UserReport(ctx context.Context, organizationID *uuid.UUID, id, currency string) (
+func (s *SpinService) UserReport(ctx context.Context, access *entities.ReportAccess, id, currency string) (
 	report *entities.UserReport, err error) {
 	filters := &entities.FinancialBase{}
 	filters.ExternalUserID = id
 	filters.Currency = &currency
 
-	spins, err := s.AllSpins(ctx, organizationID, filters)
+	spins, err := s.AllSpins(ctx, access, filters)
 	if err != nil {
 		return
 	}
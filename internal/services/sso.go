@@ -0,0 +1,327 @@
+package services
+
+import (
+	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
+	"backoffice/internal/repositories"
+	"backoffice/pkg/auth"
+	"backoffice/pkg/oidc"
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const (
+	ssoStateLength    = 24
+	ssoVerifierLength = 48
+	// accountNameLength is the size of first_name and last_name columns.
+	accountNameLength = 40
+)
+
+var (
+	ErrSSODisabled       = errors.New("single sign-on is not configured")
+	ErrSSOStateInvalid   = errors.New("single sign-on state is invalid or expired")
+	ErrSSOStateUnbound   = errors.New("single sign-on was started in another browser")
+	ErrSSOCallbackParams = errors.New("state and code are required")
+	ErrSSOEmailRequired  = errors.New("identity provider did not return a verified email")
+	ErrSSONoRoles        = errors.New("none of the identity provider groups grant access")
+	ErrSSORootAccount    = errors.New("root accounts can not sign in with single sign-on")
+)
+
+type SSOConfig struct {
+	// TrustEmail treats emails as verified for identity providers that do not send email_verified.
+	TrustEmail bool
+	// SuccessURL receives the issued tokens in the url fragment, the callback answers with json when empty.
+	SuccessURL string
+	GroupRoles []*SSOGroupRole
+}
+
+// SSOGroupRole grants the role to members of the identity provider group.
+type SSOGroupRole struct {
+	Group  string
+	RoleID string
+}
+
+type SSOService struct {
+	cfg                   *SSOConfig
+	provider              *oidc.Provider
+	stateRepo             repositories.SSOStateRepository
+	identityRepo          repositories.BaseRepository[entities.AccountIdentity]
+	accountService        *AccountService
+	organizationService   *OrganizationService
+	authorizationService  *AuthorizationService
+	authenticationService *AuthenticationService
+	roleGrantService      *RoleGrantService
+}
+
+func NewSSOService(cfg *SSOConfig, provider *oidc.Provider, stateRepo repositories.SSOStateRepository,
+	identityRepo repositories.BaseRepository[entities.AccountIdentity], accountService *AccountService,
+	organizationService *OrganizationService, authorizationService *AuthorizationService,
+	authenticationService *AuthenticationService, roleGrantService *RoleGrantService) *SSOService {
+	return &SSOService{
+		cfg:                   cfg,
+		provider:              provider,
+		stateRepo:             stateRepo,
+		identityRepo:          identityRepo,
+		accountService:        accountService,
+		organizationService:   organizationService,
+		authorizationService:  authorizationService,
+		authenticationService: authenticationService,
+		roleGrantService:      roleGrantService,
+	}
+}
+
+func (s *SSOService) Enabled() bool {
+	return s.provider != nil
+}
+
+func (s *SSOService) SuccessURL() string {
+	return s.cfg.SuccessURL
+}
+
+// Login starts the authorization code flow and returns the url of the identity provider and the state,
+// which the caller binds to the browser so the callback can check the flow was started there.
+func (s *SSOService) Login(ctx context.Context) (u, state string, err error) {
+	if !s.Enabled() {
+		return "", "", ErrSSODisabled
+	}
+
+	if state, err = oidc.RandomString(ssoStateLength); err != nil {
+		return "", "", err
+	}
+
+	nonce, err := oidc.RandomString(ssoStateLength)
+	if err != nil {
+		return "", "", err
+	}
+
+	verifier, err := oidc.RandomString(ssoVerifierLength)
+	if err != nil {
+		return "", "", err
+	}
+
+	if err = s.stateRepo.Create(ctx, state, &entities.SSOState{Nonce: nonce, Verifier: verifier}); err != nil {
+		return "", "", err
+	}
+
+	if u, err = s.provider.AuthCodeURL(ctx, state, nonce, verifier); err != nil {
+		return "", "", err
+	}
+
+	return u, state, nil
+}
+
+// Callback finishes the flow: the code is exchanged, the id token verified, the account found, linked
+// or created, its mapped roles synced with the groups of the user and a regular session issued.
+// The state must be the one bound to the browser by Login, otherwise anyone could hand a callback url
+// with their own code to someone else and sign them into their account.
+func (s *SSOService) Callback(ctx context.Context, state, bound, code string, device entities.Device) (*auth.Auth, error) {
+	if !s.Enabled() {
+		return nil, ErrSSODisabled
+	}
+
+	if subtle.ConstantTimeCompare([]byte(state), []byte(bound)) != 1 {
+		return nil, ErrSSOStateUnbound
+	}
+
+	st, err := s.stateRepo.Take(ctx, state)
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			return nil, ErrSSOStateInvalid
+		}
+
+		return nil, err
+	}
+
+	token, err := s.provider.Exchange(ctx, code, st.Verifier)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := s.provider.Verify(ctx, token.IDToken, st.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.account(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.syncRoles(ctx, account, claims.Groups); err != nil {
+		return nil, err
+	}
+
+	account, err = s.accountService.FindBy(ctx, map[string]interface{}{"id": account.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(account.Roles) == 0 || len(account.Organizations) == 0 {
+		return nil, ErrSSONoRoles
+	}
+
+	if account.IsLocked() {
+		return nil, ErrAccountLocked
+	}
+
+	return s.authenticationService.Authenticate(ctx, account, device)
+}
+
+// account resolves the linked account, an unlinked identity is linked to the account with the same
+// verified email or gets a new account.
+func (s *SSOService) account(ctx context.Context, claims *oidc.Claims) (*entities.Account, error) {
+	now := time.Now()
+
+	identity, err := s.identityRepo.FindBy(ctx, map[string]interface{}{"issuer": claims.Issuer, "subject": claims.Subject})
+	if err == nil {
+		account, err := s.accountService.FindBy(ctx, map[string]interface{}{"id": identity.AccountID})
+		if err != nil {
+			return nil, err
+		}
+
+		if account.IsRoot() {
+			return nil, ErrSSORootAccount
+		}
+
+		identity.Email, identity.LastLoginAt = claims.Email, &now
+		if _, err = s.identityRepo.Save(ctx, identity); err != nil {
+			return nil, err
+		}
+
+		return account, nil
+	}
+
+	if !errors.Is(err, e.ErrEntityNotFound) {
+		return nil, err
+	}
+
+	email := strings.ToLower(strings.TrimSpace(claims.Email))
+	if email == "" || !(s.cfg.TrustEmail || (claims.EmailVerified != nil && *claims.EmailVerified)) {
+		return nil, ErrSSOEmailRequired
+	}
+
+	account, err := s.accountService.FindBy(ctx, map[string]interface{}{"auth_provider_id": email})
+	if err != nil && !errors.Is(err, e.ErrEntityNotFound) {
+		return nil, err
+	}
+
+	if account == nil {
+		if len(s.grantedRoles(claims.Groups)) == 0 {
+			return nil, ErrSSONoRoles
+		}
+
+		first, last := claims.GivenName, claims.FamilyName
+		if first == "" && last == "" {
+			first, last, _ = strings.Cut(claims.Name, " ")
+		}
+
+		account, err = s.accountService.CreateExternal(ctx, entities.AuthProviderOIDC, email,
+			truncateName(first), truncateName(last))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if account.IsRoot() {
+		return nil, ErrSSORootAccount
+	}
+
+	_, err = s.identityRepo.Create(ctx, &entities.AccountIdentity{
+		Issuer:      claims.Issuer,
+		Subject:     claims.Subject,
+		AccountID:   account.ID,
+		Email:       email,
+		LastLoginAt: &now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// syncRoles assigns mapped roles of the groups the user is in and revokes mapped roles of the groups
+// the user left, roles outside of the mapping are left untouched.
+func (s *SSOService) syncRoles(ctx context.Context, account *entities.Account, groups []string) error {
+	granted := s.grantedRoles(groups)
+
+	for _, roleID := range lo.Uniq(lo.Map(s.cfg.GroupRoles, func(item *SSOGroupRole, index int) string {
+		return item.RoleID
+	})) {
+		has := lo.ContainsBy(account.Roles, func(role *entities.Role) bool {
+			return role.ID.String() == roleID
+		})
+
+		switch want := lo.Contains(granted, roleID); {
+		case want && !has:
+			err := s.assignRole(ctx, account, roleID)
+			if errors.Is(err, ErrRoleGrantUnattended) {
+				zap.S().Warnw("identity provider group role is not granted", "account", account.AuthProviderID,
+					"role", roleID, "error", err)
+
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+		case !want && has:
+			if err := s.authorizationService.RevokeRole(ctx, account.ID.String(), roleID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// assignRole also adds the account to the organization of the role.
+func (s *SSOService) assignRole(ctx context.Context, account *entities.Account, roleID string) error {
+	id, err := uuid.Parse(roleID)
+	if err != nil {
+		return err
+	}
+
+	role, err := s.authorizationService.GetRole(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if role.Type == entities.RootRoleTypeName {
+		return ErrCanNotAssignRole
+	}
+
+	_, err = s.organizationService.Assign(ctx, account.ID, role.OrganizationID)
+	if err != nil && !errors.Is(err, e.ErrOrganizationAlreadyAssigned) {
+		return err
+	}
+
+	err = s.roleGrantService.Assign(ctx, account, role)
+	if err != nil && !errors.Is(err, e.ErrRoleAlreadyAssigned) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *SSOService) grantedRoles(groups []string) []string {
+	return lo.Uniq(lo.FilterMap(s.cfg.GroupRoles, func(item *SSOGroupRole, index int) (string, bool) {
+		return item.RoleID, lo.Contains(groups, item.Group)
+	}))
+}
+
+func truncateName(s string) string {
+	runes := []rune(s)
+	if len(runes) > accountNameLength {
+		return string(runes[:accountNameLength])
+	}
+
+	return s
+}
@@ -0,0 +1,252 @@
+package services
+
+import (
+	"backoffice/internal/entities"
+	"backoffice/internal/repositories"
+	"backoffice/internal/transport/http/requests"
+	"backoffice/pkg/totp"
+	"backoffice/pkg/webauthn"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const recoveryCodesCount = 10
+
+var (
+	ErrTwoFactorNotEnabled  = errors.New("two factor authentication is not enabled")
+	ErrCanNotResetOwnTOTP   = errors.New("use the disable endpoint to turn off your own two factor authentication")
+	ErrCanNotResetRootTOTP  = errors.New("two factor authentication of root accounts can only be reset by root")
+	ErrRecoveryCodesMissing = errors.New("enable two factor authentication to get recovery codes")
+)
+
+// TwoFactorService verifies the second factor of an account: an authenticator code, a security key
+// assertion or a recovery code. Recovery codes are shared by both methods, they are issued with the
+// first method turned on, dropped with the last one turned off and each works once.
+type TwoFactorService struct {
+	recoveryRepo    repositories.RecoveryCodeRepository
+	accountService  *AccountService
+	webAuthnService *WebAuthnService
+	mailingService  *MailingService
+}
+
+func NewTwoFactorService(recoveryRepo repositories.RecoveryCodeRepository, accountService *AccountService,
+	webAuthnService *WebAuthnService, mailingService *MailingService) *TwoFactorService {
+	return &TwoFactorService{
+		recoveryRepo:    recoveryRepo,
+		accountService:  accountService,
+		webAuthnService: webAuthnService,
+		mailingService:  mailingService,
+	}
+}
+
+// Challenge lists the second factors of the account and starts a security key assertion when it has keys.
+func (s *TwoFactorService) Challenge(ctx context.Context, account *entities.Account) (*entities.SecondFactorChallenge, error) {
+	challenge := &entities.SecondFactorChallenge{Methods: []string{}}
+
+	if account.Params != nil && account.TOTPEnabled {
+		challenge.Methods = append(challenge.Methods, entities.SecondFactorTOTP)
+	}
+
+	if account.WebAuthnEnabled && s.webAuthnService.Enabled() {
+		options, err := s.webAuthnService.BeginLogin(ctx, account)
+		if err != nil {
+			return nil, err
+		}
+
+		challenge.Methods, challenge.WebAuthn = append(challenge.Methods, entities.SecondFactorWebAuthn), options
+	}
+
+	return challenge, nil
+}
+
+// Verify checks the security key assertion or the authenticator code of the request, a code that is not
+// shaped like an authenticator code is tried as a recovery code. Rejected input is not an error.
+func (s *TwoFactorService) Verify(ctx context.Context, account *entities.Account, req *requests.SecondFactorRequest) (bool, error) {
+	if req.WebAuthn != nil {
+		if !account.WebAuthnEnabled {
+			return false, nil
+		}
+
+		err := s.webAuthnService.FinishLogin(ctx, account, req.WebAuthn)
+		if errors.Is(err, webauthn.ErrInvalidResponse) || errors.Is(err, webauthn.ErrUnsupportedKey) ||
+			errors.Is(err, ErrWebAuthnCeremonyMissing) || errors.Is(err, ErrWebAuthnUnknownCredential) {
+			return false, nil
+		}
+
+		return err == nil, err
+	}
+
+	valid, err := totp.T().Validate(req.TOTP, account.TOTPSecret)
+	if !errors.Is(err, totp.ErrValidateInputInvalidLength) {
+		// a pending secret of generate must not pass before the authenticator is enabled
+		if account.Params == nil || !account.TOTPEnabled {
+			return false, nil
+		}
+
+		return valid, err
+	}
+
+	return s.recoveryRepo.Use(ctx, account.ID, hashRecoveryCode(req.TOTP), time.Now())
+}
+
+// Enable turns on the authenticator of the account, recovery codes are returned when it is the first
+// second factor of the account.
+func (s *TwoFactorService) Enable(ctx context.Context, account *entities.Account) (*entities.RecoveryCodes, error) {
+	first := !account.HasSecondFactor()
+
+	if _, err := s.accountService.EnableTOTP(ctx, account); err != nil {
+		return nil, err
+	}
+
+	if !first {
+		return s.Remaining(ctx, account)
+	}
+
+	return s.generate(ctx, account.ID)
+}
+
+func (s *TwoFactorService) Disable(ctx context.Context, account *entities.Account) error {
+	account, err := s.accountService.DisableTOTP(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	return s.dropRecoveryCodes(ctx, account)
+}
+
+// AddWebAuthn finishes the registration of a security key, recovery codes are returned when it is the
+// first second factor of the account.
+func (s *TwoFactorService) AddWebAuthn(ctx context.Context, account *entities.Account, req *requests.RegisterWebAuthnRequest) (
+	*entities.WebAuthnRegistration, error) {
+	first := !account.HasSecondFactor()
+
+	credential, err := s.webAuthnService.FinishRegistration(ctx, account, req.Name, req.Credential)
+	if err != nil {
+		return nil, err
+	}
+
+	registration := &entities.WebAuthnRegistration{Credential: credential}
+	if first {
+		if registration.RecoveryCodes, err = s.generate(ctx, account.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return registration, nil
+}
+
+func (s *TwoFactorService) RemoveWebAuthn(ctx context.Context, account *entities.Account, id uuid.UUID) error {
+	account, err := s.webAuthnService.Remove(ctx, account, id)
+	if err != nil {
+		return err
+	}
+
+	return s.dropRecoveryCodes(ctx, account)
+}
+
+// Regenerate replaces all recovery codes of the account, the old ones stop working.
+func (s *TwoFactorService) Regenerate(ctx context.Context, account *entities.Account) (*entities.RecoveryCodes, error) {
+	if !account.HasSecondFactor() {
+		return nil, ErrRecoveryCodesMissing
+	}
+
+	return s.generate(ctx, account.ID)
+}
+
+func (s *TwoFactorService) Remaining(ctx context.Context, account *entities.Account) (*entities.RecoveryCodes, error) {
+	remaining, err := s.recoveryRepo.Remaining(ctx, account.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.RecoveryCodes{Remaining: remaining}, nil
+}
+
+// Reset turns off two factor authentication of another account on behalf of an administrator, the owner
+// is notified by email.
+func (s *TwoFactorService) Reset(ctx context.Context, actor *entities.Session, account *entities.Account) (*entities.Account, error) {
+	if account.ID == actor.Account.ID {
+		return nil, ErrCanNotResetOwnTOTP
+	}
+
+	if account.IsRoot() && !actor.Account.IsRoot() {
+		return nil, ErrCanNotResetRootTOTP
+	}
+
+	if !account.HasSecondFactor() {
+		return nil, ErrTwoFactorNotEnabled
+	}
+
+	account, err := s.accountService.DisableTOTP(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	if account, err = s.webAuthnService.RemoveAll(ctx, account); err != nil {
+		return nil, err
+	}
+
+	if err = s.recoveryRepo.DeleteAll(ctx, account.ID); err != nil {
+		return nil, err
+	}
+
+	email := account.Email
+	if email == "" {
+		email = account.AuthProviderID
+	}
+
+	if err = s.mailingService.NotifyTwoFactorReset(email, account.AuthProviderID); err != nil {
+		zap.S().Error(err)
+	}
+
+	return account, nil
+}
+
+// dropRecoveryCodes deletes the recovery codes once the account has no second factor left.
+func (s *TwoFactorService) dropRecoveryCodes(ctx context.Context, account *entities.Account) error {
+	if account.HasSecondFactor() {
+		return nil
+	}
+
+	return s.recoveryRepo.DeleteAll(ctx, account.ID)
+}
+
+func (s *TwoFactorService) generate(ctx context.Context, accountID uuid.UUID) (*entities.RecoveryCodes, error) {
+	now := time.Now()
+	plain := make([]string, 0, recoveryCodesCount)
+	codes := make([]*entities.RecoveryCode, 0, recoveryCodesCount)
+
+	for i := 0; i < recoveryCodesCount; i++ {
+		code, err := totp.RecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+
+		plain = append(plain, code)
+		codes = append(codes, &entities.RecoveryCode{
+			CreatedAt: now,
+			ID:        uuid.New(),
+			AccountID: accountID,
+			Hash:      hashRecoveryCode(code),
+		})
+	}
+
+	if err := s.recoveryRepo.Replace(ctx, accountID, codes); err != nil {
+		return nil, err
+	}
+
+	return &entities.RecoveryCodes{Codes: plain, Remaining: int64(len(plain))}, nil
+}
+
+// hashRecoveryCode may be a plain digest, the codes are random and long enough not to need a slow hash.
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(totp.NormalizeRecoveryCode(code)))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,285 @@
+package services
+
+import (
+	"backoffice/internal/entities"
+	"backoffice/internal/repositories"
+	"backoffice/internal/transport/http/requests"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+var (
+	ErrWagerConstraintCurrency = errors.New("wager constraints repeat a currency")
+	ErrWagerConstraintBets     = errors.New("min bet of wager constraint is more than its max bet")
+)
+
+// WagerConstraintError lists every limit the wager sets break, each one is reported as a validation error.
+type WagerConstraintError struct {
+	Violations []error
+}
+
+func (err *WagerConstraintError) Error() string {
+	return errors.Join(err.Violations...).Error()
+}
+
+func (err *WagerConstraintError) Unwrap() []error {
+	return err.Violations
+}
+
+// WagerConstraintService keeps the wager constraints of games and checks wager sets against them wherever
+// a game gets a wager set: on the game, on the games of an integrator and per currency.
+type WagerConstraintService struct {
+	repo      repositories.GameWagerConstraintRepository
+	wagerRepo repositories.BaseRepository[entities.WagerSet]
+	gameRepo  repositories.GameRepository
+}
+
+func NewWagerConstraintService(repo repositories.GameWagerConstraintRepository, wagerRepo repositories.BaseRepository[entities.WagerSet],
+	gameRepo repositories.GameRepository) *WagerConstraintService {
+	return &WagerConstraintService{
+		repo:      repo,
+		wagerRepo: wagerRepo,
+		gameRepo:  gameRepo,
+	}
+}
+
+func (s *WagerConstraintService) Get(ctx context.Context, gameID uuid.UUID) ([]*entities.GameWagerConstraint, error) {
+	return s.repo.Find(ctx, map[string]interface{}{"game_id": gameID})
+}
+
+// Set replaces the constraints of the game, the wager sets the game uses already have to keep to them.
+func (s *WagerConstraintService) Set(ctx context.Context, gameID uuid.UUID, req []*requests.GameWagerConstraintRequest) (
+	[]*entities.GameWagerConstraint, error) {
+	constraints, err := newWagerConstraints(gameID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	usages, err := s.repo.Usages(ctx, &gameID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.check(ctx, map[uuid.UUID][]*entities.GameWagerConstraint{gameID: constraints}, usages, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.Replace(ctx, gameID, constraints); err != nil {
+		return nil, err
+	}
+
+	return constraints, nil
+}
+
+// ValidateGame checks the wager sets of the game against its constraints, against req when it is not nil.
+// The wager sets integrators use for the game are checked as well when the constraints change.
+func (s *WagerConstraintService) ValidateGame(ctx context.Context, gameID uuid.UUID, name string, wagerSetIDs []uuid.UUID,
+	req []*requests.GameWagerConstraintRequest) error {
+	usages := lo.Map(wagerSetIDs, func(item uuid.UUID, _ int) *entities.WagerSetUsage {
+		return &entities.WagerSetUsage{GameID: gameID, WagerSetID: item}
+	})
+
+	var constraints []*entities.GameWagerConstraint
+
+	if req == nil {
+		stored, err := s.Get(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		constraints = stored
+	} else {
+		changed, err := newWagerConstraints(gameID, req)
+		if err != nil {
+			return err
+		}
+
+		stored, err := s.repo.Usages(ctx, &gameID, nil)
+		if err != nil {
+			return err
+		}
+
+		constraints = changed
+		usages = append(usages, lo.Filter(stored, func(item *entities.WagerSetUsage, _ int) bool { return item.OrganizationID != nil })...)
+	}
+
+	return s.check(ctx, map[uuid.UUID][]*entities.GameWagerConstraint{gameID: constraints}, usages,
+		map[uuid.UUID]string{gameID: name}, nil)
+}
+
+// ValidateWagerSet checks the wager set, before it is saved, against the constraints of the games using it
+// and of gameIDs.
+func (s *WagerConstraintService) ValidateWagerSet(ctx context.Context, ws *entities.WagerSet, gameIDs []uuid.UUID) error {
+	usages, err := s.repo.Usages(ctx, nil, &ws.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, gameID := range gameIDs {
+		usages = append(usages, &entities.WagerSetUsage{GameID: gameID, WagerSetID: ws.ID})
+	}
+
+	constraints, err := s.constraints(ctx, usages)
+	if err != nil {
+		return err
+	}
+
+	return s.check(ctx, constraints, usages, nil, map[uuid.UUID]*entities.WagerSet{ws.ID: ws})
+}
+
+// ValidateAssignment checks a wager set an integrator gives a game, in a currency when it is not empty.
+func (s *WagerConstraintService) ValidateAssignment(ctx context.Context, integratorID, gameID, wagerSetID uuid.UUID, currency string) error {
+	usages := []*entities.WagerSetUsage{{GameID: gameID, WagerSetID: wagerSetID, OrganizationID: &integratorID, Currency: currency}}
+
+	constraints, err := s.constraints(ctx, usages)
+	if err != nil {
+		return err
+	}
+
+	return s.check(ctx, constraints, usages, nil, nil)
+}
+
+func (s *WagerConstraintService) constraints(ctx context.Context, usages []*entities.WagerSetUsage) (
+	map[uuid.UUID][]*entities.GameWagerConstraint, error) {
+	gameIDs := lo.Uniq(lo.Map(usages, func(item *entities.WagerSetUsage, _ int) uuid.UUID { return item.GameID }))
+	if len(gameIDs) == 0 {
+		return nil, nil
+	}
+
+	constraints, err := s.repo.Find(ctx, map[string]interface{}{"game_id": gameIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.GroupBy(constraints, func(item *entities.GameWagerConstraint) uuid.UUID { return item.GameID }), nil
+}
+
+// check collects what every usage breaks, names and sets are the game names and wager sets known already.
+func (s *WagerConstraintService) check(ctx context.Context, constraints map[uuid.UUID][]*entities.GameWagerConstraint,
+	usages []*entities.WagerSetUsage, names map[uuid.UUID]string, sets map[uuid.UUID]*entities.WagerSet) error {
+	if names == nil {
+		names = map[uuid.UUID]string{}
+	}
+
+	if sets == nil {
+		sets = map[uuid.UUID]*entities.WagerSet{}
+	}
+
+	var violations []error
+
+	for _, usage := range lo.UniqBy(usages, func(item *entities.WagerSetUsage) string {
+		return fmt.Sprintf("%s:%s:%s:%s", item.GameID, item.WagerSetID, lo.FromPtr(item.OrganizationID), item.Currency)
+	}) {
+		constraint := wagerConstraint(constraints[usage.GameID], usage.Currency)
+		if constraint == nil {
+			continue
+		}
+
+		ws, ok := sets[usage.WagerSetID]
+		if !ok {
+			var err error
+			if ws, err = s.wagerRepo.FindBy(ctx, map[string]interface{}{"id": usage.WagerSetID}); err != nil {
+				return fmt.Errorf("wager set %s: %w", usage.WagerSetID, err)
+			}
+
+			sets[usage.WagerSetID] = ws
+		}
+
+		broken := constraint.Check(ws)
+		if len(broken) == 0 {
+			continue
+		}
+
+		name, err := s.gameName(ctx, names, usage.GameID)
+		if err != nil {
+			return err
+		}
+
+		where := "game " + name
+		if usage.Currency != "" {
+			where += " in " + usage.Currency
+		}
+
+		if usage.OrganizationID != nil {
+			where += " for integrator " + usage.OrganizationID.String()
+		}
+
+		for _, violation := range broken {
+			violations = append(violations, fmt.Errorf("wager set %s of %s: %w", ws.Name, where, violation))
+		}
+	}
+
+	if len(violations) > 0 {
+		return &WagerConstraintError{Violations: violations}
+	}
+
+	return nil
+}
+
+func (s *WagerConstraintService) gameName(ctx context.Context, names map[uuid.UUID]string, gameID uuid.UUID) (string, error) {
+	if name, ok := names[gameID]; ok {
+		return name, nil
+	}
+
+	game, err := s.gameRepo.GetBy(ctx, map[string]interface{}{"id": gameID})
+	if err != nil {
+		return "", err
+	}
+
+	names[gameID] = game.Name
+
+	return game.Name, nil
+}
+
+// wagerConstraint picks the constraint of the currency, the one without a currency otherwise.
+func wagerConstraint(constraints []*entities.GameWagerConstraint, currency string) *entities.GameWagerConstraint {
+	if constraint, ok := lo.Find(constraints, func(item *entities.GameWagerConstraint) bool {
+		return currency != "" && strings.EqualFold(item.Currency, currency)
+	}); ok {
+		return constraint
+	}
+
+	constraint, _ := lo.Find(constraints, func(item *entities.GameWagerConstraint) bool { return item.Currency == "" })
+
+	return constraint
+}
+
+func newWagerConstraints(gameID uuid.UUID, req []*requests.GameWagerConstraintRequest) ([]*entities.GameWagerConstraint, error) {
+	now := time.Now()
+	constraints := make([]*entities.GameWagerConstraint, 0, len(req))
+
+	for _, item := range req {
+		currency := strings.ToUpper(strings.TrimSpace(item.Currency))
+
+		if lo.ContainsBy(constraints, func(c *entities.GameWagerConstraint) bool { return c.Currency == currency }) {
+			return nil, fmt.Errorf("%w: %q", ErrWagerConstraintCurrency, currency)
+		}
+
+		if item.MinBet != nil && item.MaxBet != nil && *item.MinBet > *item.MaxBet {
+			return nil, fmt.Errorf("%w: %d > %d", ErrWagerConstraintBets, *item.MinBet, *item.MaxBet)
+		}
+
+		constraints = append(constraints, &entities.GameWagerConstraint{
+			CreatedAt:        now,
+			UpdatedAt:        now,
+			ID:               uuid.New(),
+			GameID:           gameID,
+			Currency:         currency,
+			Divisor:          item.Divisor,
+			MinBet:           item.MinBet,
+			MaxBet:           item.MaxBet,
+			MaxLevels:        item.MaxLevels,
+			MaxWinMultiplier: item.MaxWinMultiplier,
+			MaxExposure:      item.MaxExposure,
+		})
+	}
+
+	return constraints, nil
+}
@@ -9,11 +9,12 @@ import (
 )
 
 type WagerSetService struct {
-	wagerRepo repositories.BaseRepository[entities.WagerSet]
+	wagerRepo              repositories.BaseRepository[entities.WagerSet]
+	wagerConstraintService *WagerConstraintService
 }
 
-func NewWagerSetService(wagerRepo repositories.BaseRepository[entities.WagerSet]) *WagerSetService {
-	return &WagerSetService{wagerRepo: wagerRepo}
+func NewWagerSetService(wagerRepo repositories.BaseRepository[entities.WagerSet], wagerConstraintService *WagerConstraintService) *WagerSetService {
+	return &WagerSetService{wagerRepo: wagerRepo, wagerConstraintService: wagerConstraintService}
 }
 
 func (s *WagerSetService) Paginate(ctx context.Context, organizationID uuid.UUID, filters map[string]interface{}, limit int, page int) (
@@ -26,7 +27,9 @@ func (s *WagerSetService) Get(ctx context.Context, id uuid.UUID) (*entities.Wage
 	return s.wagerRepo.FindBy(ctx, map[string]interface{}{"id": id})
 }
 
-func (s *WagerSetService) Create(ctx context.Context, organizationID uuid.UUID, name string, wagerLevels []int64, defaultWager int64) (*entities.WagerSet, error) {
+// Create makes a wager set that keeps to the wager constraints of gameIDs.
+func (s *WagerSetService) Create(ctx context.Context, organizationID uuid.UUID, name string, wagerLevels []int64, defaultWager int64,
+	gameIDs []uuid.UUID) (*entities.WagerSet, error) {
 	ws := &entities.WagerSet{
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
@@ -42,10 +45,16 @@ func (s *WagerSetService) Create(ctx context.Context, organizationID uuid.UUID,
 		return nil, err
 	}
 
+	if err := s.wagerConstraintService.ValidateWagerSet(ctx, ws, gameIDs); err != nil {
+		return nil, err
+	}
+
 	return s.wagerRepo.Save(ctx, ws)
 }
 
-func (s *WagerSetService) Update(ctx context.Context, id uuid.UUID, name string, wagerLevels []int64, defaultWager int64, isActive bool) (*entities.WagerSet, error) {
+// Update changes the wager set, it keeps to the wager constraints of the games using it and of gameIDs.
+func (s *WagerSetService) Update(ctx context.Context, id uuid.UUID, name string, wagerLevels []int64, defaultWager int64, isActive bool,
+	gameIDs []uuid.UUID) (*entities.WagerSet, error) {
 	ws, err := s.Get(ctx, id)
 	if err != nil {
 		return nil, err
@@ -58,6 +67,10 @@ func (s *WagerSetService) Update(ctx context.Context, id uuid.UUID, name string,
 		return nil, err
 	}
 
+	if err := s.wagerConstraintService.ValidateWagerSet(ctx, ws, gameIDs); err != nil {
+		return nil, err
+	}
+
 	return s.wagerRepo.Save(ctx, ws)
 }
 
@@ -0,0 +1,323 @@
+package services
+
+import (
+	"backoffice/internal/entities"
+	"backoffice/internal/repositories"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+var (
+	ErrWagerSetGenerationMultipliers = errors.New("organization pair has no currency multipliers")
+	ErrWagerSetGenerationOutdated    = errors.New("generated wager sets changed since the preview, preview them again")
+	ErrWagerSetGenerationInvalid     = errors.New("wager sets can not be generated for every currency")
+)
+
+// niceWagerSteps are the wagers of every power of ten scaled wagers are rounded to.
+var niceWagerSteps = []int64{1, 2, 5, 10}
+
+// WagerSetGeneratorService scales a base wager set to the currencies of a provider and integrator pair by
+// their currency multipliers. Scaled wagers are rounded to 1, 2 and 5 steps and fitted to the wager
+// constraints of the games, the wager sets are saved for the games of the integrator once the preview is approved.
+type WagerSetGeneratorService struct {
+	wagerRepo              repositories.BaseRepository[entities.WagerSet]
+	currencyRepo           repositories.CurrencyRepository
+	organizationRepo       repositories.OrganizationRepository
+	wagerConstraintService *WagerConstraintService
+}
+
+func NewWagerSetGeneratorService(wagerRepo repositories.BaseRepository[entities.WagerSet], currencyRepo repositories.CurrencyRepository,
+	organizationRepo repositories.OrganizationRepository, wagerConstraintService *WagerConstraintService) *WagerSetGeneratorService {
+	return &WagerSetGeneratorService{
+		wagerRepo:              wagerRepo,
+		currencyRepo:           currencyRepo,
+		organizationRepo:       organizationRepo,
+		wagerConstraintService: wagerConstraintService,
+	}
+}
+
+// Preview generates the wager sets of the currencies of the pair, of all of them when currencies is empty.
+func (s *WagerSetGeneratorService) Preview(ctx context.Context, baseID, pairID uuid.UUID, gameIDs []uuid.UUID, currencies []string) (
+	*entities.WagerSetGeneration, error) {
+	base, err := s.wagerRepo.FindBy(ctx, map[string]interface{}{"id": baseID})
+	if err != nil {
+		return nil, err
+	}
+
+	multipliers, err := s.currencyRepo.Search(ctx, map[string]interface{}{"organization_pair_id": pairID})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(multipliers) == 0 {
+		return nil, ErrWagerSetGenerationMultipliers
+	}
+
+	gameIDs = lo.Uniq(gameIDs)
+	constraints := map[uuid.UUID][]*entities.GameWagerConstraint{}
+
+	for _, gameID := range gameIDs {
+		if constraints[gameID], err = s.wagerConstraintService.Get(ctx, gameID); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(multipliers, func(i, j int) bool { return multipliers[i].Title < multipliers[j].Title })
+
+	generation := &entities.WagerSetGeneration{
+		BaseWagerSetID:     base.ID,
+		OrganizationPairID: pairID,
+		IntegratorID:       multipliers[0].ProviderIntegratorPair.IntegratorID,
+		GameIDs:            gameIDs,
+		WagerSets:          []*entities.GeneratedWagerSet{},
+	}
+
+	for _, currency := range currencies {
+		if !lo.ContainsBy(multipliers, func(item *entities.CurrencyMultiplier) bool { return strings.EqualFold(item.Title, currency) }) {
+			generation.WagerSets = append(generation.WagerSets, &entities.GeneratedWagerSet{
+				Currency: currency,
+				Errors:   []string{"the organization pair has no multiplier for the currency"},
+			})
+		}
+	}
+
+	for _, multiplier := range multipliers {
+		if len(currencies) > 0 && !lo.ContainsBy(currencies, func(item string) bool { return strings.EqualFold(item, multiplier.Title) }) {
+			continue
+		}
+
+		limits := newWagerLimits(lo.MapToSlice(constraints, func(_ uuid.UUID, items []*entities.GameWagerConstraint) *entities.GameWagerConstraint {
+			return wagerConstraint(items, multiplier.Title)
+		}))
+
+		generation.WagerSets = append(generation.WagerSets, limits.generate(base, multiplier.Title, multiplier.Multiplier))
+	}
+
+	if generation.Checksum, err = wagerSetGenerationChecksum(generation); err != nil {
+		return nil, err
+	}
+
+	return generation, nil
+}
+
+// Save generates the wager sets again and, when they are still the approved preview of checksum, saves them
+// as the only wager sets of the games of the integrator in their currencies.
+func (s *WagerSetGeneratorService) Save(ctx context.Context, baseID, pairID uuid.UUID, gameIDs []uuid.UUID, currencies []string,
+	checksum string) (*entities.WagerSetGeneration, error) {
+	generation, err := s.Preview(ctx, baseID, pairID, gameIDs, currencies)
+	if err != nil {
+		return nil, err
+	}
+
+	if generation.Checksum != checksum {
+		return nil, ErrWagerSetGenerationOutdated
+	}
+
+	invalid := lo.Filter(generation.WagerSets, func(item *entities.GeneratedWagerSet, _ int) bool { return len(item.Errors) > 0 })
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrWagerSetGenerationInvalid,
+			strings.Join(lo.Map(invalid, func(item *entities.GeneratedWagerSet, _ int) string { return item.Currency }), ", "))
+	}
+
+	base, err := s.wagerRepo.FindBy(ctx, map[string]interface{}{"id": baseID})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	wagerSets := make([]*entities.WagerSet, 0, len(generation.WagerSets))
+	igws := make([]*entities.IntegratorGameWagerSet, 0, len(generation.WagerSets)*len(generation.GameIDs))
+
+	for _, generated := range generation.WagerSets {
+		ws := &entities.WagerSet{
+			CreatedAt:      now,
+			UpdatedAt:      now,
+			ID:             uuid.New(),
+			OrganizationID: base.OrganizationID,
+			Name:           fmt.Sprintf("%s %s", base.Name, generated.Currency),
+			IsActive:       true,
+		}
+
+		if err = ws.SetNewWagerParams(generated.WagerLevels, generated.DefaultWager); err != nil {
+			return nil, fmt.Errorf("%s: %w", generated.Currency, err)
+		}
+
+		for _, gameID := range generation.GameIDs {
+			igws = append(igws, &entities.IntegratorGameWagerSet{
+				OrganizationID: generation.IntegratorID,
+				GameID:         gameID,
+				Currency:       generated.Currency,
+				WagerSetID:     ws.ID,
+			})
+		}
+
+		generated.WagerSet = ws
+		wagerSets = append(wagerSets, ws)
+	}
+
+	if err = s.organizationRepo.ReplaceIntegratorGameWagerSets(ctx, wagerSets, igws); err != nil {
+		return nil, err
+	}
+
+	generation.Saved = true
+
+	return generation, nil
+}
+
+// wagerLimits are the wager constraints of several games in a currency combined, zero limits are not set.
+type wagerLimits struct {
+	divisor   int64
+	minBet    int64
+	maxBet    int64
+	maxLevels int
+}
+
+func newWagerLimits(constraints []*entities.GameWagerConstraint) *wagerLimits {
+	limits := &wagerLimits{divisor: 1}
+
+	for _, c := range constraints {
+		if c == nil {
+			continue
+		}
+
+		if c.Divisor != nil {
+			limits.divisor = lcm(limits.divisor, *c.Divisor)
+		}
+
+		if c.MinBet != nil && *c.MinBet > limits.minBet {
+			limits.minBet = *c.MinBet
+		}
+
+		if c.MaxBet != nil {
+			limits.lowerMaxBet(*c.MaxBet)
+		}
+
+		if c.MaxWinMultiplier != nil && c.MaxExposure != nil {
+			limits.lowerMaxBet(*c.MaxExposure / *c.MaxWinMultiplier)
+		}
+
+		if c.MaxLevels != nil && (limits.maxLevels == 0 || *c.MaxLevels < limits.maxLevels) {
+			limits.maxLevels = *c.MaxLevels
+		}
+	}
+
+	return limits
+}
+
+func (l *wagerLimits) lowerMaxBet(maxBet int64) {
+	if l.maxBet == 0 || maxBet < l.maxBet {
+		l.maxBet = maxBet
+	}
+}
+
+// generate scales the wagers of the base wager set, wagers the limits do not allow are dropped.
+func (l *wagerLimits) generate(base *entities.WagerSet, currency string, multiplier int64) *entities.GeneratedWagerSet {
+	generated := &entities.GeneratedWagerSet{Currency: currency, Multiplier: multiplier, WagerLevels: []int64{}}
+
+	for _, wager := range base.WagerLevels {
+		scaled := l.fit(niceWager(wager * multiplier))
+
+		if !l.allows(scaled) {
+			generated.Dropped = append(generated.Dropped, scaled)
+
+			continue
+		}
+
+		generated.WagerLevels = append(generated.WagerLevels, scaled)
+	}
+
+	generated.WagerLevels = lo.Uniq(generated.WagerLevels)
+	sort.Slice(generated.WagerLevels, func(i, j int) bool { return generated.WagerLevels[i] < generated.WagerLevels[j] })
+
+	if l.maxLevels > 0 && len(generated.WagerLevels) > l.maxLevels {
+		generated.Dropped = append(generated.Dropped, generated.WagerLevels[l.maxLevels:]...)
+		generated.WagerLevels = generated.WagerLevels[:l.maxLevels]
+	}
+
+	if len(generated.WagerLevels) == 0 {
+		generated.Errors = append(generated.Errors, "no wager of the base wager set fits the wager constraints of the games")
+
+		return generated
+	}
+
+	// the default wager is the scaled default, or the wager closest to it when the limits drop it
+	scaled := l.fit(niceWager(base.DefaultWager * multiplier))
+	generated.DefaultWager = lo.MinBy(generated.WagerLevels, func(a, b int64) bool {
+		return math.Abs(float64(a-scaled)) < math.Abs(float64(b-scaled))
+	})
+
+	return generated
+}
+
+// fit rounds the wager to the closest multiple of the divisor.
+func (l *wagerLimits) fit(wager int64) int64 {
+	if l.divisor <= 1 || wager <= 0 {
+		return wager
+	}
+
+	return lo.Max([]int64{1, (wager + l.divisor/2) / l.divisor}) * l.divisor
+}
+
+func (l *wagerLimits) allows(wager int64) bool {
+	return wager > 0 && wager >= l.minBet && (l.maxBet == 0 || wager <= l.maxBet)
+}
+
+// niceWager rounds the wager to the closest step of its power of ten.
+func niceWager(wager int64) int64 {
+	if wager <= 0 {
+		return 0
+	}
+
+	power := int64(1)
+	for power*10 <= wager {
+		power *= 10
+	}
+
+	nice := power
+	for _, step := range niceWagerSteps {
+		if candidate := step * power; abs64(candidate-wager) < abs64(nice-wager) {
+			nice = candidate
+		}
+	}
+
+	return nice
+}
+
+func wagerSetGenerationChecksum(generation *entities.WagerSetGeneration) (string, error) {
+	data, err := json.Marshal(generation)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func lcm(a, b int64) int64 {
+	x, y := a, b
+	for y != 0 {
+		x, y = y, x%y
+	}
+
+	return a / x * b
+}
+
+func abs64(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
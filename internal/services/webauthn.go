@@ -0,0 +1,252 @@
+package services
+
+import (
+	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
+	"backoffice/internal/repositories"
+	"backoffice/pkg/webauthn"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	webAuthnRegisterKey = "register:"
+	webAuthnLoginKey    = "login:"
+	webAuthnNameLength  = 64
+	webAuthnDefaultName = "Security key"
+)
+
+var (
+	ErrWebAuthnDisabled          = errors.New("security keys are not configured")
+	ErrWebAuthnCeremonyMissing   = errors.New("security key challenge is missing or expired, start again")
+	ErrWebAuthnNoCredentials     = errors.New("account has no security keys")
+	ErrWebAuthnUnknownCredential = errors.New("security key is not registered to the account")
+	ErrWebAuthnAlreadyRegistered = errors.New("security key is already registered")
+)
+
+// WebAuthnService registers security keys and passkeys of accounts and runs their ceremonies, the
+// challenge of a ceremony is kept per account and ceremony kind until the browser answers.
+type WebAuthnService struct {
+	webAuthn       *webauthn.WebAuthn
+	sessionRepo    repositories.WebAuthnSessionRepository
+	credentialRepo repositories.WebAuthnCredentialRepository
+	accountService *AccountService
+}
+
+func NewWebAuthnService(webAuthn *webauthn.WebAuthn, sessionRepo repositories.WebAuthnSessionRepository,
+	credentialRepo repositories.WebAuthnCredentialRepository, accountService *AccountService) *WebAuthnService {
+	return &WebAuthnService{
+		webAuthn:       webAuthn,
+		sessionRepo:    sessionRepo,
+		credentialRepo: credentialRepo,
+		accountService: accountService,
+	}
+}
+
+func (s *WebAuthnService) Enabled() bool {
+	return s.webAuthn != nil
+}
+
+func (s *WebAuthnService) BeginRegistration(ctx context.Context, account *entities.Account) (*webauthn.CreationOptions, error) {
+	if !s.Enabled() {
+		return nil, ErrWebAuthnDisabled
+	}
+
+	credentials, err := s.Credentials(ctx, account.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	user := webauthn.User{
+		ID:          account.ID[:],
+		Name:        account.AuthProviderID,
+		DisplayName: strings.TrimSpace(account.FirstName + " " + account.LastName),
+	}
+
+	options, session, err := s.webAuthn.BeginRegistration(user, descriptors(credentials))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.sessionRepo.Create(ctx, webAuthnRegisterKey+account.ID.String(), session); err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
+// FinishRegistration stores the security key and turns on the webauthn second factor of the account.
+func (s *WebAuthnService) FinishRegistration(ctx context.Context, account *entities.Account, name string,
+	resp *webauthn.AttestationResponse) (*entities.WebAuthnCredential, error) {
+	if !s.Enabled() {
+		return nil, ErrWebAuthnDisabled
+	}
+
+	session, err := s.take(ctx, webAuthnRegisterKey+account.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := s.webAuthn.FinishRegistration(session, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.credentialRepo.FindBy(ctx, map[string]interface{}{"credential_id": credential.ID})
+	if err == nil {
+		return nil, ErrWebAuthnAlreadyRegistered
+	}
+
+	if !errors.Is(err, e.ErrEntityNotFound) {
+		return nil, err
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = webAuthnDefaultName
+	}
+
+	if runes := []rune(name); len(runes) > webAuthnNameLength {
+		name = string(runes[:webAuthnNameLength])
+	}
+
+	created, err := s.credentialRepo.Create(ctx, &entities.WebAuthnCredential{
+		CreatedAt:    time.Now(),
+		ID:           uuid.New(),
+		AccountID:    account.ID,
+		Name:         name,
+		CredentialID: credential.ID,
+		PublicKey:    credential.PublicKey,
+		SignCount:    int64(credential.SignCount),
+		AAGUID:       credential.AAGUID,
+		Transports:   credential.Transports,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !account.WebAuthnEnabled {
+		if _, err = s.accountService.SetWebAuthn(ctx, account, true); err != nil {
+			return nil, err
+		}
+	}
+
+	return created, nil
+}
+
+func (s *WebAuthnService) Credentials(ctx context.Context, accountID uuid.UUID) ([]*entities.WebAuthnCredential, error) {
+	return s.credentialRepo.Find(ctx, map[string]interface{}{"account_id": accountID})
+}
+
+// Remove deletes the security key, the webauthn second factor is turned off with the last key.
+func (s *WebAuthnService) Remove(ctx context.Context, account *entities.Account, id uuid.UUID) (*entities.Account, error) {
+	credential, err := s.credentialRepo.FindBy(ctx, map[string]interface{}{"id": id, "account_id": account.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.credentialRepo.Delete(ctx, credential); err != nil {
+		return nil, err
+	}
+
+	credentials, err := s.Credentials(ctx, account.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(credentials) > 0 {
+		return account, nil
+	}
+
+	return s.accountService.SetWebAuthn(ctx, account, false)
+}
+
+func (s *WebAuthnService) RemoveAll(ctx context.Context, account *entities.Account) (*entities.Account, error) {
+	if err := s.credentialRepo.Delete(ctx, &entities.WebAuthnCredential{}, "account_id = ?", account.ID); err != nil {
+		return nil, err
+	}
+
+	return s.accountService.SetWebAuthn(ctx, account, false)
+}
+
+// BeginLogin returns the options for an assertion with any security key of the account.
+func (s *WebAuthnService) BeginLogin(ctx context.Context, account *entities.Account) (*webauthn.RequestOptions, error) {
+	if !s.Enabled() {
+		return nil, ErrWebAuthnDisabled
+	}
+
+	credentials, err := s.Credentials(ctx, account.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(credentials) == 0 {
+		return nil, ErrWebAuthnNoCredentials
+	}
+
+	options, session, err := s.webAuthn.BeginLogin(descriptors(credentials))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.sessionRepo.Create(ctx, webAuthnLoginKey+account.ID.String(), session); err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
+// FinishLogin verifies the assertion and advances the signature counter of the security key.
+func (s *WebAuthnService) FinishLogin(ctx context.Context, account *entities.Account, resp *webauthn.AssertionResponse) error {
+	if !s.Enabled() {
+		return ErrWebAuthnDisabled
+	}
+
+	session, err := s.take(ctx, webAuthnLoginKey+account.ID.String())
+	if err != nil {
+		return err
+	}
+
+	credential, err := s.credentialRepo.FindBy(ctx, map[string]interface{}{
+		"account_id":    account.ID,
+		"credential_id": []byte(resp.RawID),
+	})
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			return ErrWebAuthnUnknownCredential
+		}
+
+		return err
+	}
+
+	signCount, err := s.webAuthn.FinishLogin(session, credential.Credential(), resp)
+	if err != nil {
+		return err
+	}
+
+	return s.credentialRepo.Touch(ctx, credential.ID, int64(signCount), time.Now())
+}
+
+func (s *WebAuthnService) take(ctx context.Context, key string) (*webauthn.SessionData, error) {
+	session, err := s.sessionRepo.Take(ctx, key)
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			return nil, ErrWebAuthnCeremonyMissing
+		}
+
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func descriptors(credentials []*entities.WebAuthnCredential) []webauthn.Descriptor {
+	return lo.Map(credentials, func(item *entities.WebAuthnCredential, index int) webauthn.Descriptor {
+		return item.Descriptor()
+	})
+}
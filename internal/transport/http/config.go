@@ -8,4 +8,6 @@ type Config struct {
 	Port         int
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
+	// TrustedProxies may set the client ip with X-Forwarded-For, the peer address is used when empty.
+	TrustedProxies []string
 }
@@ -4,6 +4,7 @@ import (
 	"backoffice/internal/entities"
 	e "backoffice/internal/errors"
 	"backoffice/internal/services"
+	"backoffice/internal/transport/http/middlewares"
 	"backoffice/internal/transport/http/requests"
 	"backoffice/internal/transport/http/response"
 	"errors"
@@ -12,16 +13,29 @@ import (
 )
 
 type accountHandler struct {
-	accountService       *services.AccountService
-	authorizationService *services.AuthorizationService
-	organizationService  *services.OrganizationService
+	accountService        *services.AccountService
+	authorizationService  *services.AuthorizationService
+	organizationService   *services.OrganizationService
+	authenticationService *services.AuthenticationService
+	lockoutService        *services.LockoutService
+	twoFactorService      *services.TwoFactorService
+	impersonationService  *services.ImpersonationService
+	roleGrantService      *services.RoleGrantService
 }
 
-func NewAccountHandler(accountService *services.AccountService, authorizationService *services.AuthorizationService, organizationService *services.OrganizationService) *accountHandler {
+func NewAccountHandler(accountService *services.AccountService, authorizationService *services.AuthorizationService,
+	organizationService *services.OrganizationService, authenticationService *services.AuthenticationService,
+	lockoutService *services.LockoutService, twoFactorService *services.TwoFactorService,
+	impersonationService *services.ImpersonationService, roleGrantService *services.RoleGrantService) *accountHandler {
 	return &accountHandler{
-		accountService:       accountService,
-		authorizationService: authorizationService,
-		organizationService:  organizationService,
+		accountService:        accountService,
+		authorizationService:  authorizationService,
+		organizationService:   organizationService,
+		authenticationService: authenticationService,
+		lockoutService:        lockoutService,
+		twoFactorService:      twoFactorService,
+		impersonationService:  impersonationService,
+		roleGrantService:      roleGrantService,
 	}
 }
 
@@ -37,6 +51,7 @@ func (h *accountHandler) Register(route *gin.RouterGroup) {
 			account.DELETE("", h.delete)
 			account.POST("roles", h.assignRole)
 			account.DELETE("roles", h.revokeRole)
+			account.GET("role_grants", h.roleGrants)
 			account.POST("change_password", h.changePassword)
 			//account.POST("permissions", h.assignPermissions)
 			//account.DELETE("permissions", h.revokePermissions)
@@ -44,6 +59,12 @@ func (h *accountHandler) Register(route *gin.RouterGroup) {
 			account.DELETE("organizations", h.revokeOrganization)
 			account.POST("operators", h.assignOperator)
 			account.DELETE("operators", h.revokeOperator)
+			account.GET("sessions", h.sessions)
+			account.DELETE("sessions", h.revokeSessions)
+			account.DELETE("sessions/:session_id", h.revokeSession)
+			account.POST("unlock", h.unlock)
+			account.POST("totp/reset", h.resetTOTP)
+			account.POST("impersonate", h.impersonate)
 		}
 	}
 }
@@ -85,7 +106,7 @@ func (h *accountHandler) all(ctx *gin.Context) {
 // @Summary Create new account.
 // @Tags accounts
 // @Consume application/json
-// @Description Create account.
+// @Description Create account, its role needs an approval of another administrator where configured.
 // @Accept json
 // @Produce json
 // @Security X-Authenticate
@@ -109,13 +130,39 @@ func (h *accountHandler) create(ctx *gin.Context) {
 		return
 	}
 
-	account, err := h.accountService.Create(ctx, req.ID, req.Token, req.FirstName, req.LastName)
+	roleID, err := uuid.Parse(req.RoleID)
+	if err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	role, err := h.authorizationService.GetRole(ctx, roleID)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
 
 		return
 	}
 
+	if err = h.roleGrantService.Check(session, role, req.RoleExpiresAt); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	account, err := h.accountService.Create(ctx, session.OrganizationID, req.ID, req.Token, req.FirstName, req.LastName)
+	if err != nil {
+		if passwordPolicyViolated(err) {
+			response.ValidationFailed(ctx, err)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
 	account, err = h.organizationService.Assign(ctx, account.ID, session.OrganizationID)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
@@ -123,7 +170,8 @@ func (h *accountHandler) create(ctx *gin.Context) {
 		return
 	}
 
-	err = h.authorizationService.AssignRole(ctx, account.ID.String(), req.RoleID)
+	// roles that need an approval are pending until another administrator approves them
+	_, err = h.roleGrantService.Request(ctx, session, account, role.ID, req.RoleExpiresAt)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
 
@@ -167,6 +215,8 @@ func (h *accountHandler) update(ctx *gin.Context) {
 		return
 	}
 
+	h.auditAccount(ctx)
+
 	account, err := h.accountService.Update(ctx, ctx.Param("id"), req.FirstName, req.LastName)
 	if err != nil {
 		if errors.Is(err, e.ErrEntityNotFound) {
@@ -215,8 +265,16 @@ func (h *accountHandler) changePassword(ctx *gin.Context) {
 		return
 	}
 
+	middlewares.AuditBefore(ctx, account)
+
 	err = h.accountService.ChangePassword(ctx, account, req.Password, req.NewPassword)
 	if err != nil {
+		if passwordPolicyViolated(err) {
+			response.ValidationFailed(ctx, err)
+
+			return
+		}
+
 		response.BadRequest(ctx, err, nil)
 
 		return
@@ -238,6 +296,8 @@ func (h *accountHandler) changePassword(ctx *gin.Context) {
 // @Router /api/accounts/{id} [delete].
 func (h *accountHandler) delete(ctx *gin.Context) {
 	session := ctx.Value("session").(*entities.Session)
+	h.auditAccount(ctx)
+
 	err := h.accountService.Delete(ctx, session.Account, ctx.Param("id"))
 	if err != nil {
 		if errors.Is(err, e.ErrEntityNotFound) {
@@ -274,6 +334,8 @@ func (h *accountHandler) assignOrganization(ctx *gin.Context) {
 		return
 	}
 
+	h.auditAccount(ctx)
+
 	account, err := h.organizationService.Assign(ctx, uuid.MustParse(ctx.Param("id")), req.OrganizationID)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
@@ -304,6 +366,8 @@ func (h *accountHandler) revokeOrganization(ctx *gin.Context) {
 		return
 	}
 
+	h.auditAccount(ctx)
+
 	err := h.organizationService.Revoke(ctx, uuid.MustParse(ctx.Param("id")), req.OrganizationID)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
@@ -317,16 +381,17 @@ func (h *accountHandler) revokeOrganization(ctx *gin.Context) {
 // @Summary Add role.
 // @Tags accounts
 // @Consume application/json
-// @Description Add role.
+// @Description Add role, until expires_at when set. Roles that need an approval are granted once another administrator approves.
 // @Accept json
 // @Produce json
 // @Security X-Authenticate
 // @Param X-Auth header string true "Insert your access token"
 // @Param id path string true "account_id"
 // @Param data body requests.AccountRoleRequest true "AccountRoleRequest"
-// @Success 200 {object} response.Response
+// @Success 200 {object} response.Response{data=entities.RoleGrant}
 // @Router /api/accounts/{id}/roles [post].
 func (h *accountHandler) assignRole(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
 	req := &requests.AccountRoleRequest{}
 	if err := ctx.ShouldBind(&req); err != nil {
 		response.ValidationFailed(ctx, err)
@@ -334,14 +399,70 @@ func (h *accountHandler) assignRole(ctx *gin.Context) {
 		return
 	}
 
-	err := h.authorizationService.AssignRole(ctx, ctx.Param("id"), req.RoleID)
+	roleID, err := uuid.Parse(req.RoleID)
+	if err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	account, err := h.organizationAccount(ctx, session)
+	if err != nil {
+		h.accountError(ctx, err)
+
+		return
+	}
+
+	middlewares.AuditBefore(ctx, account)
+
+	grant, err := h.roleGrantService.Request(ctx, session, account, roleID, req.ExpiresAt)
+	if err != nil {
+		h.accountError(ctx, err)
+
+		return
+	}
+
+	response.OK(ctx, grant, nil)
+}
+
+// @Summary Get role grants.
+// @Tags accounts
+// @Consume application/json
+// @Description Pending, active and ended role grants of an account of the current organization.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "account_id"
+// @Param limit query int true "rows limit"
+// @Param page query int true "page"
+// @Param status query string false "pending, active, expired, rejected or revoked"
+// @Success 200 {object} response.Response{data=entities.Pagination[entities.RoleGrant]}
+// @Router /api/accounts/{id}/role_grants [get].
+func (h *accountHandler) roleGrants(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.RoleGrantsRequest{}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	account, err := h.organizationAccount(ctx, session)
+	if err != nil {
+		h.accountError(ctx, err)
+
+		return
+	}
+
+	grants, err := h.roleGrantService.Account(ctx, account.ID, req.Status, req.Limit, req.Page)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
 
 		return
 	}
 
-	response.OK(ctx, "Success", nil)
+	response.OK(ctx, grants, nil)
 }
 
 // @Summary Revoke role.
@@ -364,6 +485,8 @@ func (h *accountHandler) revokeRole(ctx *gin.Context) {
 		return
 	}
 
+	h.auditAccount(ctx)
+
 	err := h.authorizationService.RevokeRole(ctx, ctx.Param("id"), req.RoleID)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
@@ -394,6 +517,8 @@ func (h *accountHandler) assignOperator(ctx *gin.Context) {
 		return
 	}
 
+	h.auditAccount(ctx)
+
 	account, err := h.organizationService.AssignOperator(ctx, uuid.MustParse(ctx.Param("id")).String(), req.OperatorID.String(), req.IntegratorID)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
@@ -424,6 +549,8 @@ func (h *accountHandler) revokeOperator(ctx *gin.Context) {
 		return
 	}
 
+	h.auditAccount(ctx)
+
 	err := h.organizationService.RevokeOperator(ctx, ctx.Param("id"), req.OperatorID, req.IntegratorID)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
@@ -495,3 +622,243 @@ func (h *accountHandler) get(ctx *gin.Context) {
 
 	response.OK(ctx, account, nil)
 }
+
+// @Summary Get account sessions.
+// @Tags accounts
+// @Consume application/json
+// @Description Active sessions of an account of the current organization.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "account_id"
+// @Success 200 {object} response.Response{data=[]entities.SessionInfo}
+// @Router /api/accounts/{id}/sessions [get].
+func (h *accountHandler) sessions(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	account, err := h.organizationAccount(ctx, session)
+	if err != nil {
+		h.accountError(ctx, err)
+
+		return
+	}
+
+	sessions, err := h.authenticationService.Sessions(ctx, account.ID, session.ID)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, sessions, nil)
+}
+
+// @Summary Revoke account session.
+// @Tags accounts
+// @Consume application/json
+// @Description Revoke one session of an account of the current organization.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "account_id"
+// @Param session_id path string true "session_id"
+// @Success 200 {object} response.Response{data=string}
+// @Router /api/accounts/{id}/sessions/{session_id} [delete].
+func (h *accountHandler) revokeSession(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	sessionID, err := uuid.Parse(ctx.Param("session_id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	account, err := h.organizationAccount(ctx, session)
+	if err != nil {
+		h.accountError(ctx, err)
+
+		return
+	}
+
+	if err = h.authenticationService.Revoke(ctx, account.ID, sessionID); err != nil {
+		h.accountError(ctx, err)
+
+		return
+	}
+
+	response.OK(ctx, "Success", nil)
+}
+
+// @Summary Revoke account sessions.
+// @Tags accounts
+// @Consume application/json
+// @Description Revoke all sessions of an account of the current organization, the current session is kept.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "account_id"
+// @Success 200 {object} response.Response{data=string}
+// @Router /api/accounts/{id}/sessions [delete].
+func (h *accountHandler) revokeSessions(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	account, err := h.organizationAccount(ctx, session)
+	if err != nil {
+		h.accountError(ctx, err)
+
+		return
+	}
+
+	if err = h.authenticationService.RevokeOthers(ctx, account.ID, session.ID); err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, "Success", nil)
+}
+
+// @Summary Unlock account.
+// @Tags accounts
+// @Consume application/json
+// @Description Unlock an account locked after too many failed sign in attempts.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "account_id"
+// @Success 200 {object} response.Response{data=entities.Account}
+// @Router /api/accounts/{id}/unlock [post].
+func (h *accountHandler) unlock(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	h.auditAccount(ctx)
+
+	account, err := h.lockoutService.Unlock(ctx, session, id)
+	if err != nil {
+		h.accountError(ctx, err)
+
+		return
+	}
+
+	response.OK(ctx, account, nil)
+}
+
+// @Summary Reset account two factor.
+// @Tags accounts
+// @Consume application/json
+// @Description Turn off two factor authentication of an account that lost its authenticator and recovery codes.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "account_id"
+// @Success 200 {object} response.Response{data=entities.Account}
+// @Router /api/accounts/{id}/totp/reset [post].
+func (h *accountHandler) resetTOTP(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	account, err := h.organizationAccount(ctx, session)
+	if err != nil {
+		h.accountError(ctx, err)
+
+		return
+	}
+
+	middlewares.AuditBefore(ctx, account)
+
+	account, err = h.twoFactorService.Reset(ctx, session, account)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, account, nil)
+}
+
+// @Summary Impersonate account.
+// @Tags accounts
+// @Consume application/json
+// @Description Sign in as the account to see the backoffice as it does. The session expires after a short time,
+// @Description can not be refreshed and can not change anything outside of the configured endpoints.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "account_id"
+// @Success 200 {object} response.Response{data=auth.Auth}
+// @Router /api/accounts/{id}/impersonate [post].
+func (h *accountHandler) impersonate(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	token, err := h.impersonationService.Start(ctx, session, id, device(ctx))
+	if err != nil {
+		if errors.Is(err, services.ErrImpersonationForbidden) {
+			response.Forbidden(ctx, err, nil)
+
+			return
+		}
+
+		h.accountError(ctx, err)
+
+		return
+	}
+
+	response.OK(ctx, token, nil)
+}
+
+// organizationAccount finds the account of the id param, accounts outside of the current organization
+// are not found unless the caller is root.
+func (h *accountHandler) organizationAccount(ctx *gin.Context, session *entities.Session) (*entities.Account, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := h.accountService.FindBy(ctx, map[string]interface{}{"id": id})
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.Account.IsRoot() && !account.InOrganization(session.OrganizationID) {
+		return nil, e.ErrEntityNotFound
+	}
+
+	return account, nil
+}
+
+// auditAccount snapshots the account of the id param before it changes.
+func (h *accountHandler) auditAccount(ctx *gin.Context) {
+	if before, err := h.accountService.FindBy(ctx, map[string]interface{}{"id": ctx.Param("id")}); err == nil {
+		middlewares.AuditBefore(ctx, before)
+	}
+}
+
+func (h *accountHandler) accountError(ctx *gin.Context, err error) {
+	if errors.Is(err, e.ErrEntityNotFound) {
+		response.NotFound(ctx, err, nil)
+
+		return
+	}
+
+	response.BadRequest(ctx, err, nil)
+}
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
+	"backoffice/internal/services"
+	"backoffice/internal/transport/http/middlewares"
+	"backoffice/internal/transport/http/requests"
+	"backoffice/internal/transport/http/response"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type apiKeyHandler struct {
+	apiKeyService *services.APIKeyService
+}
+
+func NewAPIKeyHandler(apiKeyService *services.APIKeyService) *apiKeyHandler {
+	return &apiKeyHandler{apiKeyService: apiKeyService}
+}
+
+func (h *apiKeyHandler) Register(router *gin.RouterGroup) {
+	keys := router.Group("api_keys")
+
+	keys.GET("", h.all)
+	keys.POST("", h.create)
+
+	key := keys.Group(":id")
+	{
+		key.POST("rotate", h.rotate)
+		key.DELETE("", h.revoke)
+	}
+}
+
+// @Summary Get service account API keys.
+// @Tags api_keys
+// @Consume application/json
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param limit query int true "rows limit"
+// @Param page query int true "page"
+// @Success 200 {object} response.Response{data=entities.Pagination[entities.APIKey]}
+// @Router /api/api_keys [get].
+func (h *apiKeyHandler) all(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.PaginateAPIKeyRequest{}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	paginate, err := h.apiKeyService.Paginate(ctx, session.OrganizationID, req.Limit, req.Page)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, paginate, nil)
+}
+
+// @Summary Create service account API key.
+// @Tags api_keys
+// @Consume application/json
+// @Description The key acts with permissions of the role, the secret is returned only in this response.
+// @Description Clients send it in the X-Api-Key header.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param data body requests.CreateAPIKeyRequest true "requests.CreateAPIKeyRequest"
+// @Success 200 {object} response.Response{data=entities.APIKey}
+// @Router /api/api_keys [post].
+func (h *apiKeyHandler) create(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.CreateAPIKeyRequest{}
+	if err := ctx.ShouldBind(req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	key, err := h.apiKeyService.Create(ctx, session, req)
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, key, nil)
+}
+
+// @Summary Rotate service account API key.
+// @Tags api_keys
+// @Consume application/json
+// @Description Issues a new secret for the key, the previous one stops working immediately.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "api_key_id"
+// @Param data body requests.RotateAPIKeyRequest true "requests.RotateAPIKeyRequest"
+// @Success 200 {object} response.Response{data=entities.APIKey}
+// @Router /api/api_keys/{id}/rotate [post].
+func (h *apiKeyHandler) rotate(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	keyID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	req := &requests.RotateAPIKeyRequest{}
+	if err := ctx.ShouldBind(req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	key, err := h.apiKeyService.Rotate(ctx, session.OrganizationID, keyID, req)
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, key, nil)
+}
+
+// @Summary Revoke service account API key.
+// @Tags api_keys
+// @Consume application/json
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "api_key_id"
+// @Success 204
+// @Router /api/api_keys/{id} [delete].
+func (h *apiKeyHandler) revoke(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	keyID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	if before, err := h.apiKeyService.Get(ctx, session.OrganizationID, keyID); err == nil {
+		middlewares.AuditBefore(ctx, before)
+	}
+
+	if err = h.apiKeyService.Revoke(ctx, session.OrganizationID, keyID); err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.NoContent(ctx)
+}
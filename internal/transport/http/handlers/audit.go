@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"backoffice/internal/entities"
+	"backoffice/internal/services"
+	"backoffice/internal/transport/http/requests"
+	"backoffice/internal/transport/http/response"
+	"github.com/gin-gonic/gin"
+	"strings"
+)
+
+type auditHandler struct {
+	auditService *services.AuditService
+}
+
+func NewAuditHandler(auditService *services.AuditService) *auditHandler {
+	return &auditHandler{auditService: auditService}
+}
+
+func (h *auditHandler) Register(router *gin.RouterGroup) {
+	audit := router.Group("audit")
+
+	audit.GET("", h.all)
+}
+
+// @Summary Get audit log.
+// @Tags audit
+// @Consume application/json
+// @Description Get paginated history of mutating actions. Non-root accounts only see their current organization.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param limit query int true "rows limit"
+// @Param page query int true "page"
+// @Param account_id query string false "account id"
+// @Param organization_id query string false "organization id"
+// @Param endpoint query string false "endpoint, e.g. /game/:id"
+// @Param method query string false "http method"
+// @Param entity_id query string false "entity id"
+// @Param impersonator_id query string false "account id of the administrator impersonating"
+// @Param from query string false "RFC3339 date from"
+// @Param to query string false "RFC3339 date to"
+// @Success 200 {object} response.Response{data=entities.Pagination[entities.AuditLog]}
+// @Router /api/audit [get].
+func (h *auditHandler) all(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.AuditRequest{}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	filters := &entities.AuditFilters{
+		AccountID:      req.AccountID,
+		OrganizationID: req.OrganizationID,
+		Endpoint:       req.Endpoint,
+		Method:         strings.ToUpper(req.Method),
+		EntityID:       req.EntityID,
+		ImpersonatorID: req.ImpersonatorID,
+		From:           req.From,
+		To:             req.To,
+	}
+
+	if !session.Account.IsRoot() {
+		filters.OrganizationID = &session.OrganizationID
+	}
+
+	paginate, err := h.auditService.Paginate(ctx, filters, req.Limit, req.Page)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, paginate, nil)
+}
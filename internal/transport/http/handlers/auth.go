@@ -17,18 +17,36 @@ import (
 )
 
 type authHandler struct {
-	authProvider        auth.Authorizer
-	authenticateService *services.AuthenticationService
-	accountService      *services.AccountService
-	sessionService      *services.SessionService
+	authProvider         auth.Authorizer
+	authenticateService  *services.AuthenticationService
+	accountService       *services.AccountService
+	sessionService       *services.SessionService
+	auditService         *services.AuditService
+	apiKeyService        *services.APIKeyService
+	lockoutService       *services.LockoutService
+	twoFactorService     *services.TwoFactorService
+	webAuthnService      *services.WebAuthnService
+	inviteService        *services.InviteService
+	impersonationService *services.ImpersonationService
 }
 
-func NewAuthHandler(authProvider auth.Authorizer, authenticateService *services.AuthenticationService, accountService *services.AccountService, sessionService *services.SessionService) *authHandler {
+func NewAuthHandler(authProvider auth.Authorizer, authenticateService *services.AuthenticationService, accountService *services.AccountService,
+	sessionService *services.SessionService, auditService *services.AuditService, apiKeyService *services.APIKeyService,
+	lockoutService *services.LockoutService, twoFactorService *services.TwoFactorService,
+	webAuthnService *services.WebAuthnService, inviteService *services.InviteService,
+	impersonationService *services.ImpersonationService) *authHandler {
 	return &authHandler{
-		authProvider:        authProvider,
-		authenticateService: authenticateService,
-		accountService:      accountService,
-		sessionService:      sessionService,
+		authProvider:         authProvider,
+		authenticateService:  authenticateService,
+		accountService:       accountService,
+		sessionService:       sessionService,
+		auditService:         auditService,
+		apiKeyService:        apiKeyService,
+		lockoutService:       lockoutService,
+		twoFactorService:     twoFactorService,
+		webAuthnService:      webAuthnService,
+		inviteService:        inviteService,
+		impersonationService: impersonationService,
 	}
 }
 
@@ -40,22 +58,42 @@ func (h *authHandler) Register(route *gin.RouterGroup) {
 		auth.POST("password/reset", h.resetPasswordRequest)
 		auth.POST("password/reset/:token", h.resetPassword)
 
-		auth.Use(middlewares.Authenticate(h.authProvider, h.sessionService))
+		auth.GET("invites/:token", h.invite)
+		auth.POST("invites/:token", h.acceptInvite)
+
+		auth.Use(middlewares.Authenticate(h.authProvider, h.sessionService), middlewares.Audit(h.auditService),
+			middlewares.Impersonation(h.impersonationService, h.auditService))
 		auth.POST("refresh", h.refresh)
 		auth.POST("logout", h.logout)
 		auth.GET("session", h.session)
+		auth.GET("sessions", h.sessions)
+		auth.DELETE("sessions", h.revokeOtherSessions)
+		auth.DELETE("sessions/:id", h.revokeSession)
 		auth.POST("organization", h.switchOrganization)
-		auth.POST("password/change", middlewares.TOTP(false), h.changePassword)
+		auth.DELETE("impersonation", h.endImpersonation)
+		auth.POST("password/change", middlewares.SecondFactor(h.lockoutService, h.twoFactorService, false), h.changePassword)
 
 		opt := auth.Group("otp")
 		{
-			opt.POST("generate", middlewares.TOTP(false), h.generateTOTP)
-			opt.POST("enable", middlewares.TOTP(false), h.enableTOTP)
-			opt.POST("disable", middlewares.TOTP(true), h.disableTOTP)
+			opt.POST("generate", middlewares.SecondFactor(h.lockoutService, h.twoFactorService, false), h.generateTOTP)
+			opt.POST("enable", middlewares.SecondFactor(h.lockoutService, h.twoFactorService, false), h.enableTOTP)
+			opt.POST("disable", middlewares.SecondFactor(h.lockoutService, h.twoFactorService, true), h.disableTOTP)
+			opt.GET("recovery_codes", h.recoveryCodes)
+			opt.POST("recovery_codes", middlewares.SecondFactor(h.lockoutService, h.twoFactorService, true), h.regenerateRecoveryCodes)
+		}
+
+		webAuthn := auth.Group("webauthn")
+		{
+			webAuthn.POST("register", h.beginWebAuthnRegistration)
+			webAuthn.GET("credentials", h.webAuthnCredentials)
+			webAuthn.POST("credentials", middlewares.SecondFactor(h.lockoutService, h.twoFactorService, false), h.registerWebAuthn)
+			webAuthn.DELETE("credentials/:id", middlewares.SecondFactor(h.lockoutService, h.twoFactorService, true), h.removeWebAuthn)
 		}
 	}
 
-	route.Use(middlewares.Authenticate(h.authProvider, h.sessionService), middlewares.Authorize())
+	route.Use(middlewares.AuthenticateAPIKey(h.apiKeyService, middlewares.Authenticate(h.authProvider, h.sessionService)),
+		middlewares.Audit(h.auditService), middlewares.Impersonation(h.impersonationService, h.auditService),
+		middlewares.Authorize())
 }
 
 // @Summary Generate TOTP QR.
@@ -91,6 +129,9 @@ func (h *authHandler) generateTOTP(ctx *gin.Context) {
 		return
 	}
 
+	// the QR code carries the secret
+	middlewares.AuditOmitResponse(ctx)
+
 	response.OK(ctx, image, nil)
 }
 
@@ -122,17 +163,18 @@ func (h *authHandler) enableTOTP(ctx *gin.Context) {
 		return
 	}
 
-	if _, err := h.accountService.EnableTOTP(ctx, session.Account); err != nil {
+	codes, err := h.twoFactorService.Enable(ctx, session.Account)
+	if err != nil {
 		response.ServerError(ctx, err, nil)
 		return
 	}
 
-	response.OK(ctx, "Success", "totp_enabled")
+	response.OK(ctx, codes, "totp_enabled")
 }
 
 func (h *authHandler) disableTOTP(ctx *gin.Context) {
 	session := ctx.Value("session").(*entities.Session)
-	if _, err := h.accountService.DisableTOTP(ctx, session.Account); err != nil {
+	if err := h.twoFactorService.Disable(ctx, session.Account); err != nil {
 		response.ServerError(ctx, err, nil)
 		return
 	}
@@ -140,14 +182,166 @@ func (h *authHandler) disableTOTP(ctx *gin.Context) {
 	response.OK(ctx, "Success", "totp_disabled")
 }
 
+// @Summary Count recovery codes.
+// @Tags TOTP
+// @Consume application/json
+// @Description Count unused recovery codes of the current account.
+// @Accept  json
+// @Produce  json
+// @Success 200  {object} response.Response{data=entities.RecoveryCodes}
+// @Router /api/auth/otp/recovery_codes [get].
+func (h *authHandler) recoveryCodes(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	codes, err := h.twoFactorService.Remaining(ctx, session.Account)
+	if err != nil {
+		response.ServerError(ctx, err, nil)
+		return
+	}
+
+	response.OK(ctx, codes, nil)
+}
+
+// @Summary Regenerate recovery codes.
+// @Tags TOTP
+// @Consume application/json
+// @Description Replace recovery codes of the current account, the new codes are only shown once.
+// @Accept  json
+// @Produce  json
+// @Param   data body   totp.Request true  "totp.Request"
+// @Success 200  {object} response.Response{data=entities.RecoveryCodes}
+// @Router /api/auth/otp/recovery_codes [post].
+func (h *authHandler) regenerateRecoveryCodes(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	codes, err := h.twoFactorService.Regenerate(ctx, session.Account)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+		return
+	}
+
+	response.OK(ctx, codes, nil)
+}
+
+// @Summary Start security key registration.
+// @Tags WebAuthn
+// @Consume application/json
+// @Description Get options for navigator.credentials.create.
+// @Accept  json
+// @Produce  json
+// @Success 200  {object} response.Response{data=webauthn.CreationOptions}
+// @Router /api/auth/webauthn/register [post].
+func (h *authHandler) beginWebAuthnRegistration(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	options, err := h.webAuthnService.BeginRegistration(ctx, session.Account)
+	if err != nil {
+		if errors.Is(err, services.ErrWebAuthnDisabled) {
+			response.NotFound(ctx, err, nil)
+			return
+		}
+
+		response.ServerError(ctx, err, nil)
+		return
+	}
+
+	response.OK(ctx, options, nil)
+}
+
+// @Summary Get security keys.
+// @Tags WebAuthn
+// @Consume application/json
+// @Description Security keys registered to the current account.
+// @Accept  json
+// @Produce  json
+// @Success 200  {object} response.Response{data=[]entities.WebAuthnCredential}
+// @Router /api/auth/webauthn/credentials [get].
+func (h *authHandler) webAuthnCredentials(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	credentials, err := h.webAuthnService.Credentials(ctx, session.Account.ID)
+	if err != nil {
+		response.ServerError(ctx, err, nil)
+		return
+	}
+
+	response.OK(ctx, credentials, nil)
+}
+
+// @Summary Register security key.
+// @Tags WebAuthn
+// @Consume application/json
+// @Description Finish security key registration with the response of navigator.credentials.create. Accounts
+// @Description with a second factor also send it. Recovery codes are returned with the first second factor.
+// @Accept  json
+// @Produce  json
+// @Param   data body   requests.RegisterWebAuthnRequest true  "requests.RegisterWebAuthnRequest"
+// @Success 200  {object} response.Response{data=entities.WebAuthnRegistration}
+// @Router /api/auth/webauthn/credentials [post].
+func (h *authHandler) registerWebAuthn(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.RegisterWebAuthnRequest{}
+	if err := ctx.ShouldBindBodyWith(&req, binding.JSON); err != nil || req.Credential == nil {
+		response.ValidationFailed(ctx, e.ErrValidationFailed("credential"))
+		return
+	}
+
+	registration, err := h.twoFactorService.AddWebAuthn(ctx, session.Account, req)
+	if err != nil {
+		if errors.Is(err, services.ErrWebAuthnDisabled) {
+			response.NotFound(ctx, err, nil)
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+		return
+	}
+
+	response.OK(ctx, registration, nil)
+}
+
+// @Summary Remove security key.
+// @Tags WebAuthn
+// @Consume application/json
+// @Description Remove security key of the current account, requires a second factor.
+// @Accept  json
+// @Produce  json
+// @Param id path string true "credential id"
+// @Param   data body   requests.SecondFactorRequest true  "requests.SecondFactorRequest"
+// @Success 200  {object} response.Response{data=string}
+// @Router /api/auth/webauthn/credentials/{id} [delete].
+func (h *authHandler) removeWebAuthn(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+		return
+	}
+
+	if err = h.twoFactorService.RemoveWebAuthn(ctx, session.Account, id); err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+			return
+		}
+
+		response.ServerError(ctx, err, nil)
+		return
+	}
+
+	response.OK(ctx, "Success", nil)
+}
+
 // @Summary Authenticate.
 // @Tags Auth
 // @Consume application/json
-// @Description Authenticate account.
+// @Description Authenticate account. An expired password is answered with 403, meta password_expired and
+// @Description a short lived token for the reset password endpoint.
 // @Accept json
 // @Produce json
 // @Param request body requests.AuthenticateRequest true "Authenticate"
 // @Success 200  {object} response.Response{data=auth.Auth}
+// @Failure 403  {object} response.Response{data=entities.PasswordRotation}
 // @Router /api/auth/login [post].
 func (h *authHandler) login(ctx *gin.Context) {
 	req := &requests.AuthenticateRequest{}
@@ -156,32 +350,68 @@ func (h *authHandler) login(ctx *gin.Context) {
 		return
 	}
 
+	d := device(ctx)
+
+	if retryAfter, err := h.lockoutService.Check(ctx, req.ID, d.IP); err != nil {
+		if errors.Is(err, services.ErrTooManyAttempts) {
+			response.TooManyRequests(ctx, err, retryAfter)
+			return
+		}
+
+		response.ServerError(ctx, err, nil)
+		return
+	}
+
 	account, err := h.accountService.Auth(ctx, req.ID, req.Token)
 	if err != nil {
+		if errors.Is(err, services.ErrNotValidPassword) || errors.Is(err, e.ErrEntityNotFound) {
+			if err := h.lockoutService.Failure(ctx, entities.SecurityEventLoginFailed, req.ID, d); err != nil {
+				response.ServerError(ctx, err, nil)
+				return
+			}
+		}
+
 		response.BadRequest(ctx, err, nil)
 		return
 	}
 
-	if account.TOTPEnabled {
-		r := &totp.Request{}
-		if err := ctx.ShouldBindBodyWith(r, binding.JSON); err != nil {
-			response.Unauthorized(ctx, middlewares.ErrTOTPSecretRequired, "totp_required")
-			return
-		}
+	if account.IsLocked() {
+		response.Forbidden(ctx, services.ErrAccountLocked, nil)
+		return
+	}
+
+	if account.IsPending() {
+		response.Forbidden(ctx, services.ErrInvitePending, nil)
+		return
+	}
+
+	if account.HasSecondFactor() && !middlewares.VerifySecondFactor(ctx, h.lockoutService, h.twoFactorService, account) {
+		return
+	}
+
+	if err = h.lockoutService.Success(ctx, req.ID); err != nil {
+		response.ServerError(ctx, err, nil)
+		return
+	}
 
-		valid, err := totp.T().Validate(r.TOTP, account.TOTPSecret)
+	expired, err := h.accountService.PasswordExpired(ctx, account)
+	if err != nil {
+		response.ServerError(ctx, err, nil)
+		return
+	}
+
+	if expired {
+		rotation, err := h.accountService.RotatePassword(ctx, account)
 		if err != nil {
-			response.ValidationFailed(ctx, err)
+			response.ServerError(ctx, err, nil)
 			return
 		}
 
-		if !valid {
-			response.BadRequest(ctx, middlewares.ErrInvalidTOTPSecret, nil)
-			return
-		}
+		response.Forbidden(ctx, rotation, "password_expired")
+		return
 	}
 
-	token, err := h.authenticateService.Authenticate(ctx, account)
+	token, err := h.authenticateService.Authenticate(ctx, account, d)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) || errors.Is(err, services.ErrNotValidPassword) {
 			response.Unauthorized(ctx, err, nil)
@@ -206,7 +436,7 @@ func (h *authHandler) login(ctx *gin.Context) {
 // @Success 200  {object} response.Response{data=auth.Auth}
 // @Router /api/auth/refresh [post].
 func (h *authHandler) refresh(ctx *gin.Context) {
-	token, err := h.authenticateService.Refresh(ctx, ctx.GetHeader("X-Authenticate"))
+	token, err := h.authenticateService.Refresh(ctx, ctx.GetHeader("X-Authenticate"), device(ctx))
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) || errors.Is(err, services.ErrNotValidPassword) {
 			response.Unauthorized(ctx, err, nil)
@@ -261,6 +491,11 @@ func (h *authHandler) changePassword(ctx *gin.Context) {
 	}
 
 	if err := h.accountService.ChangePassword(ctx, session.Account, req.Password, req.NewPassword); err != nil {
+		if passwordPolicyViolated(err) {
+			response.ValidationFailed(ctx, err)
+			return
+		}
+
 		response.BadRequest(ctx, err, nil)
 		return
 	}
@@ -368,9 +603,199 @@ func (h *authHandler) resetPassword(ctx *gin.Context) {
 			return
 		}
 
+		if passwordPolicyViolated(err) {
+			response.ValidationFailed(ctx, err)
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+		return
+	}
+
+	response.OK(ctx, "Success", nil)
+}
+
+// @Summary Get invite.
+// @Tags Auth
+// @Consume application/json
+// @Description Get the invite of a link before setting the password.
+// @Accept json
+// @Produce json
+// @Param token path string true "token"
+// @Success 200 {object} response.Response{data=entities.Invite}
+// @Router /api/auth/invites/{token} [get].
+func (h *authHandler) invite(ctx *gin.Context) {
+	invite, err := h.inviteService.Find(ctx, ctx.Param("token"))
+	if err != nil {
+		if errors.Is(err, services.ErrInviteInvalid) || errors.Is(err, services.ErrInviteDisabled) {
+			response.NotFound(ctx, err, nil)
+			return
+		}
+
+		response.ServerError(ctx, err, nil)
+		return
+	}
+
+	response.OK(ctx, invite, nil)
+}
+
+// @Summary Accept invite.
+// @Tags Auth
+// @Consume application/json
+// @Description Set the password of an invited account and sign in, the link stops working. Two factor
+// @Description authentication can be enrolled with the issued tokens.
+// @Accept json
+// @Produce json
+// @Param token path string true "token"
+// @Param data body requests.ResetPassword true "ResetPassword"
+// @Success 200 {object} response.Response{data=auth.Auth}
+// @Router /api/auth/invites/{token} [post].
+func (h *authHandler) acceptInvite(ctx *gin.Context) {
+	req := &requests.ResetPassword{}
+	if err := ctx.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+		response.ValidationFailed(ctx, err)
+		return
+	}
+
+	token, err := h.inviteService.Accept(ctx, ctx.Param("token"), req.NewPassword, device(ctx))
+	if err != nil {
+		if errors.Is(err, services.ErrInviteInvalid) || errors.Is(err, services.ErrInviteDisabled) {
+			response.NotFound(ctx, err, nil)
+			return
+		}
+
+		if passwordPolicyViolated(err) {
+			response.ValidationFailed(ctx, err)
+			return
+		}
+
+		response.ServerError(ctx, err, nil)
+		return
+	}
+
+	response.OK(ctx, token, nil)
+}
+
+// @Summary Active sessions.
+// @Tags Auth
+// @Consume application/json
+// @Description List active sessions of the account.
+// @Accept  json
+// @Produce  json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Success 200  {object} response.Response{data=[]entities.SessionInfo}
+// @Router /api/auth/sessions [get].
+func (h *authHandler) sessions(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	sessions, err := h.authenticateService.Sessions(ctx, session.Account.ID, session.ID)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, sessions, nil)
+}
+
+// @Summary Revoke session.
+// @Tags Auth
+// @Consume application/json
+// @Description Revoke one of the account sessions.
+// @Accept  json
+// @Produce  json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param   id path   string true  "session id"
+// @Success 200  {object} response.Response{data=string}
+// @Router /api/auth/sessions/{id} [delete].
+func (h *authHandler) revokeSession(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	if err = h.authenticateService.Revoke(ctx, session.Account.ID, id); err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
 		response.BadRequest(ctx, err, nil)
+
 		return
 	}
 
 	response.OK(ctx, "Success", nil)
 }
+
+// @Summary Revoke other sessions.
+// @Tags Auth
+// @Consume application/json
+// @Description Revoke all account sessions except the current one.
+// @Accept  json
+// @Produce  json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Success 200  {object} response.Response{data=string}
+// @Router /api/auth/sessions [delete].
+func (h *authHandler) revokeOtherSessions(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	if err := h.authenticateService.RevokeOthers(ctx, session.Account.ID, session.ID); err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, "Success", nil)
+}
+
+// @Summary End impersonation.
+// @Tags Auth
+// @Consume application/json
+// @Description End the impersonated session, the session of the administrator is not affected.
+// @Accept  json
+// @Produce  json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Success 200  {object} response.Response{data=string}
+// @Router /api/auth/impersonation [delete].
+func (h *authHandler) endImpersonation(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	if err := h.impersonationService.End(ctx, session); err != nil {
+		if errors.Is(err, services.ErrNotImpersonating) {
+			response.BadRequest(ctx, err, nil)
+
+			return
+		}
+
+		response.ServerError(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, "Success", nil)
+}
+
+// passwordPolicyViolated is true for new passwords breaking the password policy, the broken rules are
+// reported as validation errors.
+func passwordPolicyViolated(err error) bool {
+	var policyErr *services.PasswordPolicyError
+
+	return errors.As(err, &policyErr)
+}
+
+func device(ctx *gin.Context) entities.Device {
+	return entities.Device{
+		IP:        ctx.ClientIP(),
+		UserAgent: ctx.Request.UserAgent(),
+	}
+}
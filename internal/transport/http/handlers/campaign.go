@@ -0,0 +1,349 @@
+package handlers
+
+import (
+	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
+	"backoffice/internal/services"
+	"backoffice/internal/transport/http/middlewares"
+	"backoffice/internal/transport/http/requests"
+	"backoffice/internal/transport/http/response"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type campaignHandler struct {
+	campaignService *services.FreeSpinCampaignService
+}
+
+func NewCampaignHandler(campaignService *services.FreeSpinCampaignService) *campaignHandler {
+	return &campaignHandler{campaignService: campaignService}
+}
+
+func (h *campaignHandler) Register(router *gin.RouterGroup) {
+	campaigns := router.Group("campaigns")
+
+	campaigns.GET("", h.all)
+	campaigns.POST("", h.create)
+
+	campaign := campaigns.Group(":id")
+	{
+		campaign.GET("", h.get)
+		campaign.PUT("", h.update)
+		campaign.DELETE("", h.delete)
+
+		campaign.GET("users", h.users)
+		campaign.POST("users", h.addUsers)
+		campaign.DELETE("users/:user_id", h.removeUser)
+	}
+}
+
+// @Summary Get free spin campaigns.
+// @Tags campaigns
+// @Consume application/json
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param limit query int true "rows limit"
+// @Param page query int true "page"
+// @Param status query string false "campaign status"
+// @Param integrator_id query string false "integrator id"
+// @Success 200 {object} response.Response{data=entities.Pagination[entities.FreeSpinCampaign]}
+// @Router /api/campaigns [get].
+func (h *campaignHandler) all(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.PaginateCampaignRequest{}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	filters := map[string]interface{}{}
+	if req.Status != "" {
+		filters["status"] = req.Status
+	}
+
+	if req.IntegratorID != nil {
+		filters["integrator_id"] = *req.IntegratorID
+	}
+
+	paginate, err := h.campaignService.Paginate(ctx, session.OrganizationID, filters, req.Limit, req.Page)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, paginate, nil)
+}
+
+// @Summary Create free spin campaign.
+// @Tags campaigns
+// @Consume application/json
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param data body requests.CampaignRequest true "requests.CampaignRequest"
+// @Success 200 {object} response.Response{data=entities.FreeSpinCampaign}
+// @Router /api/campaigns [post].
+func (h *campaignHandler) create(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.CampaignRequest{}
+	if err := ctx.ShouldBind(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	campaign, err := h.campaignService.Create(ctx, session, req)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, campaign, nil)
+}
+
+// @Summary Get free spin campaign.
+// @Tags campaigns
+// @Consume application/json
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "campaign_id"
+// @Success 200 {object} response.Response{data=entities.FreeSpinCampaign}
+// @Router /api/campaigns/{id} [get].
+func (h *campaignHandler) get(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	campaignID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	campaign, err := h.campaignService.Get(ctx, session.OrganizationID, campaignID)
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, campaign, nil)
+}
+
+// @Summary Update free spin campaign.
+// @Tags campaigns
+// @Consume application/json
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "campaign_id"
+// @Param data body requests.CampaignRequest true "requests.CampaignRequest"
+// @Success 200 {object} response.Response{data=entities.FreeSpinCampaign}
+// @Router /api/campaigns/{id} [put].
+func (h *campaignHandler) update(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.CampaignRequest{}
+	if err := ctx.ShouldBind(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	campaignID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	if before, err := h.campaignService.Get(ctx, session.OrganizationID, campaignID); err == nil {
+		middlewares.AuditBefore(ctx, before)
+	}
+
+	campaign, err := h.campaignService.Update(ctx, session.OrganizationID, campaignID, req)
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, campaign, nil)
+}
+
+// @Summary Delete free spin campaign.
+// @Tags campaigns
+// @Consume application/json
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "campaign_id"
+// @Success 204
+// @Router /api/campaigns/{id} [delete].
+func (h *campaignHandler) delete(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	campaignID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	if before, err := h.campaignService.Get(ctx, session.OrganizationID, campaignID); err == nil {
+		middlewares.AuditBefore(ctx, before)
+	}
+
+	if err = h.campaignService.Delete(ctx, session.OrganizationID, campaignID); err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.NoContent(ctx)
+}
+
+// @Summary Get free spin campaign users.
+// @Tags campaigns
+// @Consume application/json
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "campaign_id"
+// @Success 200 {object} response.Response{data=[]entities.FreeSpinUser}
+// @Router /api/campaigns/{id}/users [get].
+func (h *campaignHandler) users(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	campaignID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	users, err := h.campaignService.Users(ctx, session.OrganizationID, campaignID)
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, users, nil)
+}
+
+// @Summary Attach users to free spin campaign.
+// @Tags campaigns
+// @Consume application/json
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "campaign_id"
+// @Param data body requests.AddCampaignUsersRequest true "requests.AddCampaignUsersRequest"
+// @Success 200 {object} response.Response{data=[]entities.FreeSpinUser}
+// @Router /api/campaigns/{id}/users [post].
+func (h *campaignHandler) addUsers(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.AddCampaignUsersRequest{}
+	if err := ctx.ShouldBind(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	campaignID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	users, err := h.campaignService.AddUsers(ctx, session.OrganizationID, campaignID, req.Users)
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, users, nil)
+}
+
+// @Summary Detach user from free spin campaign.
+// @Tags campaigns
+// @Consume application/json
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "campaign_id"
+// @Param user_id path string true "campaign user id"
+// @Success 204
+// @Router /api/campaigns/{id}/users/{user_id} [delete].
+func (h *campaignHandler) removeUser(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	campaignID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	userID, err := uuid.Parse(ctx.Param("user_id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	if err = h.campaignService.RemoveUser(ctx, session.OrganizationID, campaignID, userID); err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.NoContent(ctx)
+}
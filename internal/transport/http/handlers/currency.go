@@ -4,6 +4,7 @@ import (
 	"backoffice/internal/entities"
 	e "backoffice/internal/errors"
 	"backoffice/internal/services"
+	"backoffice/internal/transport/http/middlewares"
 	"backoffice/internal/transport/http/requests"
 	"backoffice/internal/transport/http/response"
 	"errors"
@@ -164,6 +165,10 @@ func (h *currencyHandler) update(ctx *gin.Context) {
 		return
 	}
 
+	if before, err := h.currencyService.Get(ctx, req.OrganizationPairID, req.Title); err == nil {
+		middlewares.AuditBefore(ctx, before)
+	}
+
 	cm, err := h.currencyService.UpdateCurrencyMultiplier(ctx, req.OrganizationPairID, req.Title, req.Multiplier, req.Synonym)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
@@ -195,6 +200,10 @@ func (h *currencyHandler) delete(ctx *gin.Context) {
 		return
 	}
 
+	if before, err := h.currencyService.Get(ctx, req.OrganizationPairID, req.Title); err == nil {
+		middlewares.AuditBefore(ctx, before)
+	}
+
 	err := h.currencyService.DeleteCurrencyMultiplier(ctx, req.OrganizationPairID, req.Title)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
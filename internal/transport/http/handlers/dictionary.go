@@ -80,7 +80,7 @@ func (h *dictionaryHandler) games(ctx *gin.Context) {
 // @Router /api/dictionaries/hosts [get].
 func (h *dictionaryHandler) hosts(ctx *gin.Context) {
 	session := ctx.Value("session").(*entities.Session)
-	games, err := h.spinService.Hosts(ctx, &session.OrganizationID)
+	games, err := h.spinService.Hosts(ctx, session.ReportAccess())
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
 
@@ -213,7 +213,7 @@ func (h *dictionaryHandler) integrators(ctx *gin.Context) {
 func (h *dictionaryHandler) integratorOperators(ctx *gin.Context) {
 	session := ctx.Value("session").(*entities.Session)
 
-	integrators, err := h.spinService.IntegratorOperatorsMap(ctx, &session.OrganizationID)
+	integrators, err := h.spinService.IntegratorOperatorsMap(ctx, session.ReportAccess())
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
 
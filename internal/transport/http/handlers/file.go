@@ -2,11 +2,14 @@ package handlers
 
 import (
 	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
 	"backoffice/internal/services"
+	"backoffice/internal/transport/http/middlewares"
 	"backoffice/internal/transport/http/response"
+	"context"
 	"errors"
+	"io"
 	"net/http"
-	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -40,7 +43,11 @@ func (h *fileHandler) Register(router *gin.RouterGroup) {
 
 	files.GET("", h.files)
 	files.GET(":id", h.download)
+	files.DELETE(":id", h.cancel)
+	files.GET("ws", h.subscribeAllHandler)
 	files.GET("ws/:id", h.subscribeHandler)
+	files.GET("events", h.allEventsHandler)
+	files.GET("events/:id", h.eventsHandler)
 }
 
 // @Summary Get list of files by organizationId.
@@ -77,64 +84,213 @@ func (h *fileHandler) files(ctx *gin.Context) {
 // @Router /api/files/{id} [get].
 func (h *fileHandler) download(ctx *gin.Context) {
 	session := ctx.Value("session").(*entities.Session)
-	id := ctx.Param("id")
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
 
-	file, err := h.fileService.GetFile(ctx, session.ID, uuid.MustParse(id))
+		return
+	}
+
+	file, err := h.fileService.GetFile(ctx, session.ID, id)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
 
 		return
 	}
 
+	var contentType string
+
 	switch file.Type {
 	case entities.FileXLSX:
-		response.XLSX(ctx, file.Data, file.Name)
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
 	case entities.FileCSV:
-		response.CSV(ctx, file.Name, file.Array)
+		contentType = "text/csv"
 	default:
 		response.BadRequest(ctx, errors.New("unknown file type"), nil)
+
+		return
 	}
+
+	reader, err := h.fileService.Open(ctx, file)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+	defer reader.Close()
+
+	response.Stream(ctx, reader, contentType, file.FileName())
 }
 
-// @Summary Check file generation status.
+// @Summary Cancel file generation.
 // @Tags files
-// @Description Only for admin. Websocket.
+// @Description Only for admin. Stops a queued or running export.
+// @Accept  json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your refresh token"
+// @Param   id path   string true  "file_id"
+// @Success 204
+// @Router /api/files/{id} [delete].
+func (h *fileHandler) cancel(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	if before, err := h.fileService.GetFile(ctx, session.ID, id); err == nil {
+		middlewares.AuditBefore(ctx, before.Response())
+	}
+
+	if err = h.fileService.Cancel(ctx, session.ID, id); err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.NoContent(ctx)
+}
+
+// @Summary Follow generation status of a file.
+// @Tags files
+// @Description Only for admin. Websocket, pushes entities.FileEvent as json starting with the current state and closes after the final status.
 // @Accept  json
 // @Security X-Authenticate
 // @Param X-Auth header string true "Insert your refresh token"
 // @Param   id path   string true  "file_id"
 // @Router /api/files/ws/{id} [get].
 func (h *fileHandler) subscribeHandler(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	h.websocket(ctx, &id)
+}
+
+// @Summary Follow generation status of all session files.
+// @Tags files
+// @Description Only for admin. Websocket, pushes entities.FileEvent as json for every export of the session.
+// @Accept  json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your refresh token"
+// @Router /api/files/ws [get].
+func (h *fileHandler) subscribeAllHandler(ctx *gin.Context) {
+	h.websocket(ctx, nil)
+}
+
+// @Summary Follow generation status of a file.
+// @Tags files
+// @Description Only for admin. Server-sent events named "file" with entities.FileEvent data, the stream ends after the final status.
+// @Produce text/event-stream
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your refresh token"
+// @Param   id path   string true  "file_id"
+// @Success 200  {object} entities.FileEvent
+// @Router /api/files/events/{id} [get].
+func (h *fileHandler) eventsHandler(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	h.sse(ctx, &id)
+}
+
+// @Summary Follow generation status of all session files.
+// @Tags files
+// @Description Only for admin. Server-sent events named "file" with entities.FileEvent data for every export of the session.
+// @Produce text/event-stream
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your refresh token"
+// @Success 200  {object} entities.FileEvent
+// @Router /api/files/events [get].
+func (h *fileHandler) allEventsHandler(ctx *gin.Context) {
+	h.sse(ctx, nil)
+}
+
+func (h *fileHandler) websocket(ctx *gin.Context, fileID *uuid.UUID) {
 	session := ctx.Value("session").(*entities.Session)
-	id := ctx.Param("id")
 
 	conn, err := h.upgrader.Upgrade(ctx.Writer, ctx.Request, nil)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
+
 		return
 	}
+	defer conn.Close()
+
+	subCtx, cancel := context.WithCancel(ctx.Request.Context())
+	defer cancel()
 
-	for {
-		file, err := h.fileService.GetFile(ctx, session.ID, uuid.MustParse(id))
-		if err != nil {
-			if err := conn.WriteMessage(websocket.TextMessage, []byte(err.Error())); err != nil {
-				zap.S().Error(err)
+	// clients only listen, reading is needed to notice that the connection is gone
+	go func() {
+		defer cancel()
+
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
 			}
-			conn.Close()
 		}
+	}()
 
-		if err := conn.WriteMessage(websocket.TextMessage, []byte(file.Status)); err != nil {
-			if err := conn.WriteMessage(websocket.TextMessage, []byte(err.Error())); err != nil {
-				zap.S().Error(err)
-			}
-			conn.Close()
+	events, err := h.fileService.Follow(subCtx, session.ID, fileID)
+	if err != nil {
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(err.Error())); err != nil {
+			zap.S().Error(err)
+		}
+
+		return
+	}
+
+	for event := range events {
+		if err := conn.WriteJSON(event); err != nil {
+			zap.S().Error(err)
+
+			return
 		}
+	}
+}
+
+func (h *fileHandler) sse(ctx *gin.Context, fileID *uuid.UUID) {
+	session := ctx.Value("session").(*entities.Session)
+
+	events, err := h.fileService.Follow(ctx.Request.Context(), session.ID, fileID)
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
 
-		if file.Status != entities.FileStatusInProgress {
-			conn.Close()
 			return
 		}
 
-		time.Sleep(time.Second)
+		response.BadRequest(ctx, err, nil)
+
+		return
 	}
+
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("X-Accel-Buffering", "no")
+
+	ctx.Stream(func(w io.Writer) bool {
+		event, ok := <-events
+		if !ok {
+			return false
+		}
+
+		ctx.SSEvent("file", event)
+
+		return true
+	})
 }
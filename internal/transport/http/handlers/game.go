@@ -4,23 +4,33 @@ import (
 	"backoffice/internal/entities"
 	e "backoffice/internal/errors"
 	"backoffice/internal/services"
+	"backoffice/internal/transport/http/middlewares"
 	"backoffice/internal/transport/http/requests"
 	"backoffice/internal/transport/http/response"
 	"errors"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/google/uuid"
+	"strconv"
 )
 
 type gameHandler struct {
-	gameService *services.GameService
-	cfgSender   *services.ConfigSenderService
+	gameService            *services.GameService
+	gameHistoryService     *services.GameHistoryService
+	gameCatalogService     *services.GameCatalogService
+	wagerConstraintService *services.WagerConstraintService
+	cfgSender              *services.ConfigSenderService
 }
 
-func NewGameHTTPHandler(gameService *services.GameService, cfgSender *services.ConfigSenderService) *gameHandler {
+func NewGameHTTPHandler(gameService *services.GameService, gameHistoryService *services.GameHistoryService,
+	gameCatalogService *services.GameCatalogService, wagerConstraintService *services.WagerConstraintService,
+	cfgSender *services.ConfigSenderService) *gameHandler {
 	return &gameHandler{
-		gameService: gameService,
-		cfgSender:   cfgSender,
+		gameService:            gameService,
+		gameHistoryService:     gameHistoryService,
+		gameCatalogService:     gameCatalogService,
+		wagerConstraintService: wagerConstraintService,
+		cfgSender:              cfgSender,
 	}
 }
 
@@ -30,11 +40,17 @@ func (h *gameHandler) Register(route *gin.RouterGroup) {
 		games.POST("", h.create)
 		games.GET("", h.all)
 		games.POST("search", h.search)
+		games.GET("catalog", h.catalog)
+		games.POST("catalog", h.importCatalog)
 		game := games.Group(":id")
 		{
 			game.GET("", h.get)
 			game.DELETE("", h.delete)
 			game.PUT("", h.update)
+			game.GET("history", h.history)
+			game.POST("rollback", h.rollback)
+			game.GET("wager_constraints", h.wagerConstraints)
+			game.PUT("wager_constraints", h.setWagerConstraints)
 		}
 	}
 }
@@ -128,6 +144,12 @@ func (h *gameHandler) create(ctx *gin.Context) {
 
 	game, err := h.gameService.Create(ctx, req)
 	if err != nil {
+		if wagerConstraintViolated(err) {
+			response.ValidationFailed(ctx, err)
+
+			return
+		}
+
 		response.BadRequest(ctx, err, nil)
 
 		return
@@ -190,6 +212,10 @@ func (h *gameHandler) delete(ctx *gin.Context) {
 		return
 	}
 
+	if before, err := h.gameService.GetGame(ctx, gameID); err == nil {
+		middlewares.AuditBefore(ctx, before)
+	}
+
 	err = h.gameService.Delete(ctx, gameID)
 	if err != nil {
 		if errors.Is(err, e.ErrEntityNotFound) {
@@ -234,8 +260,20 @@ func (h *gameHandler) update(ctx *gin.Context) {
 		return
 	}
 
-	game, err := h.gameService.Update(ctx, gameID, req)
+	if before, err := h.gameService.GetGame(ctx, gameID); err == nil {
+		middlewares.AuditBefore(ctx, before)
+	}
+
+	session := ctx.Value("session").(*entities.Session)
+
+	game, err := h.gameService.Update(ctx, session.Account, gameID, req)
 	if err != nil {
+		if wagerConstraintViolated(err) {
+			response.ValidationFailed(ctx, err)
+
+			return
+		}
+
 		response.BadRequest(ctx, err, nil)
 
 		return
@@ -245,3 +283,279 @@ func (h *gameHandler) update(ctx *gin.Context) {
 
 	response.OK(ctx, game, nil)
 }
+
+// @Summary Get game history.
+// @Tags game
+// @Consume application/json
+// @Description Versions of the game configuration newest first with the fields each of them changed,
+// @Description of the game settings of an integrator when integrator_id is set.
+// @Accept  json
+// @Produce  json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "id"
+// @Param limit query int true "rows limit"
+// @Param page query int true "page"
+// @Param integrator_id query string false "integrator_id"
+// @Success 200  {object} response.Response{data=entities.Pagination[entities.GameVersionChange]}
+// @Router /api/game/{id}/history [get].
+func (h *gameHandler) history(ctx *gin.Context) {
+	req := &requests.GameHistoryRequest{}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	gameID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	history, err := h.gameHistoryService.History(ctx, gameID, req.IntegratorID, req.Limit, req.Page)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, history, nil)
+}
+
+// @Summary Roll game back.
+// @Tags game
+// @Consume application/json
+// @Description Restore a version of the game configuration, or of the game settings of an integrator when
+// @Description integrator_id is set, and send the configuration to overlord.
+// @Accept  json
+// @Produce  json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "id"
+// @Param data body requests.GameRollbackRequest true "GameRollbackRequest"
+// @Success 200  {object} response.Response{data=entities.Game}
+// @Router /api/game/{id}/rollback [post].
+func (h *gameHandler) rollback(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.GameRollbackRequest{}
+	if err := ctx.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	gameID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	var restored interface{}
+
+	if req.IntegratorID != nil {
+		restored, err = h.gameHistoryService.RollbackIntegratorGame(ctx, session.Account, gameID, *req.IntegratorID, req.Version)
+	} else {
+		if before, err := h.gameService.GetGame(ctx, gameID); err == nil {
+			middlewares.AuditBefore(ctx, before)
+		}
+
+		restored, err = h.gameHistoryService.RollbackGame(ctx, session.Account, gameID, req.Version)
+	}
+
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		if wagerConstraintViolated(err) {
+			response.ValidationFailed(ctx, err)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	h.cfgSender.Notify(ctx)
+
+	response.OK(ctx, restored, nil)
+}
+
+// @Summary Download game catalog.
+// @Tags game
+// @Description Games as an Excel file that can be edited and imported back, lists are comma separated.
+// @Accept json
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param organization_id query string false "provider id"
+// @Success 200 {file} file "Excel file with games"
+// @Router /api/game/catalog [get].
+func (h *gameHandler) catalog(ctx *gin.Context) {
+	req := &requests.GameCatalogRequest{}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	filter := make(map[string]interface{})
+
+	if req.OrganizationID != nil {
+		filter["organization_id"] = *req.OrganizationID
+	}
+
+	file, fileName, err := h.gameCatalogService.Export(ctx, filter)
+	if err != nil {
+		response.ServerError(ctx, "Error exporting data: %s", err.Error())
+
+		return
+	}
+
+	response.XLSXFile(ctx, file, fileName)
+}
+
+// @Summary Import game catalog.
+// @Tags game
+// @Description Create and update games from an Excel file in the format of the catalog download, games are
+// @Description matched by name. Every row is checked first and the report lists what each row does and its
+// @Description errors. Unless dry_run is false nothing is changed, otherwise the games are saved in one
+// @Description transaction when no row has errors.
+// @Accept multipart/form-data
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param dry_run formData bool false "only report the changes, true by default"
+// @Param file formData file true "Excel file with games"
+// @Success 200 {object} response.Response{data=entities.GameCatalogReport}
+// @Failure 400 {object} response.Response{data=entities.GameCatalogReport}
+// @Router /api/game/catalog [post].
+func (h *gameHandler) importCatalog(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	dryRun, err := strconv.ParseBool(ctx.DefaultPostForm("dry_run", "true"))
+	if err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+	defer file.Close()
+
+	report, err := h.gameCatalogService.Import(ctx, session.Account, file, dryRun)
+	if err != nil {
+		if errors.Is(err, services.ErrGameCatalogInvalid) {
+			response.BadRequest(ctx, report, nil)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	if report.Applied {
+		h.cfgSender.Notify(ctx)
+	}
+
+	response.OK(ctx, report, nil)
+}
+
+// @Summary Get game wager constraints.
+// @Tags game
+// @Consume application/json
+// @Description Limits of the wager sets of the game, per currency and for every other currency.
+// @Accept  json
+// @Produce  json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "id"
+// @Success 200  {object} response.Response{data=[]entities.GameWagerConstraint}
+// @Router /api/game/{id}/wager_constraints [get].
+func (h *gameHandler) wagerConstraints(ctx *gin.Context) {
+	gameID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	constraints, err := h.wagerConstraintService.Get(ctx, gameID)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, constraints, nil)
+}
+
+// @Summary Set game wager constraints.
+// @Tags game
+// @Consume application/json
+// @Description Replace the wager constraints of the game, a constraint without a currency applies to every
+// @Description currency without one of its own. The wager sets the game and its integrators use already
+// @Description must keep to them.
+// @Accept  json
+// @Produce  json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "id"
+// @Param data body requests.GameWagerConstraintsRequest true "GameWagerConstraintsRequest"
+// @Success 200  {object} response.Response{data=[]entities.GameWagerConstraint}
+// @Router /api/game/{id}/wager_constraints [put].
+func (h *gameHandler) setWagerConstraints(ctx *gin.Context) {
+	req := &requests.GameWagerConstraintsRequest{}
+	if err := ctx.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	gameID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	if before, err := h.wagerConstraintService.Get(ctx, gameID); err == nil {
+		middlewares.AuditBefore(ctx, before)
+	}
+
+	constraints, err := h.wagerConstraintService.Set(ctx, gameID, req.Constraints)
+	if err != nil {
+		if wagerConstraintViolated(err) {
+			response.ValidationFailed(ctx, err)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, constraints, nil)
+}
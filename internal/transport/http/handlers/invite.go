@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
+	"backoffice/internal/services"
+	"backoffice/internal/transport/http/middlewares"
+	"backoffice/internal/transport/http/requests"
+	"backoffice/internal/transport/http/response"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type inviteHandler struct {
+	inviteService *services.InviteService
+}
+
+func NewInviteHandler(inviteService *services.InviteService) *inviteHandler {
+	return &inviteHandler{inviteService: inviteService}
+}
+
+func (h *inviteHandler) Register(router *gin.RouterGroup) {
+	invites := router.Group("invites")
+
+	invites.GET("", h.all)
+	invites.POST("", h.create)
+
+	invite := invites.Group(":id")
+	{
+		invite.POST("resend", h.resend)
+		invite.DELETE("", h.revoke)
+	}
+}
+
+// @Summary Get invites.
+// @Tags accounts
+// @Consume application/json
+// @Description Pending invites of the current organization.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param limit query int true "rows limit"
+// @Param offset query int true "rows offset"
+// @Param order query string false "order field"
+// @Success 200 {object} response.Response{data=[]entities.Invite}
+// @Router /api/invites [get].
+func (h *inviteHandler) all(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.Pagination{}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	invites, total, err := h.inviteService.Invites(ctx, session.OrganizationID, req.Order, req.Limit, req.Offset)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	req.Total = total
+
+	response.OK(ctx, invites, req)
+}
+
+// @Summary Invite account.
+// @Tags accounts
+// @Consume application/json
+// @Description Create a pending account with roles and organizations and email it a link to set the password.
+// @Description Roles that need an approval are granted once another administrator approves.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param data body requests.CreateInviteRequest true "requests.CreateInviteRequest"
+// @Success 200 {object} response.Response{data=entities.Invite}
+// @Router /api/invites [post].
+func (h *inviteHandler) create(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.CreateInviteRequest{}
+	if err := ctx.ShouldBind(req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	invite, err := h.inviteService.Invite(ctx, session, req)
+	if err != nil {
+		h.inviteError(ctx, err)
+
+		return
+	}
+
+	response.OK(ctx, invite, nil)
+}
+
+// @Summary Resend invite.
+// @Tags accounts
+// @Consume application/json
+// @Description Email a new link to the invited account, the previous link stops working.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "account_id"
+// @Success 200 {object} response.Response{data=entities.Invite}
+// @Router /api/invites/{id}/resend [post].
+func (h *inviteHandler) resend(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	invite, err := h.inviteService.Resend(ctx, session, id)
+	if err != nil {
+		h.inviteError(ctx, err)
+
+		return
+	}
+
+	response.OK(ctx, invite, nil)
+}
+
+// @Summary Revoke invite.
+// @Tags accounts
+// @Consume application/json
+// @Description Delete the pending account of the invite, its link stops working.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "account_id"
+// @Success 200 {object} response.Response{data=string}
+// @Router /api/invites/{id} [delete].
+func (h *inviteHandler) revoke(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	account, err := h.inviteService.Pending(ctx, session, id)
+	if err != nil {
+		h.inviteError(ctx, err)
+
+		return
+	}
+
+	middlewares.AuditBefore(ctx, entities.NewInvite(account))
+
+	if err = h.inviteService.Revoke(ctx, session, id); err != nil {
+		h.inviteError(ctx, err)
+
+		return
+	}
+
+	response.OK(ctx, "Success", nil)
+}
+
+func (h *inviteHandler) inviteError(ctx *gin.Context, err error) {
+	if errors.Is(err, e.ErrEntityNotFound) || errors.Is(err, services.ErrInviteDisabled) {
+		response.NotFound(ctx, err, nil)
+
+		return
+	}
+
+	response.BadRequest(ctx, err, nil)
+}
@@ -4,6 +4,7 @@ import (
 	"backoffice/internal/entities"
 	e "backoffice/internal/errors"
 	"backoffice/internal/services"
+	"backoffice/internal/transport/http/middlewares"
 	"backoffice/internal/transport/http/requests"
 	"backoffice/internal/transport/http/response"
 	"errors"
@@ -44,6 +45,7 @@ func (h *organizationHandler) Register(route *gin.RouterGroup) {
 			organization.GET("integrators", h.getIntegratorsByProvider)
 			organization.GET("operators", h.getOperatorsByIntegrator)
 			organization.PUT("", h.update)
+			organization.PUT("unsigned_public_reports", h.setUnsignedPublicReports)
 			organization.GET("game", h.getGame)
 			organization.POST("game", h.assignGames)
 			organization.PUT("game", h.updateGame)
@@ -218,6 +220,10 @@ func (h *organizationHandler) update(ctx *gin.Context) {
 		return
 	}
 
+	if before, err := h.organizationService.Get(ctx, organizationID); err == nil {
+		middlewares.AuditBefore(ctx, before)
+	}
+
 	organization, err := h.organizationService.Update(ctx, organizationID, uint8(req.Status), req.Name, req.Type)
 	if err != nil {
 		if errors.Is(err, e.ErrEntityNotFound) {
@@ -236,6 +242,53 @@ func (h *organizationHandler) update(ctx *gin.Context) {
 	response.OK(ctx, organization, nil)
 }
 
+// @Summary Switch unsigned public reports.
+// @Tags organizations
+// @Consume application/json
+// @Description Allow or refuse public reports of the integrator opened without a signed token.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "organization_id"
+// @Param data body requests.UnsignedPublicReportsRequest true "UnsignedPublicReportsRequest"
+// @Success 200 {object} response.Response{data=entities.Organization}
+// @Router /api/organizations/{id}/unsigned_public_reports [put].
+func (h *organizationHandler) setUnsignedPublicReports(ctx *gin.Context) {
+	req := &requests.UnsignedPublicReportsRequest{}
+	if err := ctx.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	organizationID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	if before, err := h.organizationService.Get(ctx, organizationID); err == nil {
+		middlewares.AuditBefore(ctx, before)
+	}
+
+	organization, err := h.organizationService.SetUnsignedPublicReports(ctx, organizationID, *req.Allowed)
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, organization, nil)
+}
+
 // @Summary Delete organization.
 // @Tags organizations
 // @Consume application/json
@@ -256,6 +309,10 @@ func (h *organizationHandler) delete(ctx *gin.Context) {
 		return
 	}
 
+	if before, err := h.organizationService.Get(ctx, organizationID); err == nil {
+		middlewares.AuditBefore(ctx, before)
+	}
+
 	err = h.organizationService.Delete(ctx, session.Account.ID, organizationID)
 	if err != nil {
 		if errors.Is(err, e.ErrEntityNotFound) {
@@ -333,6 +390,12 @@ func (h *organizationHandler) assignGames(ctx *gin.Context) {
 	zap.S().Info("init method cfgSender.Fire for integrator-games")
 	ig, err := h.organizationService.AssignGames(ctx, integratorID, req.WagerSetID, req.GameID...)
 	if err != nil {
+		if wagerConstraintViolated(err) {
+			response.ValidationFailed(ctx, err)
+
+			return
+		}
+
 		response.BadRequest(ctx, err, nil)
 
 		return
@@ -370,8 +433,16 @@ func (h *organizationHandler) updateGame(ctx *gin.Context) {
 		return
 	}
 	zap.S().Info("init method cfgSender.Fire for integrator-games")
-	ig, err := h.organizationService.UpdateGame(ctx, integratorID, req.GameID, req.WagerSetID, req.RTP, req.Volatility, req.ShortLink)
+	session := ctx.Value("session").(*entities.Session)
+
+	ig, err := h.organizationService.UpdateGame(ctx, session.Account, integratorID, req.GameID, req.WagerSetID, req.RTP, req.Volatility, req.ShortLink)
 	if err != nil {
+		if wagerConstraintViolated(err) {
+			response.ValidationFailed(ctx, err)
+
+			return
+		}
+
 		response.BadRequest(ctx, err, nil)
 
 		return
@@ -717,6 +788,12 @@ func (h *organizationHandler) addGameWagerSet(ctx *gin.Context) {
 	zap.S().Info("init method cfgSender.Fire for integrator-games-wager-set")
 	igws, err := h.organizationService.CreateIntegratorGameWagerSet(ctx, integratorID, req.WagerSetID, req.Currency, req.GameID)
 	if err != nil {
+		if wagerConstraintViolated(err) {
+			response.ValidationFailed(ctx, err)
+
+			return
+		}
+
 		response.BadRequest(ctx, err, nil)
 
 		return
@@ -756,6 +833,12 @@ func (h *organizationHandler) updateGameWagerSet(ctx *gin.Context) {
 	zap.S().Info("init method cfgSender.Fire for integrator-games-wager-set")
 	igws, err := h.organizationService.UpdateGameWagerSet(ctx, integratorID, req.GameID, req.WagerSetID, req.Currency, req.NewCurrency)
 	if err != nil {
+		if wagerConstraintViolated(err) {
+			response.ValidationFailed(ctx, err)
+
+			return
+		}
+
 		response.BadRequest(ctx, err, nil)
 
 		return
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"backoffice/internal/entities"
+	"backoffice/internal/services"
+	"backoffice/internal/transport/http/middlewares"
+	"backoffice/internal/transport/http/requests"
+	"backoffice/internal/transport/http/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+type passwordPolicyHandler struct {
+	passwordPolicyService *services.PasswordPolicyService
+}
+
+func NewPasswordPolicyHandler(passwordPolicyService *services.PasswordPolicyService) *passwordPolicyHandler {
+	return &passwordPolicyHandler{passwordPolicyService: passwordPolicyService}
+}
+
+func (h *passwordPolicyHandler) Register(router *gin.RouterGroup) {
+	policy := router.Group("password_policy")
+
+	policy.GET("", h.get)
+	policy.PUT("", h.update)
+}
+
+// @Summary Get password policy.
+// @Tags organizations
+// @Consume application/json
+// @Description Password policy of the current organization.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Success 200 {object} response.Response{data=entities.PasswordPolicy}
+// @Router /api/password_policy [get].
+func (h *passwordPolicyHandler) get(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	policy, err := h.passwordPolicyService.Get(ctx, session.OrganizationID)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, policy, nil)
+}
+
+// @Summary Update password policy.
+// @Tags organizations
+// @Consume application/json
+// @Description Update password policy of the current organization. Accounts in several organizations follow
+// @Description the strictest combination of their policies, new rules apply to the next password change.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param data body requests.PasswordPolicyRequest true "requests.PasswordPolicyRequest"
+// @Success 200 {object} response.Response{data=entities.PasswordPolicy}
+// @Router /api/password_policy [put].
+func (h *passwordPolicyHandler) update(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.PasswordPolicyRequest{}
+	if err := ctx.ShouldBind(req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	before, err := h.passwordPolicyService.Get(ctx, session.OrganizationID)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	middlewares.AuditBefore(ctx, before)
+
+	policy, err := h.passwordPolicyService.Update(ctx, session.OrganizationID, req)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, policy, nil)
+}
@@ -12,12 +12,15 @@ import (
 )
 
 type permissionHandler struct {
-	authorizationService *services.AuthorizationService
+	authorizationService      *services.AuthorizationService
+	permissionRegistryService *services.PermissionRegistryService
 }
 
-func NewPermissionHandler(authorizationService *services.AuthorizationService) *permissionHandler {
+func NewPermissionHandler(authorizationService *services.AuthorizationService,
+	permissionRegistryService *services.PermissionRegistryService) *permissionHandler {
 	return &permissionHandler{
-		authorizationService: authorizationService,
+		authorizationService:      authorizationService,
+		permissionRegistryService: permissionRegistryService,
 	}
 }
 
@@ -26,6 +29,8 @@ func (h *permissionHandler) Register(route *gin.RouterGroup) {
 	{
 		permissions.GET("", h.all)
 		permissions.POST("", h.create)
+		permissions.GET("coverage", h.coverage)
+		permissions.POST("sync", h.sync)
 		permission := permissions.Group(":id")
 		{
 			permission.GET("", h.get)
@@ -200,3 +205,47 @@ func (h *permissionHandler) delete(ctx *gin.Context) {
 
 	response.NoContent(ctx)
 }
+
+// @Summary Permission coverage.
+// @Tags permission
+// @Consume application/json
+// @Description Routes served without a permission check, protected routes no permission grants and permissions
+// @Description granting no route.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Success 200 {object} response.Response{data=entities.PermissionCoverage}
+// @Router /api/permissions/coverage [get].
+func (h *permissionHandler) coverage(ctx *gin.Context) {
+	coverage, err := h.permissionRegistryService.Coverage(ctx)
+	if err != nil {
+		response.ServerError(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, coverage, nil)
+}
+
+// @Summary Sync permissions.
+// @Tags permission
+// @Consume application/json
+// @Description Create permissions for protected routes no permission grants, admin roles get them. Orphaned
+// @Description permissions are listed, not deleted.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Success 200 {object} response.Response{data=entities.PermissionSync}
+// @Router /api/permissions/sync [post].
+func (h *permissionHandler) sync(ctx *gin.Context) {
+	result, err := h.permissionRegistryService.Sync(ctx)
+	if err != nil {
+		response.ServerError(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, result, nil)
+}
@@ -3,17 +3,18 @@ package handlers
 import (
 	"backoffice/internal/services"
 	"backoffice/internal/transport/http/response"
+	"errors"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
 
 type publicReportHandler struct {
-	spinService *services.SpinService
+	publicReportService *services.PublicReportService
 }
 
-func NewPublicReportHandler(spinService *services.SpinService) *publicReportHandler {
+func NewPublicReportHandler(publicReportService *services.PublicReportService) *publicReportHandler {
 	return &publicReportHandler{
-		spinService: spinService,
+		publicReportService: publicReportService,
 	}
 }
 
@@ -30,19 +31,27 @@ func (h *publicReportHandler) Register(router *gin.RouterGroup) {
 // @Summary Get public spin information.
 // @Tags reports
 // @Consume application/json
-// @Description For all users.
+// @Description For all users. The token of a public report link sets game and currency,
+// @Description without it the integrator must allow unsigned public reports.
 // @Accept  json
 // @Produce  json
 // @Param   id path   string true  "session_id"
+// @Param   token query string false "signed token of the link"
 // @Param   currency query string false "currency"
-// @Param   game query string true "game"
+// @Param   game query string false "game"
 // @Success 200  {object} response.Response{data=entities.GamingSession}
 // @Router /api/public_reports/sessions/{id} [get].
 func (h *publicReportHandler) session(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
 
-	gamingSession, err := h.spinService.GameSession(ctx, ctx.Query("game"), uuid.MustParse(ctx.Param("id")), ctx.Query("currency"))
+	gamingSession, err := h.publicReportService.Session(ctx, id, ctx.Query("game"), ctx.Query("currency"), ctx.Query("token"))
 	if err != nil {
-		response.BadRequest(ctx, err, nil)
+		h.error(ctx, err)
 
 		return
 	}
@@ -53,21 +62,33 @@ func (h *publicReportHandler) session(ctx *gin.Context) {
 // @Summary Get public spin.
 // @Tags spins
 // @Consume application/json
-// @Description Get spin information for all users.
+// @Description Get spin information for all users. The token of a public report link sets the currency,
+// @Description without it the integrator must allow unsigned public reports.
 // @Accept  json
 // @Produce  json
 // @Param   id path   string true  "spin_id"
+// @Param   token query string false "signed token of the link"
 // @Param   currency query string false "currency"
 // @Success 200  {object} response.Response{data=entities.Spin}
 // @Router /api/public_reports/spins/{id} [get].
 func (h *publicReportHandler) spin(ctx *gin.Context) {
-
-	spin, err := h.spinService.GetSpin(ctx, ctx.Param("id"), ctx.Query("currency"))
+	spin, err := h.publicReportService.Spin(ctx, ctx.Param("id"), ctx.Query("currency"), ctx.Query("token"))
 	if err != nil {
-		response.BadRequest(ctx, err, nil)
+		h.error(ctx, err)
 
 		return
 	}
 
 	response.OK(ctx, spin.Prettify(), nil)
 }
+
+func (h *publicReportHandler) error(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrPublicReportTokenInvalid), errors.Is(err, services.ErrPublicReportTokenExpired):
+		response.Unauthorized(ctx, err, nil)
+	case errors.Is(err, services.ErrPublicReportTokenRequired):
+		response.Forbidden(ctx, err, nil)
+	default:
+		response.BadRequest(ctx, err, nil)
+	}
+}
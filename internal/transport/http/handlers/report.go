@@ -2,27 +2,34 @@ package handlers
 
 import (
 	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
 	"backoffice/internal/services"
+	"backoffice/internal/transport/http/requests"
 	"backoffice/internal/transport/http/response"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 )
 
 type reportHandler struct {
-	spinService *services.SpinService
-	fileService *services.FileDownloadingService
+	spinService         *services.SpinService
+	fileService         *services.FileDownloadingService
+	publicReportService *services.PublicReportService
 }
 
 func NewReportHandler(spinService *services.SpinService,
 	fileService *services.FileDownloadingService,
+	publicReportService *services.PublicReportService,
 ) *reportHandler {
 	return &reportHandler{
-		spinService: spinService,
-		fileService: fileService,
+		spinService:         spinService,
+		fileService:         fileService,
+		publicReportService: publicReportService,
 	}
 }
 
@@ -32,6 +39,10 @@ func (h *reportHandler) Register(router *gin.RouterGroup) {
 	reports.GET("financial", h.financial)
 	reports.GET("financial/csv", h.financialCSV)
 	reports.GET("financial/xlsx", h.financialXLSX)
+	reports.GET("financial/series", h.financialSeries)
+	reports.GET("financial/consolidated", h.consolidatedFinancial)
+	reports.GET("financial/consolidated/csv", h.consolidatedFinancialCSV)
+	reports.GET("financial/consolidated/xlsx", h.consolidatedFinancialXLSX)
 
 	spins := reports.Group("spins")
 	spins.GET("", h.spins)
@@ -45,12 +56,15 @@ func (h *reportHandler) Register(router *gin.RouterGroup) {
 	sessions.GET("csv", h.sessionCSV)
 	sessions.GET("xlsx", h.sessionXLSX)
 
+	reports.POST("public_links", h.publicLink)
+
 	users := reports.Group("users")
 
 	users.GET(":id", h.user)
 
 	aggregated := reports.Group("aggregated")
 
+	aggregated.GET("series", h.aggregatedSeries)
 	aggregated.GET("by_game", h.aggregatedByGame)
 	aggregated.GET("by_game/:country", h.aggregatedByGamePerCountry)
 	aggregated.GET("by_game/csv", h.aggregatedByGameCSV)
@@ -91,7 +105,7 @@ func (h *reportHandler) financial(ctx *gin.Context) {
 		return
 	}
 
-	rep, err := h.spinService.FinancialReport(ctx, &session.OrganizationID, req)
+	rep, err := h.spinService.FinancialReport(ctx, session.ReportAccess(), req)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
 
@@ -138,7 +152,7 @@ func (h *reportHandler) spins(ctx *gin.Context) {
 	}
 
 	if len(groupBy) > 0 {
-		pagination, err := h.spinService.PaginateGrouped(ctx, &session.OrganizationID, &req.FinancialBase, req.Order, req.Limit, req.Page, groupBy)
+		pagination, err := h.spinService.PaginateGrouped(ctx, session.ReportAccess(), &req.FinancialBase, req.Order, req.Limit, req.Page, groupBy)
 		if err != nil {
 			response.BadRequest(ctx, err, nil)
 			return
@@ -150,7 +164,7 @@ func (h *reportHandler) spins(ctx *gin.Context) {
 
 		response.OK(ctx, pagination, nil)
 	} else {
-		pagination, err := h.spinService.Paginate(ctx, &session.OrganizationID, &req.FinancialBase, req.Order, req.Limit, req.Page)
+		pagination, err := h.spinService.Paginate(ctx, session.ReportAccess(), &req.FinancialBase, req.Order, req.Limit, req.Page)
 		if err != nil {
 			response.BadRequest(ctx, err, nil)
 			return
@@ -177,8 +191,16 @@ func (h *reportHandler) spins(ctx *gin.Context) {
 // @Success 200  {object} response.Response{data=entities.Spin}
 // @Router /api/reports/spins/{id} [get].
 func (h *reportHandler) spin(ctx *gin.Context) {
-	spin, err := h.spinService.GetSpin(ctx, ctx.Param("id"), ctx.Query("currency"))
+	session := ctx.Value("session").(*entities.Session)
+
+	spin, err := h.spinService.Spin(ctx, session.ReportAccess(), ctx.Param("id"), ctx.Query("currency"))
 	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
 		response.BadRequest(ctx, err, nil)
 
 		return
@@ -218,7 +240,7 @@ func (h *reportHandler) sessions(ctx *gin.Context) {
 	}
 
 	session := ctx.Value("session").(*entities.Session)
-	pagination, err := h.spinService.PaginateGamingSession(ctx, &session.OrganizationID, &req.FinancialBase, req.Order, req.Limit, req.Page)
+	pagination, err := h.spinService.PaginateGamingSession(ctx, session.ReportAccess(), &req.FinancialBase, req.Order, req.Limit, req.Page)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
 
@@ -246,7 +268,7 @@ func (h *reportHandler) sessions(ctx *gin.Context) {
 // @Router /api/reports/sessions/{id} [get].
 func (h *reportHandler) session(ctx *gin.Context) {
 	session := ctx.Value("session").(*entities.Session)
-	gamingSession, err := h.spinService.Session(ctx, &session.OrganizationID, uuid.MustParse(ctx.Param("id")), ctx.Query("currency"))
+	gamingSession, err := h.spinService.Session(ctx, session.ReportAccess(), uuid.MustParse(ctx.Param("id")), ctx.Query("currency"))
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
 
@@ -312,6 +334,8 @@ func (h *reportHandler) financialCSV(ctx *gin.Context) {
 // @Param   game query string false "game name"
 // @Param   starting_from query string false "time format: 2006-01-02 15:04:05"
 // @Param   ending_at query string false "time format: 2006-01-02 15:04:05"
+// @Param   granularity query string false "hour, day, week or month, adds a series sheet"
+// @Param   timezone query string false "IANA timezone series buckets are aligned to"
 // @Success 200  {object} response.Response{data=entities.FileReportResponse}
 // @Router /api/reports/financial/xlsx [get].
 func (h *reportHandler) financialXLSX(ctx *gin.Context) {
@@ -336,6 +360,166 @@ func (h *reportHandler) financialXLSX(ctx *gin.Context) {
 	}, nil)
 }
 
+// @Summary Get financial report time series.
+// @Tags reports
+// @Consume application/json
+// @Description Wager, award, revenue, RTP and unique users per bucket.
+// @Accept  json
+// @Produce  json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param   currency query string true "currency"
+// @Param   granularity query string true "hour, day, week or month"
+// @Param   timezone query string false "IANA timezone buckets are aligned to, UTC by default"
+// @Param   integrator query string false "integrator name"
+// @Param   operator query string false "operator name"
+// @Param   game query string false "game name"
+// @Param   starting_from query string false "time format: 2006-01-02 15:04:05-07:00"
+// @Param   ending_at query string false "time format: 2006-01-02 15:04:05-07:00"
+// @Success 200  {object} response.Response{data=[]entities.ReportSeriesItem}
+// @Router /api/reports/financial/series [get].
+func (h *reportHandler) financialSeries(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	req := &entities.FinancialBase{}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	series, err := h.spinService.FinancialSeries(ctx, session.ReportAccess(), req)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	lo.ForEach(series, func(item *entities.ReportSeriesItem, index int) {
+		item.Prettify()
+	})
+
+	response.OK(ctx, series, nil)
+}
+
+// @Summary Get consolidated multi-currency financial report.
+// @Tags reports
+// @Consume application/json
+// @Description Converts per-currency totals to the target currency and shows the rate used for every source currency.
+// @Accept  json
+// @Produce  json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param   target_currency query string true "currency all amounts are converted to"
+// @Param   rate_mode query string false "spin_time (default, at most 32 periods between rate changes) or snapshot"
+// @Param   rate_at query string false "snapshot time, defaults to now. time format: 2006-01-02 15:04:05-07:00"
+// @Param   integrator query string false "integrator name"
+// @Param   operator query string false "operator name"
+// @Param   game query string false "game name"
+// @Param   starting_from query string false "time format: 2006-01-02 15:04:05-07:00, required in spin_time mode"
+// @Param   ending_at query string false "time format: 2006-01-02 15:04:05-07:00"
+// @Success 200  {object} response.Response{data=entities.ConsolidatedFinancialReport}
+// @Router /api/reports/financial/consolidated [get].
+func (h *reportHandler) consolidatedFinancial(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	req := &entities.ConsolidatedFinancialFilters{}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	rep, err := h.spinService.ConsolidatedFinancialReport(ctx, session.ReportAccess(), req)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, rep.Prettify(), nil)
+}
+
+// @Summary Get consolidated multi-currency financial report csv.
+// @Tags reports
+// @Consume application/json
+// @Description Converts per-currency totals to the target currency and shows the rate used for every source currency.
+// @Accept  json
+// @Produce  json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param   target_currency query string true "currency all amounts are converted to"
+// @Param   rate_mode query string false "spin_time (default, at most 32 periods between rate changes) or snapshot"
+// @Param   rate_at query string false "snapshot time, defaults to now. time format: 2006-01-02 15:04:05-07:00"
+// @Param   integrator query string false "integrator name"
+// @Param   operator query string false "operator name"
+// @Param   game query string false "game name"
+// @Param   starting_from query string false "time format: 2006-01-02 15:04:05-07:00, required in spin_time mode"
+// @Param   ending_at query string false "time format: 2006-01-02 15:04:05-07:00"
+// @Success 200  {object} response.Response{data=entities.FileReportResponse}
+// @Router /api/reports/financial/consolidated/csv [get].
+func (h *reportHandler) consolidatedFinancialCSV(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	req := &entities.ConsolidatedFinancialFilters{}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	id, err := h.fileService.ConsolidatedFinancialCSV(ctx, session, req)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, entities.FileReportResponse{
+		ID: id,
+	}, nil)
+}
+
+// @Summary Get consolidated multi-currency financial report xlsx.
+// @Tags reports
+// @Consume application/json
+// @Description Converts per-currency totals to the target currency and shows the rate used for every source currency.
+// @Accept  json
+// @Produce  json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param   target_currency query string true "currency all amounts are converted to"
+// @Param   rate_mode query string false "spin_time (default, at most 32 periods between rate changes) or snapshot"
+// @Param   rate_at query string false "snapshot time, defaults to now. time format: 2006-01-02 15:04:05-07:00"
+// @Param   integrator query string false "integrator name"
+// @Param   operator query string false "operator name"
+// @Param   game query string false "game name"
+// @Param   starting_from query string false "time format: 2006-01-02 15:04:05-07:00, required in spin_time mode"
+// @Param   ending_at query string false "time format: 2006-01-02 15:04:05-07:00"
+// @Success 200  {object} response.Response{data=entities.FileReportResponse}
+// @Router /api/reports/financial/consolidated/xlsx [get].
+func (h *reportHandler) consolidatedFinancialXLSX(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	req := &entities.ConsolidatedFinancialFilters{}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	id, err := h.fileService.ConsolidatedFinancialXLSX(ctx, session, req)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, entities.FileReportResponse{
+		ID: id,
+	}, nil)
+}
+
 // @Summary Get spins report csv.
 // @Tags reports
 // @Consume text/csv
@@ -523,7 +707,7 @@ func (h *reportHandler) currencies(ctx *gin.Context) {
 		return
 	}
 
-	currencies, err := h.spinService.Currencies(ctx, &session.OrganizationID, req)
+	currencies, err := h.spinService.Currencies(ctx, session.ReportAccess(), req)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
 
@@ -533,6 +717,47 @@ func (h *reportHandler) currencies(ctx *gin.Context) {
 	response.OK(ctx, currencies, nil)
 }
 
+// @Summary Get aggregated report time series.
+// @Tags reports
+// @Consume application/json
+// @Description Wager, award, revenue, RTP and unique users per bucket.
+// @Accept  json
+// @Produce  application/json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param   currency query string true "currency name"
+// @Param   granularity query string true "hour, day, week or month"
+// @Param   timezone query string false "IANA timezone buckets are aligned to, UTC by default"
+// @Param   integrator query string false "integrator name"
+// @Param   operator query string false "operator name"
+// @Param   starting_from query string false "time format: 2006-01-02 15:04:05-00:00"
+// @Param   ending_at query string false "time format: 2006-01-02 15:04:05-00:00"
+// @Success 200  {object} response.Response{data=[]entities.ReportSeriesItem}
+// @Router /api/reports/aggregated/series [get].
+func (h *reportHandler) aggregatedSeries(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+
+	req := &entities.AggregateFilters{}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	series, err := h.spinService.AggregatedSeries(ctx, session.ReportAccess(), req)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	lo.ForEach(series, func(item *entities.ReportSeriesItem, index int) {
+		item.Prettify()
+	})
+
+	response.OK(ctx, series, nil)
+}
+
 // @Summary Get aggregated report.
 // @Tags reports
 // @Consume application/json
@@ -560,7 +785,7 @@ func (h *reportHandler) aggregatedByGame(ctx *gin.Context) {
 		return
 	}
 
-	aggregatedReps, err := h.spinService.AggregatedReportByGame(ctx, &session.OrganizationID, *req.Currency, nil, req)
+	aggregatedReps, err := h.spinService.AggregatedReportByGame(ctx, session.ReportAccess(), *req.Currency, nil, req)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
 
@@ -604,7 +829,7 @@ func (h *reportHandler) aggregatedByGamePerCountry(ctx *gin.Context) {
 
 	country := ctx.Param("country")
 
-	aggregatedReps, err := h.spinService.AggregatedReportByGame(ctx, &session.OrganizationID, *req.Currency, &country, req)
+	aggregatedReps, err := h.spinService.AggregatedReportByGame(ctx, session.ReportAccess(), *req.Currency, &country, req)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
 
@@ -631,6 +856,8 @@ func (h *reportHandler) aggregatedByGamePerCountry(ctx *gin.Context) {
 // @Param   game query string false "game name"
 // @Param   starting_from query string false "time format: 2006-01-02 15:04:05"
 // @Param   ending_at query string false "time format: 2006-01-02 15:04:05"
+// @Param   granularity query string false "hour, day, week or month, adds a series sheet"
+// @Param   timezone query string false "IANA timezone series buckets are aligned to"
 // @Success 200  {object} response.Response{data=entities.FileReportResponse}
 // @Router /api/reports/aggregated/by_game/xlsx [get].
 func (h *reportHandler) aggregatedByGameXLSX(ctx *gin.Context) {
@@ -719,7 +946,7 @@ func (h *reportHandler) aggregatedByCountry(ctx *gin.Context) {
 		return
 	}
 
-	aggregatedReps, err := h.spinService.AggregatedReportByCountry(ctx, &session.OrganizationID, *req.Currency, nil, req)
+	aggregatedReps, err := h.spinService.AggregatedReportByCountry(ctx, session.ReportAccess(), *req.Currency, nil, req)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
 
@@ -763,7 +990,7 @@ func (h *reportHandler) aggregatedByCountryPerGame(ctx *gin.Context) {
 
 	game := ctx.Param("game")
 
-	aggregatedReps, err := h.spinService.AggregatedReportByCountry(ctx, &session.OrganizationID, *req.Currency, &game, req)
+	aggregatedReps, err := h.spinService.AggregatedReportByCountry(ctx, session.ReportAccess(), *req.Currency, &game, req)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
 
@@ -790,6 +1017,8 @@ func (h *reportHandler) aggregatedByCountryPerGame(ctx *gin.Context) {
 // @Param   game query string false "game name"
 // @Param   starting_from query string false "time format: 2006-01-02 15:04:05"
 // @Param   ending_at query string false "time format: 2006-01-02 15:04:05"
+// @Param   granularity query string false "hour, day, week or month, adds a series sheet"
+// @Param   timezone query string false "IANA timezone series buckets are aligned to"
 // @Success 200  {object} response.Response{data=entities.FileReportResponse}
 // @Router /api/reports/aggregated/by_country/xlsx [get].
 func (h *reportHandler) aggregatedByCountryXLSX(ctx *gin.Context) {
@@ -872,7 +1101,7 @@ func (h *reportHandler) user(ctx *gin.Context) {
 
 	session := ctx.Value("session").(*entities.Session)
 
-	report, err := h.spinService.UserReport(ctx, &session.OrganizationID, id, currency)
+	report, err := h.spinService.UserReport(ctx, session.ReportAccess(), id, currency)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
 
@@ -881,3 +1110,39 @@ func (h *reportHandler) user(ctx *gin.Context) {
 
 	response.OK(ctx, report.Prettify(), nil)
 }
+
+// @Summary Create public report link.
+// @Tags reports
+// @Consume application/json
+// @Description Sign a link to the public report of a session or a spin, e.g. for the round history of a player.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param data body requests.PublicReportLinkRequest true "PublicReportLinkRequest"
+// @Success 200 {object} response.Response{data=entities.PublicReportLink}
+// @Router /api/reports/public_links [post].
+func (h *reportHandler) publicLink(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.PublicReportLinkRequest{}
+	if err := ctx.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	link, err := h.publicReportService.Link(ctx, session, req.Kind, req.ID, req.Currency, time.Duration(req.TTL)*time.Second)
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, link, nil)
+}
@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"backoffice/internal/entities"
+	e "backoffice/internal/errors"
+	"backoffice/internal/services"
+	"backoffice/internal/transport/http/middlewares"
+	"backoffice/internal/transport/http/requests"
+	"backoffice/internal/transport/http/response"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type reportScheduleHandler struct {
+	reportScheduleService *services.ReportScheduleService
+}
+
+func NewReportScheduleHandler(reportScheduleService *services.ReportScheduleService) *reportScheduleHandler {
+	return &reportScheduleHandler{reportScheduleService: reportScheduleService}
+}
+
+func (h *reportScheduleHandler) Register(router *gin.RouterGroup) {
+	schedules := router.Group("report_schedules")
+
+	schedules.GET("", h.all)
+	schedules.POST("", h.create)
+
+	schedule := schedules.Group(":id")
+	{
+		schedule.GET("", h.get)
+		schedule.PUT("", h.update)
+		schedule.DELETE("", h.delete)
+	}
+}
+
+// @Summary Get report schedules.
+// @Tags report_schedules
+// @Consume application/json
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param limit query int true "rows limit"
+// @Param page query int true "page"
+// @Success 200 {object} response.Response{data=entities.Pagination[entities.ReportSchedule]}
+// @Router /api/report_schedules [get].
+func (h *reportScheduleHandler) all(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.PaginateReportScheduleRequest{}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	paginate, err := h.reportScheduleService.Paginate(ctx, session.OrganizationID, req.Limit, req.Page)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, paginate, nil)
+}
+
+// @Summary Create report schedule.
+// @Tags report_schedules
+// @Consume application/json
+// @Description Payload is entities.FinancialBase for financial, spins and sessions reports
+// @Description and entities.AggregateFilters for aggregated ones.
+// @Description Period (previous_day, previous_week, previous_month, month_to_date) overrides payload dates on every run.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param data body requests.ReportScheduleRequest true "requests.ReportScheduleRequest"
+// @Success 200 {object} response.Response{data=entities.ReportSchedule}
+// @Router /api/report_schedules [post].
+func (h *reportScheduleHandler) create(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.ReportScheduleRequest{}
+	if err := ctx.ShouldBind(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	schedule, err := h.reportScheduleService.Create(ctx, session, req)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, schedule, nil)
+}
+
+// @Summary Get report schedule.
+// @Tags report_schedules
+// @Consume application/json
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "report_schedule_id"
+// @Success 200 {object} response.Response{data=entities.ReportSchedule}
+// @Router /api/report_schedules/{id} [get].
+func (h *reportScheduleHandler) get(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	scheduleID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	schedule, err := h.reportScheduleService.Get(ctx, session.OrganizationID, scheduleID)
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, schedule, nil)
+}
+
+// @Summary Update report schedule.
+// @Tags report_schedules
+// @Consume application/json
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "report_schedule_id"
+// @Param data body requests.ReportScheduleRequest true "requests.ReportScheduleRequest"
+// @Success 200 {object} response.Response{data=entities.ReportSchedule}
+// @Router /api/report_schedules/{id} [put].
+func (h *reportScheduleHandler) update(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.ReportScheduleRequest{}
+	if err := ctx.ShouldBind(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	scheduleID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	if before, err := h.reportScheduleService.Get(ctx, session.OrganizationID, scheduleID); err == nil {
+		middlewares.AuditBefore(ctx, before)
+	}
+
+	schedule, err := h.reportScheduleService.Update(ctx, session.OrganizationID, scheduleID, req)
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, schedule, nil)
+}
+
+// @Summary Delete report schedule.
+// @Tags report_schedules
+// @Consume application/json
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "report_schedule_id"
+// @Success 204
+// @Router /api/report_schedules/{id} [delete].
+func (h *reportScheduleHandler) delete(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	scheduleID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	if before, err := h.reportScheduleService.Get(ctx, session.OrganizationID, scheduleID); err == nil {
+		middlewares.AuditBefore(ctx, before)
+	}
+
+	if err = h.reportScheduleService.Delete(ctx, session.OrganizationID, scheduleID); err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.NoContent(ctx)
+}
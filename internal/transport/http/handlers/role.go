@@ -4,8 +4,10 @@ import (
 	"backoffice/internal/entities"
 	e "backoffice/internal/errors"
 	"backoffice/internal/services"
+	"backoffice/internal/transport/http/middlewares"
 	"backoffice/internal/transport/http/requests"
 	"backoffice/internal/transport/http/response"
+	"context"
 	"errors"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
@@ -15,12 +17,15 @@ import (
 type roleHandler struct {
 	authorizationService  *services.AuthorizationService
 	authenticationService *services.AuthenticationService
+	roleGrantService      *services.RoleGrantService
 }
 
-func NewRoleHandler(authorizationService *services.AuthorizationService, authenticationService *services.AuthenticationService) *roleHandler {
+func NewRoleHandler(authorizationService *services.AuthorizationService, authenticationService *services.AuthenticationService,
+	roleGrantService *services.RoleGrantService) *roleHandler {
 	return &roleHandler{
 		authorizationService:  authorizationService,
 		authenticationService: authenticationService,
+		roleGrantService:      roleGrantService,
 	}
 }
 
@@ -37,8 +42,16 @@ func (h *roleHandler) Register(route *gin.RouterGroup) {
 			role.DELETE("", h.delete)
 			role.POST("permissions", h.assignPermissions)
 			role.DELETE("permissions", h.revokePermissions)
+			role.PUT("report_scope", h.setReportScope)
+			role.GET("grants", h.grants)
 		}
 	}
+
+	grants := route.Group("role_grants")
+	{
+		grants.POST(":id/approve", h.approveGrant)
+		grants.POST(":id/reject", h.rejectGrant)
+	}
 }
 
 // @Summary Get roles list.
@@ -118,6 +131,8 @@ func (h *roleHandler) create(ctx *gin.Context) {
 // @Router /api/roles/{id} [delete].
 func (h *roleHandler) delete(ctx *gin.Context) {
 	session := ctx.Value("session").(*entities.Session)
+	h.auditRole(ctx)
+
 	err := h.authorizationService.DeleteRole(ctx, session.OrganizationID, ctx.Param("id"))
 	if err != nil {
 		if errors.Is(err, e.ErrEntityNotFound) {
@@ -156,6 +171,7 @@ func (h *roleHandler) assignPermissions(ctx *gin.Context) {
 	}
 
 	roleID := ctx.Param("id")
+	h.auditRole(ctx)
 
 	if err := h.authorizationService.AssignRolePermissions(ctx, session.OrganizationID, roleID, req.Permissions...); err != nil {
 		response.BadRequest(ctx, err, nil)
@@ -194,6 +210,7 @@ func (h *roleHandler) revokePermissions(ctx *gin.Context) {
 	}
 
 	roleID := ctx.Param("id")
+	h.auditRole(ctx)
 
 	if err := h.authorizationService.RevokeRolePermissions(ctx, session, roleID, req.Permissions...); err != nil {
 		response.BadRequest(ctx, err, nil)
@@ -273,6 +290,8 @@ func (h *roleHandler) update(ctx *gin.Context) {
 		return
 	}
 
+	h.auditRole(ctx)
+
 	role, err := h.authorizationService.UpdateRole(ctx, session, roleID, req.Name, req.Description, req.Type)
 	if err != nil {
 		if errors.Is(err, e.ErrEntityNotFound) {
@@ -288,3 +307,170 @@ func (h *roleHandler) update(ctx *gin.Context) {
 
 	response.OK(ctx, role, nil)
 }
+
+// @Summary Set report scope.
+// @Tags roles
+// @Consume application/json
+// @Description Limit reports of the role to games, integrators, operators, countries and currencies, empty lists are not limited.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "role_id"
+// @Param data body requests.RoleReportScopeRequest true "RoleReportScopeRequest"
+// @Success 200 {object} response.Response{data=entities.Role}
+// @Router /api/roles/{id}/report_scope [put].
+func (h *roleHandler) setReportScope(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.RoleReportScopeRequest{}
+	if err := ctx.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	roleID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	h.auditRole(ctx)
+
+	role, err := h.authorizationService.SetRoleReportScope(ctx, session, roleID, &entities.ReportScope{
+		Games:       req.Games,
+		Integrators: req.Integrators,
+		Operators:   req.Operators,
+		Countries:   req.Countries,
+		Currencies:  req.Currencies,
+	})
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	if errs := h.authenticationService.LogoutAllByRole(ctx, roleID.String()); errs != nil {
+		response.BadRequest(ctx, errs, nil)
+
+		return
+	}
+
+	response.OK(ctx, role, nil)
+}
+
+// @Summary Get role grants.
+// @Tags roles
+// @Consume application/json
+// @Description Pending, active and ended grants of the role made in the current organization.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "role_id"
+// @Param limit query int true "rows limit"
+// @Param page query int true "page"
+// @Param status query string false "pending, active, expired, rejected or revoked"
+// @Success 200 {object} response.Response{data=entities.Pagination[entities.RoleGrant]}
+// @Router /api/roles/{id}/grants [get].
+func (h *roleHandler) grants(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.RoleGrantsRequest{}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	roleID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	grants, err := h.roleGrantService.Role(ctx, session, roleID, req.Status, req.Limit, req.Page)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, grants, nil)
+}
+
+// @Summary Approve role grant.
+// @Tags roles
+// @Consume application/json
+// @Description Grant a pending role assignment, it must be approved by an administrator other than the requester.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "grant_id"
+// @Success 200 {object} response.Response{data=entities.RoleGrant}
+// @Router /api/role_grants/{id}/approve [post].
+func (h *roleHandler) approveGrant(ctx *gin.Context) {
+	h.decideGrant(ctx, h.roleGrantService.Approve)
+}
+
+// @Summary Reject role grant.
+// @Tags roles
+// @Consume application/json
+// @Description Reject a pending role assignment.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "grant_id"
+// @Success 200 {object} response.Response{data=entities.RoleGrant}
+// @Router /api/role_grants/{id}/reject [post].
+func (h *roleHandler) rejectGrant(ctx *gin.Context) {
+	h.decideGrant(ctx, h.roleGrantService.Reject)
+}
+
+func (h *roleHandler) decideGrant(ctx *gin.Context,
+	decide func(ctx context.Context, session *entities.Session, grantID uuid.UUID) (*entities.RoleGrant, error)) {
+	session := ctx.Value("session").(*entities.Session)
+
+	grantID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	grant, err := decide(ctx, session, grantID)
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, grant, nil)
+}
+
+// auditRole snapshots the role of the id param before it changes.
+func (h *roleHandler) auditRole(ctx *gin.Context) {
+	roleID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return
+	}
+
+	if before, err := h.authorizationService.GetRole(ctx, roleID); err == nil {
+		middlewares.AuditBefore(ctx, before)
+	}
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"backoffice/internal/entities"
+	"backoffice/internal/services"
+	"backoffice/internal/transport/http/requests"
+	"backoffice/internal/transport/http/response"
+	"github.com/gin-gonic/gin"
+)
+
+type securityEventHandler struct {
+	lockoutService *services.LockoutService
+}
+
+func NewSecurityEventHandler(lockoutService *services.LockoutService) *securityEventHandler {
+	return &securityEventHandler{lockoutService: lockoutService}
+}
+
+func (h *securityEventHandler) Register(router *gin.RouterGroup) {
+	events := router.Group("security_events")
+
+	events.GET("", h.all)
+}
+
+// @Summary Get security events.
+// @Tags audit
+// @Consume application/json
+// @Description Get paginated failed sign in attempts, lockouts and account locks. Non-root accounts only see their current organization.
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param limit query int true "rows limit"
+// @Param page query int true "page"
+// @Param type query string false "login_failed, totp_failed, webauthn_failed, locked_out, account_locked, account_unlocked, impersonation_started or impersonation_ended"
+// @Param login query string false "login"
+// @Param account_id query string false "account id"
+// @Param organization_id query string false "organization id"
+// @Param ip query string false "client address"
+// @Param from query string false "RFC3339 date from"
+// @Param to query string false "RFC3339 date to"
+// @Success 200 {object} response.Response{data=entities.Pagination[entities.SecurityEvent]}
+// @Router /api/security_events [get].
+func (h *securityEventHandler) all(ctx *gin.Context) {
+	session := ctx.Value("session").(*entities.Session)
+	req := &requests.SecurityEventRequest{}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	filters := &entities.SecurityEventFilters{
+		Type:           req.Type,
+		Login:          req.Login,
+		AccountID:      req.AccountID,
+		OrganizationID: req.OrganizationID,
+		IP:             req.IP,
+		From:           req.From,
+		To:             req.To,
+	}
+
+	if !session.Account.IsRoot() {
+		filters.OrganizationID = &session.OrganizationID
+	}
+
+	paginate, err := h.lockoutService.Events(ctx, filters, req.Limit, req.Page)
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, paginate, nil)
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"backoffice/internal/services"
+	"backoffice/internal/transport/http/response"
+	"backoffice/pkg/auth"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// ssoStateCookie binds the state of a login to the browser that started it.
+	ssoStateCookie = "sso_state"
+	// ssoStateCookieMaxAge is as long as the state is kept for the callback.
+	ssoStateCookieMaxAge = 10 * time.Minute
+)
+
+type ssoHandler struct {
+	ssoService *services.SSOService
+}
+
+func NewSSOHandler(ssoService *services.SSOService) *ssoHandler {
+	return &ssoHandler{
+		ssoService: ssoService,
+	}
+}
+
+func (h *ssoHandler) Register(route *gin.RouterGroup) {
+	oidc := route.Group("auth/oidc")
+	{
+		oidc.GET("login", h.login)
+		oidc.GET("callback", h.callback)
+	}
+}
+
+// @Summary Start single sign-on.
+// @Tags auth
+// @Description Redirects to the OpenID Connect identity provider and sets the sso_state cookie the callback checks.
+// @Success 302
+// @Router /api/auth/oidc/login [get].
+func (h *ssoHandler) login(ctx *gin.Context) {
+	u, state, err := h.ssoService.Login(ctx)
+	if err != nil {
+		if errors.Is(err, services.ErrSSODisabled) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		response.ServerError(ctx, err, nil)
+
+		return
+	}
+
+	setSSOStateCookie(ctx, state, int(ssoStateCookieMaxAge.Seconds()))
+	ctx.Redirect(http.StatusFound, u)
+}
+
+// @Summary Finish single sign-on.
+// @Tags auth
+// @Description Identity provider callback, issues tokens and redirects to the frontend with them
+// @Description in the url fragment, answers with json when no frontend url is configured. The state must match
+// @Description the sso_state cookie set by the login.
+// @Produce  json
+// @Param   state query string true "state"
+// @Param   code query string true "code"
+// @Success 200  {object} response.Response{data=auth.Auth}
+// @Success 302
+// @Router /api/auth/oidc/callback [get].
+func (h *ssoHandler) callback(ctx *gin.Context) {
+	if reason := ctx.Query("error"); reason != "" {
+		h.fail(ctx, http.StatusUnauthorized, errors.New(reason+": "+ctx.Query("error_description")))
+
+		return
+	}
+
+	state, code := ctx.Query("state"), ctx.Query("code")
+	if state == "" || code == "" {
+		h.fail(ctx, http.StatusBadRequest, services.ErrSSOCallbackParams)
+
+		return
+	}
+
+	// the state is used once, so is its cookie
+	bound, _ := ctx.Cookie(ssoStateCookie)
+	setSSOStateCookie(ctx, "", -1)
+
+	tokens, err := h.ssoService.Callback(ctx, state, bound, code, device(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrSSODisabled):
+			h.fail(ctx, http.StatusNotFound, err)
+		case errors.Is(err, services.ErrSSOStateInvalid), errors.Is(err, services.ErrSSOStateUnbound):
+			h.fail(ctx, http.StatusBadRequest, err)
+		default:
+			h.fail(ctx, http.StatusUnauthorized, err)
+		}
+
+		return
+	}
+
+	if h.ssoService.SuccessURL() == "" {
+		response.OK(ctx, tokens, nil)
+
+		return
+	}
+
+	ctx.Redirect(http.StatusFound, h.ssoService.SuccessURL()+"#"+fragment(tokens).Encode())
+}
+
+// fail hands the error over to the frontend when it is configured, the user agent is in a redirect
+// chain and would otherwise end up on a bare json page.
+func (h *ssoHandler) fail(ctx *gin.Context, status int, err error) {
+	if h.ssoService.SuccessURL() == "" {
+		response.Code(ctx, status, err, nil)
+
+		return
+	}
+
+	ctx.Redirect(http.StatusFound, h.ssoService.SuccessURL()+"#"+url.Values{"error": {err.Error()}}.Encode())
+}
+
+// setSSOStateCookie keeps the state away from scripts, it is sent along the top level redirect back from the
+// identity provider but not with requests other sites make.
+func setSSOStateCookie(ctx *gin.Context, state string, maxAge int) {
+	secure := ctx.Request.TLS != nil || ctx.GetHeader("X-Forwarded-Proto") == "https"
+
+	ctx.SetSameSite(http.SameSiteLaxMode)
+	ctx.SetCookie(ssoStateCookie, state, maxAge, "/", "", secure, true)
+}
+
+func fragment(tokens *auth.Auth) url.Values {
+	return url.Values{
+		"access_token":  {tokens.AccessToken},
+		"refresh_token": {tokens.RefreshToken},
+		"expired_at":    {tokens.ExpiredAt.Format(time.RFC3339)},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"backoffice/internal/entities"
 	e "backoffice/internal/errors"
 	"backoffice/internal/services"
+	"backoffice/internal/transport/http/middlewares"
 	"backoffice/internal/transport/http/requests"
 	"backoffice/internal/transport/http/response"
 	"errors"
@@ -12,11 +13,14 @@ import (
 )
 
 type wagerSetHandler struct {
-	wagerSetService *services.WagerSetService
+	wagerSetService          *services.WagerSetService
+	wagerSetGeneratorService *services.WagerSetGeneratorService
+	cfgSender                *services.ConfigSenderService
 }
 
-func NewWagerSetHandler(wagerSetService *services.WagerSetService) *wagerSetHandler {
-	return &wagerSetHandler{wagerSetService: wagerSetService}
+func NewWagerSetHandler(wagerSetService *services.WagerSetService, wagerSetGeneratorService *services.WagerSetGeneratorService,
+	cfgSender *services.ConfigSenderService) *wagerSetHandler {
+	return &wagerSetHandler{wagerSetService: wagerSetService, wagerSetGeneratorService: wagerSetGeneratorService, cfgSender: cfgSender}
 }
 
 func (h *wagerSetHandler) Register(router *gin.RouterGroup) {
@@ -30,6 +34,8 @@ func (h *wagerSetHandler) Register(router *gin.RouterGroup) {
 		wagerSet.GET("", h.get)
 		wagerSet.PUT("", h.update)
 		wagerSet.DELETE("", h.delete)
+		wagerSet.POST("currencies/preview", h.previewCurrencies)
+		wagerSet.POST("currencies", h.saveCurrencies)
 	}
 }
 
@@ -82,8 +88,14 @@ func (h *wagerSetHandler) create(ctx *gin.Context) {
 		return
 	}
 
-	ws, err := h.wagerSetService.Create(ctx, session.OrganizationID, req.Name, req.WagerLevels, req.DefaultWager)
+	ws, err := h.wagerSetService.Create(ctx, session.OrganizationID, req.Name, req.WagerLevels, req.DefaultWager, req.GameIDs)
 	if err != nil {
+		if wagerConstraintViolated(err) {
+			response.ValidationFailed(ctx, err)
+
+			return
+		}
+
 		response.BadRequest(ctx, err, nil)
 
 		return
@@ -118,8 +130,18 @@ func (h *wagerSetHandler) update(ctx *gin.Context) {
 		return
 	}
 
-	ws, err := h.wagerSetService.Update(ctx, wsID, req.Name, req.WagerLevels, req.DefaultWager, *req.IsActive)
+	if before, err := h.wagerSetService.Get(ctx, wsID); err == nil {
+		middlewares.AuditBefore(ctx, before)
+	}
+
+	ws, err := h.wagerSetService.Update(ctx, wsID, req.Name, req.WagerLevels, req.DefaultWager, *req.IsActive, req.GameIDs)
 	if err != nil {
+		if wagerConstraintViolated(err) {
+			response.ValidationFailed(ctx, err)
+
+			return
+		}
+
 		response.BadRequest(ctx, err, nil)
 
 		return
@@ -180,6 +202,10 @@ func (h *wagerSetHandler) delete(ctx *gin.Context) {
 		return
 	}
 
+	if before, err := h.wagerSetService.Get(ctx, wsID); err == nil {
+		middlewares.AuditBefore(ctx, before)
+	}
+
 	err = h.wagerSetService.Delete(ctx, wsID)
 	if err != nil {
 		response.BadRequest(ctx, err, nil)
@@ -189,3 +215,98 @@ func (h *wagerSetHandler) delete(ctx *gin.Context) {
 
 	response.NoContent(ctx)
 }
+
+// @Summary Preview wager sets generated for the currencies of an organization pair.
+// @Tags wager_set
+// @Consume application/json
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "base wager_set_id"
+// @Param data body requests.GenerateWagerSetsRequest true "requests.GenerateWagerSetsRequest"
+// @Success 200 {object} response.Response{data=entities.WagerSetGeneration}
+// @Router /api/wager_set/{id}/currencies/preview [post].
+func (h *wagerSetHandler) previewCurrencies(ctx *gin.Context) {
+	req := &requests.GenerateWagerSetsRequest{}
+	if err := ctx.ShouldBind(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	wsID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	generation, err := h.wagerSetGeneratorService.Preview(ctx, wsID, req.OrganizationPairID, req.GameIDs, req.Currencies)
+	if err != nil {
+		if errors.Is(err, e.ErrEntityNotFound) {
+			response.NotFound(ctx, err, nil)
+
+			return
+		}
+
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	response.OK(ctx, generation, nil)
+}
+
+// @Summary Save the approved preview of wager sets generated for the currencies of an organization pair.
+// @Tags wager_set
+// @Consume application/json
+// @Accept json
+// @Produce json
+// @Security X-Authenticate
+// @Param X-Auth header string true "Insert your access token"
+// @Param id path string true "base wager_set_id"
+// @Param data body requests.SaveGeneratedWagerSetsRequest true "requests.SaveGeneratedWagerSetsRequest"
+// @Success 200 {object} response.Response{data=entities.WagerSetGeneration}
+// @Router /api/wager_set/{id}/currencies [post].
+func (h *wagerSetHandler) saveCurrencies(ctx *gin.Context) {
+	req := &requests.SaveGeneratedWagerSetsRequest{}
+	if err := ctx.ShouldBind(&req); err != nil {
+		response.ValidationFailed(ctx, err)
+
+		return
+	}
+
+	wsID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.BadRequest(ctx, err, nil)
+
+		return
+	}
+
+	generation, err := h.wagerSetGeneratorService.Save(ctx, wsID, req.OrganizationPairID, req.GameIDs, req.Currencies, req.Checksum)
+	if err != nil {
+		switch {
+		case errors.Is(err, e.ErrEntityNotFound):
+			response.NotFound(ctx, err, nil)
+		case errors.Is(err, services.ErrWagerSetGenerationOutdated):
+			response.Conflict(ctx, err, nil)
+		default:
+			response.BadRequest(ctx, err, nil)
+		}
+
+		return
+	}
+
+	h.cfgSender.Notify(ctx)
+
+	response.OK(ctx, generation, nil)
+}
+
+// wagerConstraintViolated is true for wager sets breaking the wager constraints of a game, the broken limits
+// are reported as validation errors.
+func wagerConstraintViolated(err error) bool {
+	var constraintErr *services.WagerConstraintError
+
+	return errors.As(err, &constraintErr)
+}
@@ -0,0 +1,34 @@
+package middlewares
+
+import (
+	"backoffice/internal/services"
+	"backoffice/internal/transport/http/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+const APIKeyHeader = "X-Api-Key"
+
+// AuthenticateAPIKey authenticates service accounts by the X-Api-Key header,
+// requests without the header are passed to the fallback authentication.
+func AuthenticateAPIKey(apiKeyService *services.APIKeyService, fallback gin.HandlerFunc) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		token := ctx.GetHeader(APIKeyHeader)
+		if token == "" {
+			fallback(ctx)
+
+			return
+		}
+
+		session, err := apiKeyService.Authenticate(ctx, token, ctx.ClientIP())
+		if err != nil {
+			response.Unauthorized(ctx, err, nil)
+
+			return
+		}
+
+		ctx.Set("session_id", session.ID.String())
+		ctx.Set("session", session)
+		ctx.Next()
+	}
+}
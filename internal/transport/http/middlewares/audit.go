@@ -0,0 +1,120 @@
+package middlewares
+
+import (
+	"backoffice/internal/entities"
+	"backoffice/internal/services"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const (
+	auditBeforeKey       = "audit_before"
+	auditedKey           = "audited"
+	auditOmitResponseKey = "audit_omit_response"
+	auditMaxBodyLength   = 1 << 20
+)
+
+var auditMethods = []string{http.MethodPost, http.MethodPut, http.MethodDelete}
+
+type auditResponseWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *auditResponseWriter) Write(data []byte) (int, error) {
+	if strings.Contains(w.Header().Get("Content-Type"), "json") && w.body.Len()+len(data) <= auditMaxBodyLength {
+		w.body.Write(data)
+	}
+
+	return w.ResponseWriter.Write(data)
+}
+
+// AuditBefore snapshots the entity state before modification, handlers call it before applying changes.
+func AuditBefore(ctx *gin.Context, entity interface{}) {
+	data, err := json.Marshal(entity)
+	if err != nil {
+		zap.S().Error(err)
+
+		return
+	}
+
+	ctx.Set(auditBeforeKey, data)
+}
+
+// AuditOmitResponse keeps the response out of the audit record, handlers call it when the response carries a secret.
+func AuditOmitResponse(ctx *gin.Context) {
+	ctx.Set(auditOmitResponseKey, true)
+}
+
+func Audit(auditService *services.AuditService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !lo.Contains(auditMethods, ctx.Request.Method) {
+			ctx.Next()
+
+			return
+		}
+
+		var request []byte
+		if strings.Contains(ctx.ContentType(), "json") && ctx.Request.ContentLength >= 0 && ctx.Request.ContentLength <= auditMaxBodyLength {
+			request, _ = io.ReadAll(ctx.Request.Body)
+			ctx.Request.Body = io.NopCloser(bytes.NewReader(request))
+		}
+
+		writer := &auditResponseWriter{ResponseWriter: ctx.Writer, body: &bytes.Buffer{}}
+		ctx.Writer = writer
+		ctx.Set(auditedKey, true)
+
+		ctx.Next()
+
+		session, ok := ctx.Value("session").(*entities.Session)
+		if !ok {
+			return
+		}
+
+		before, _ := ctx.Value(auditBeforeKey).([]byte)
+
+		after := auditResponseData(writer.body.Bytes())
+		if ctx.GetBool(auditOmitResponseKey) {
+			after = nil
+		}
+
+		record := &services.AuditRecord{
+			Session:  session,
+			Endpoint: ctx.FullPath(),
+			Method:   ctx.Request.Method,
+			EntityID: ctx.Param("id"),
+			Status:   writer.Status(),
+			Request:  request,
+			Before:   before,
+			After:    after,
+		}
+
+		if err := auditService.Record(ctx, record); err != nil {
+			zap.S().Error(err)
+		}
+	}
+}
+
+func auditResponseData(body []byte) []byte {
+	if len(body) == 0 {
+		return nil
+	}
+
+	resp := struct {
+		Success bool            `json:"success"`
+		Data    json.RawMessage `json:"data"`
+	}{}
+
+	if err := json.Unmarshal(body, &resp); err != nil || !resp.Success {
+		return nil
+	}
+
+	return resp.Data
+}
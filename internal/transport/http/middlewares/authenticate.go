@@ -7,6 +7,7 @@ import (
 	"errors"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 )
 
 var (
@@ -27,6 +28,10 @@ func Authenticate(provider auth.Authorizer, sessionService *services.SessionServ
 			return
 		}
 
+		if err = sessionService.Touch(ctx, session, ctx.ClientIP()); err != nil {
+			zap.S().Warn(err)
+		}
+
 		ctx.Set("session_id", *jti)
 		ctx.Set("session", session)
 		ctx.Next()
@@ -4,6 +4,7 @@ import (
 	"backoffice/internal/entities"
 	"backoffice/internal/transport/http/response"
 	"github.com/gin-gonic/gin"
+	"reflect"
 )
 
 func Authorize() gin.HandlerFunc {
@@ -17,3 +18,16 @@ func Authorize() gin.HandlerFunc {
 		ctx.Next()
 	}
 }
+
+// Authorizes tells whether the handlers chain checks the permissions of the account.
+func Authorizes(chain gin.HandlersChain) bool {
+	authorize := reflect.ValueOf(Authorize()).Pointer()
+
+	for _, handler := range chain {
+		if reflect.ValueOf(handler).Pointer() == authorize {
+			return true
+		}
+	}
+
+	return false
+}
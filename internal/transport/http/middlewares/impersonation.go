@@ -0,0 +1,45 @@
+package middlewares
+
+import (
+	"backoffice/internal/entities"
+	"backoffice/internal/services"
+	"backoffice/internal/transport/http/response"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// Impersonation refuses changes of impersonated sessions outside of the writable endpoints and audits
+// every request they make, requests the audit middleware records are not recorded twice.
+func Impersonation(impersonationService *services.ImpersonationService, auditService *services.AuditService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		session, ok := ctx.Value("session").(*entities.Session)
+		if !ok || !session.Impersonated() {
+			ctx.Next()
+
+			return
+		}
+
+		if impersonationService.Allowed(ctx.Request.Method, ctx.FullPath()) {
+			ctx.Next()
+		} else {
+			response.Forbidden(ctx, services.ErrImpersonationReadOnly, "impersonation")
+		}
+
+		if ctx.GetBool(auditedKey) {
+			return
+		}
+
+		record := &services.AuditRecord{
+			Session:  session,
+			Endpoint: ctx.FullPath(),
+			Method:   ctx.Request.Method,
+			EntityID: ctx.Param("id"),
+			Status:   ctx.Writer.Status(),
+		}
+
+		if err := auditService.Record(ctx, record); err != nil {
+			zap.S().Error(err)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package middlewares
+
+import (
+	"backoffice/internal/entities"
+	"backoffice/internal/services"
+	"backoffice/internal/transport/http/requests"
+	"backoffice/internal/transport/http/response"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+var (
+	ErrNeedActivateTwoFactor = errors.New("You need activate two factor in settings")
+	ErrTOTPSecretRequired    = errors.New("The field TOTP secret is required")
+	ErrInvalidTOTPSecret     = errors.New("TOTP secret is invalid")
+	ErrInvalidSecurityKey    = errors.New("Security key assertion is invalid")
+)
+
+// SecondFactor lets the request through with a valid second factor of the account when the account has
+// one, required rejects accounts without a second factor.
+func SecondFactor(lockoutService *services.LockoutService, twoFactorService *services.TwoFactorService, required bool) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		session := ctx.Value("session").(*entities.Session)
+
+		if !session.Account.HasSecondFactor() {
+			if required {
+				response.BadRequest(ctx, ErrNeedActivateTwoFactor, nil)
+				return
+			}
+
+			ctx.Next()
+			return
+		}
+
+		if !VerifySecondFactor(ctx, lockoutService, twoFactorService, session.Account) {
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// VerifySecondFactor checks the authenticator code, recovery code or security key assertion of the request
+// body and answers the request when it is missing or invalid. A request without one gets the challenge of
+// the account, failed attempts count towards the lockout of the account login.
+func VerifySecondFactor(ctx *gin.Context, lockoutService *services.LockoutService, twoFactorService *services.TwoFactorService,
+	account *entities.Account) bool {
+	login := account.AuthProviderID
+	device := entities.Device{IP: ctx.ClientIP(), UserAgent: ctx.Request.UserAgent()}
+
+	req := &requests.SecondFactorRequest{}
+	if err := ctx.ShouldBindBodyWith(req, binding.JSON); err != nil || req.Empty() {
+		if !account.WebAuthnEnabled {
+			response.Unauthorized(ctx, ErrTOTPSecretRequired, "totp_required")
+			return false
+		}
+
+		challenge, err := twoFactorService.Challenge(ctx, account)
+		if err != nil {
+			response.ServerError(ctx, err, nil)
+			return false
+		}
+
+		response.Unauthorized(ctx, challenge, "second_factor_required")
+		return false
+	}
+
+	if retryAfter, err := lockoutService.Check(ctx, login, device.IP); err != nil {
+		if errors.Is(err, services.ErrTooManyAttempts) {
+			response.TooManyRequests(ctx, err, retryAfter)
+			return false
+		}
+
+		response.ServerError(ctx, err, nil)
+		return false
+	}
+
+	valid, err := twoFactorService.Verify(ctx, account, req)
+	if err != nil {
+		if req.WebAuthn != nil {
+			response.ServerError(ctx, err, nil)
+			return false
+		}
+
+		response.ValidationFailed(ctx, err)
+		return false
+	}
+
+	if !valid {
+		eventType, reason := entities.SecurityEventTOTPFailed, ErrInvalidTOTPSecret
+		if req.WebAuthn != nil {
+			eventType, reason = entities.SecurityEventWebAuthnFailed, ErrInvalidSecurityKey
+		}
+
+		if err = lockoutService.Failure(ctx, eventType, login, device); err != nil {
+			response.ServerError(ctx, err, nil)
+			return false
+		}
+
+		response.BadRequest(ctx, reason, nil)
+		return false
+	}
+
+	return true
+}
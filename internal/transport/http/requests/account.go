@@ -1,5 +1,7 @@
 package requests
 
+import "time"
+
 type CreateAccountRequest struct {
 	ID         string `json:"id" validate:"required"`
 	Token      string `json:"token" validate:"required"`
@@ -7,6 +9,8 @@ type CreateAccountRequest struct {
 	LastName   string `json:"last_name"`
 	RoleID     string `json:"role_id" validate:"required"`
 	OperatorID string `json:"operator_id"`
+	// RoleExpiresAt is the expiry of the role, required for role types granted only until an expiry.
+	RoleExpiresAt *time.Time `json:"role_expires_at"`
 }
 
 type UpdateAccountRequest struct {
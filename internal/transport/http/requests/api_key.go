@@ -0,0 +1,19 @@
+package requests
+
+type PaginateAPIKeyRequest struct {
+	Limit int `json:"limit" form:"limit" validate:"required"`
+	Page  int `json:"page" form:"page" validate:"required"`
+}
+
+type CreateAPIKeyRequest struct {
+	Name        string   `json:"name" validate:"required"`
+	Description string   `json:"description"`
+	RoleID      string   `json:"role_id" validate:"required"`
+	ExpiresAt   string   `json:"expires_at" validate:"required,custom_datetime"`
+	IPAllowlist []string `json:"ip_allowlist"`
+}
+
+type RotateAPIKeyRequest struct {
+	// ExpiresAt of the new secret, the previous expiry is kept when empty.
+	ExpiresAt string `json:"expires_at" validate:"custom_datetime"`
+}
@@ -0,0 +1,19 @@
+package requests
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type AuditRequest struct {
+	Limit          int        `json:"limit" form:"limit" validate:"required"`
+	Page           int        `json:"page" form:"page" validate:"required"`
+	AccountID      *uuid.UUID `json:"account_id" form:"account_id"`
+	OrganizationID *uuid.UUID `json:"organization_id" form:"organization_id"`
+	Endpoint       string     `json:"endpoint" form:"endpoint"`
+	Method         string     `json:"method" form:"method"`
+	EntityID       string     `json:"entity_id" form:"entity_id"`
+	ImpersonatorID *uuid.UUID `json:"impersonator_id" form:"impersonator_id"`
+	From           *time.Time `json:"from" form:"from"`
+	To             *time.Time `json:"to" form:"to"`
+}
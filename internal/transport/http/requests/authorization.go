@@ -1,6 +1,9 @@
 package requests
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"time"
+)
 
 type UpsertRoleRequest struct {
 	Name        string `json:"name" validate:"required"`
@@ -15,6 +18,14 @@ type AssignAccountRoleRequest struct {
 
 type AccountRoleRequest struct {
 	RoleID string `json:"role_id" validate:"required"`
+	// ExpiresAt revokes the role when it passes, the role is granted permanently without it.
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type RoleGrantsRequest struct {
+	Limit  int    `json:"limit" form:"limit" validate:"required"`
+	Page   int    `json:"page" form:"page" validate:"required"`
+	Status string `json:"status" form:"status" validate:"omitempty,oneof=pending active expired rejected revoked"`
 }
 
 type AccountOrganizationRequest struct {
@@ -29,6 +40,14 @@ type RolePermissionsRequest struct {
 	Permissions []string `json:"permissions" validate:"required,min=1"`
 }
 
+type RoleReportScopeRequest struct {
+	Games       []string `json:"games" validate:"omitempty,dive,required"`
+	Integrators []string `json:"integrators" validate:"omitempty,dive,required"`
+	Operators   []string `json:"operators" validate:"omitempty,dive,required"`
+	Countries   []string `json:"countries" validate:"omitempty,dive,required"`
+	Currencies  []string `json:"currencies" validate:"omitempty,dive,required"`
+}
+
 type AccountOperatorRequest struct {
 	IntegratorID uuid.UUID `json:"integrator_id" validate:"required"`
 	OperatorID   uuid.UUID `json:"operator_id" validate:"required"`
@@ -0,0 +1,36 @@
+package requests
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type PaginateCampaignRequest struct {
+	Limit        int        `json:"limit" form:"limit" validate:"required"`
+	Page         int        `json:"page" form:"page" validate:"required"`
+	Status       string     `json:"status" form:"status"`
+	IntegratorID *uuid.UUID `json:"integrator_id" form:"integrator_id"`
+}
+
+type CampaignRequest struct {
+	Name         string    `json:"name" form:"name" validate:"required"`
+	Description  string    `json:"description" form:"description"`
+	Type         string    `json:"type" form:"type"`
+	StartDate    time.Time `json:"start_date" form:"start_date" validate:"required"`
+	EndDate      time.Time `json:"end_date" form:"end_date" validate:"required"`
+	IntegratorID uuid.UUID `json:"integrator_id" form:"integrator_id" validate:"required"`
+	GameID       uuid.UUID `json:"game_id" form:"game_id" validate:"required"`
+	FSType       string    `json:"fs_type" form:"fs_type" validate:"required"`
+	Currencies   []string  `json:"currencies" form:"currencies" validate:"required"`
+	CoinSize     int       `json:"coin_size" form:"coin_size" validate:"required"`
+	Status       string    `json:"status" form:"status"`
+}
+
+type CampaignUser struct {
+	UserID   string `json:"user_id" validate:"required"`
+	Currency string `json:"currency" validate:"required"`
+}
+
+type AddCampaignUsersRequest struct {
+	Users []CampaignUser `json:"users" validate:"required"`
+}
@@ -26,8 +26,42 @@ type GameRequest struct {
 	OnlineVolatility     *bool          `json:"online_volatility" validate:"required"`
 	AvailableWagerSetsID pq.StringArray `json:"available_wager_sets_id" gorm:"type:uuid[]" swaggertype:"array,string" validate:"required"`
 	GambleDoubleUp       int64          `json:"gamble_double_up"`
+	// WagerConstraints replace the wager constraints of the game, they are kept as they are when it is left out.
+	WagerConstraints []*GameWagerConstraintRequest `json:"wager_constraints,omitempty" validate:"omitempty,dive,required"`
+}
+
+// GameWagerConstraintRequest limits the wager sets of the game in the currency, in every currency without
+// a constraint of its own when it is empty.
+type GameWagerConstraintRequest struct {
+	Currency         string `json:"currency"`
+	Divisor          *int64 `json:"divisor" validate:"omitempty,min=1"`
+	MinBet           *int64 `json:"min_bet" validate:"omitempty,min=1"`
+	MaxBet           *int64 `json:"max_bet" validate:"omitempty,min=1"`
+	MaxLevels        *int   `json:"max_levels" validate:"omitempty,min=1"`
+	MaxWinMultiplier *int64 `json:"max_win_multiplier" validate:"required_with=MaxExposure,omitempty,min=1"`
+	MaxExposure      *int64 `json:"max_exposure" validate:"omitempty,min=1"`
+}
+
+type GameWagerConstraintsRequest struct {
+	Constraints []*GameWagerConstraintRequest `json:"constraints" validate:"dive,required"`
 }
 
 type GameListRequest struct {
 	OrganizationID uuid.UUID `json:"organization_id"`
 }
+
+type GameCatalogRequest struct {
+	OrganizationID *uuid.UUID `json:"organization_id" form:"organization_id"`
+}
+
+type GameHistoryRequest struct {
+	Limit int `json:"limit" form:"limit" validate:"required"`
+	Page  int `json:"page" form:"page" validate:"required"`
+	// IntegratorID selects the history of the game settings of the integrator.
+	IntegratorID *uuid.UUID `json:"integrator_id" form:"integrator_id"`
+}
+
+type GameRollbackRequest struct {
+	Version      int        `json:"version" validate:"required,min=1"`
+	IntegratorID *uuid.UUID `json:"integrator_id"`
+}
@@ -0,0 +1,18 @@
+package requests
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type CreateInviteRequest struct {
+	Email     string      `json:"email" validate:"required,email"`
+	FirstName string      `json:"first_name" validate:"required"`
+	LastName  string      `json:"last_name"`
+	RoleIDs   []uuid.UUID `json:"role_ids" validate:"required,min=1"`
+	// OrganizationIDs are assigned next to the current organization and the organizations of the roles.
+	OrganizationIDs []uuid.UUID `json:"organization_ids"`
+	OperatorID      string      `json:"operator_id"`
+	// RoleExpiresAt is the expiry of the roles, required for role types granted only until an expiry.
+	RoleExpiresAt *time.Time `json:"role_expires_at"`
+}
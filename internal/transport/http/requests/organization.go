@@ -8,6 +8,10 @@ type UpsertOrganizationRequest struct {
 	Status int    `json:"status"`
 }
 
+type UnsignedPublicReportsRequest struct {
+	Allowed *bool `json:"allowed" validate:"required"`
+}
+
 type IntegratorGameRequest struct {
 	GameID     []uuid.UUID `json:"game_id" validate:"required"`
 	WagerSetID uuid.UUID   `json:"wager_set_id"`
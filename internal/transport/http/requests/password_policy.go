@@ -0,0 +1,12 @@
+package requests
+
+type PasswordPolicyRequest struct {
+	MinLength     int  `json:"min_length" validate:"gte=8,lte=128"`
+	RequireUpper  bool `json:"require_upper"`
+	RequireLower  bool `json:"require_lower"`
+	RequireDigit  bool `json:"require_digit"`
+	RequireSymbol bool `json:"require_symbol"`
+	CheckBreached bool `json:"check_breached"`
+	MaxAgeDays    int  `json:"max_age_days" validate:"gte=0,lte=3650"`
+	History       int  `json:"history" validate:"gte=0,lte=24"`
+}
@@ -0,0 +1,9 @@
+package requests
+
+type PublicReportLinkRequest struct {
+	Kind     string `json:"kind" validate:"required,oneof=session spin"`
+	ID       string `json:"id" validate:"required"`
+	Currency string `json:"currency"`
+	// TTL of the link in seconds, the configured default when empty.
+	TTL int `json:"ttl" validate:"omitempty,min=1"`
+}
@@ -0,0 +1,19 @@
+package requests
+
+import "encoding/json"
+
+type PaginateReportScheduleRequest struct {
+	Limit int `json:"limit" form:"limit" validate:"required"`
+	Page  int `json:"page" form:"page" validate:"required"`
+}
+
+type ReportScheduleRequest struct {
+	Name       string          `json:"name" validate:"required"`
+	ReportType string          `json:"report_type" validate:"required"`
+	Payload    json.RawMessage `json:"payload" validate:"required" swaggertype:"object"`
+	Period     string          `json:"period"`
+	Cron       string          `json:"cron" validate:"required"`
+	Timezone   string          `json:"timezone"`
+	Recipients []string        `json:"recipients" validate:"required"`
+	IsActive   *bool           `json:"is_active" validate:"required"`
+}
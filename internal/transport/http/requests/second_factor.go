@@ -0,0 +1,18 @@
+package requests
+
+import "backoffice/pkg/webauthn"
+
+// SecondFactorRequest carries an authenticator code, a recovery code or a security key assertion.
+type SecondFactorRequest struct {
+	TOTP     string                      `json:"totp"`
+	WebAuthn *webauthn.AssertionResponse `json:"webauthn"`
+}
+
+func (r *SecondFactorRequest) Empty() bool {
+	return r.TOTP == "" && r.WebAuthn == nil
+}
+
+type RegisterWebAuthnRequest struct {
+	Name       string                        `json:"name"`
+	Credential *webauthn.AttestationResponse `json:"credential" validate:"required"`
+}
@@ -0,0 +1,18 @@
+package requests
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type SecurityEventRequest struct {
+	Limit          int        `json:"limit" form:"limit" validate:"required"`
+	Page           int        `json:"page" form:"page" validate:"required"`
+	Type           string     `json:"type" form:"type"`
+	Login          string     `json:"login" form:"login"`
+	AccountID      *uuid.UUID `json:"account_id" form:"account_id"`
+	OrganizationID *uuid.UUID `json:"organization_id" form:"organization_id"`
+	IP             string     `json:"ip" form:"ip"`
+	From           *time.Time `json:"from" form:"from"`
+	To             *time.Time `json:"to" form:"to"`
+}
@@ -1,5 +1,7 @@
 package requests
 
+import "github.com/google/uuid"
+
 type PaginateWagerSetRequest struct {
 	Limit int `json:"limit" form:"limit" validate:"required"`
 	Page  int `json:"page" form:"page" validate:"required"`
@@ -9,6 +11,8 @@ type CreateWagerSetRequest struct {
 	Name         string  `json:"name" form:"name" validate:"required"`
 	WagerLevels  []int64 `json:"wager_levels" form:"wager_levels" validate:"required"`
 	DefaultWager int64   `json:"default_wager" form:"default_wager" validate:"required"`
+	// GameIDs are games the wager set is checked against besides the games already using it.
+	GameIDs []uuid.UUID `json:"game_ids" form:"game_ids" validate:"omitempty,dive,required"`
 }
 
 type UpdateWagerSetRequest struct {
@@ -16,4 +20,19 @@ type UpdateWagerSetRequest struct {
 	WagerLevels  []int64 `json:"wager_levels" form:"wager_levels" validate:"required"`
 	DefaultWager int64   `json:"default_wager" form:"default_wager" validate:"required"`
 	IsActive     *bool   `json:"is_active" form:"is_active" validate:"required"`
+	// GameIDs are games the wager set is checked against besides the games already using it.
+	GameIDs []uuid.UUID `json:"game_ids" form:"game_ids" validate:"omitempty,dive,required"`
+}
+
+type GenerateWagerSetsRequest struct {
+	OrganizationPairID uuid.UUID   `json:"organization_pair_id" validate:"required"`
+	GameIDs            []uuid.UUID `json:"game_ids" validate:"required,min=1,dive,required"`
+	// Currencies limit the generation to some currencies of the pair, every currency is generated when empty.
+	Currencies []string `json:"currencies" validate:"omitempty,dive,required"`
+}
+
+type SaveGeneratedWagerSetsRequest struct {
+	GenerateWagerSetsRequest
+	// Checksum of the approved preview.
+	Checksum string `json:"checksum" validate:"required"`
 }
@@ -7,8 +7,11 @@ import (
 	"fmt"
 	"github.com/samber/lo"
 	"github.com/xuri/excelize/v2"
+	"io"
+	"math"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -76,6 +79,14 @@ func XLSX(ctx *gin.Context, file []byte, filename string) {
 	ctx.Data(http.StatusOK, "application/octet-stream", file)
 }
 
+// Stream copies the reader into the response without buffering the whole file in memory.
+func Stream(ctx *gin.Context, reader io.Reader, contentType, filename string) {
+	ctx.DataFromReader(http.StatusOK, -1, contentType, reader, map[string]string{
+		"Content-Description": "File Transfer",
+		"Content-Disposition": fmt.Sprintf(`attachment; filename="%v"`, filename),
+	})
+}
+
 func XLSXFile(c *gin.Context, file *excelize.File, fileName string) {
 	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", fileName))
 	c.Header("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
@@ -125,6 +136,16 @@ func Code(ctx *gin.Context, code int, data interface{}, meta interface{}) {
 	ctx.AbortWithStatusJSON(r.Status, r)
 }
 
+// TooManyRequests tells the client when to retry through the Retry-After header.
+func TooManyRequests(ctx *gin.Context, data interface{}, retryAfter time.Duration) {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+
+	zap.S().Warn(data)
+	ctx.Header("Retry-After", strconv.Itoa(seconds))
+	r := new(http.StatusTooManyRequests, map[string]interface{}{"retry_after": seconds}, data)
+	ctx.AbortWithStatusJSON(r.Status, r)
+}
+
 func ValidationFailed(ctx *gin.Context, err error) {
 	data := make([]string, 0)
 
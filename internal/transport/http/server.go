@@ -2,6 +2,7 @@ package http
 
 import (
 	"backoffice/docs"
+	"backoffice/internal/entities"
 	"backoffice/internal/transport/http/middlewares"
 	"context"
 	"fmt"
@@ -19,11 +20,17 @@ type Server struct {
 	ctx    context.Context
 	server *http.Server
 	router *gin.Engine
+	// protected routes are keyed by method and path.
+	protected map[string]bool
 }
 
 // @SecurityDefinitions.apikey X-Authenticate
 // @in header
 // @name X-Authenticate
+
+// @SecurityDefinitions.apikey X-Api-Key
+// @in header
+// @name X-Api-Key
 func New(ctx context.Context, wg *sync.WaitGroup, cfg *Config, handlers []Handler) *Server {
 	docs.SwaggerInfo.Title = "Backoffice API"
 	docs.SwaggerInfo.Description = "Backoffice API server."
@@ -41,7 +48,13 @@ func New(ctx context.Context, wg *sync.WaitGroup, cfg *Config, handlers []Handle
 			WriteTimeout:      cfg.WriteTimeout,
 			IdleTimeout:       30 * time.Second,
 		},
-		router: gin.New(),
+		router:    gin.New(),
+		protected: map[string]bool{},
+	}
+
+	// api key allowlists and login lockouts check the client ip, so it is taken only from trusted proxies
+	if err := s.router.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		zap.S().Fatal(err)
 	}
 
 	s.router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -54,13 +67,43 @@ func New(ctx context.Context, wg *sync.WaitGroup, cfg *Config, handlers []Handle
 }
 
 func (s *Server) registerHandlers(api *gin.RouterGroup, handlers ...Handler) {
+	s.markRoutes(false)
+
 	for _, h := range handlers {
+		// routes get the middlewares the group has when they are registered
+		protected := middlewares.Authorizes(api.Handlers)
+
 		h.Register(api)
+		s.markRoutes(protected)
 	}
 
 	s.server.Handler = s.router
 }
 
+// Routes lists the routes of the server, protected routes check the permissions of the account.
+func (s *Server) Routes() []entities.Route {
+	routes := make([]entities.Route, 0, len(s.protected))
+
+	for _, route := range s.router.Routes() {
+		routes = append(routes, entities.Route{
+			Method:    route.Method,
+			Endpoint:  route.Path,
+			Protected: s.protected[route.Method+route.Path],
+		})
+	}
+
+	return routes
+}
+
+// markRoutes records whether the routes registered since the last call are protected.
+func (s *Server) markRoutes(protected bool) {
+	for _, route := range s.router.Routes() {
+		if _, ok := s.protected[route.Method+route.Path]; !ok {
+			s.protected[route.Method+route.Path] = protected
+		}
+	}
+}
+
 func (s *Server) Run() {
 	s.wg.Add(1)
 	zap.S().Infof("server listining: %s", s.server.Addr)
@@ -0,0 +1,81 @@
+-- +goose Up
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."fs_campaigns";
+CREATE TABLE "public"."fs_campaigns" (
+                                         "created_at" timestamptz(6) DEFAULT now(),
+                                         "updated_at" timestamptz(6),
+                                         "deleted_at" timestamptz(6),
+                                         "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+                                         "created_by" uuid NOT NULL,
+                                         "name" varchar(255) NOT NULL,
+                                         "description" text,
+                                         "start_date" timestamptz(6) NOT NULL,
+                                         "end_date" timestamptz(6) NOT NULL,
+                                         "type" varchar(40),
+                                         "integrator_id" uuid NOT NULL,
+                                         "game_id" uuid NOT NULL,
+                                         "token" varchar(255),
+                                         "fs_type" varchar(40) NOT NULL,
+                                         "currencies" varchar[] NOT NULL DEFAULT '{}',
+                                         "status" varchar(40) NOT NULL DEFAULT 'draft',
+                                         "coin_size" int4 NOT NULL
+)
+;
+
+DROP TABLE IF EXISTS "public"."fs_users";
+CREATE TABLE "public"."fs_users" (
+                                     "created_at" timestamptz(6) DEFAULT now(),
+                                     "updated_at" timestamptz(6),
+                                     "deleted_at" timestamptz(6),
+                                     "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+                                     "fs_campaign_id" uuid NOT NULL,
+                                     "user_id" varchar(255) NOT NULL,
+                                     "currency" varchar(5) NOT NULL
+)
+;
+
+-- ----------------------------
+-- Primary Key structure for tables fs_campaigns and fs_users
+-- ----------------------------
+ALTER TABLE "public"."fs_campaigns" ADD CONSTRAINT "fs_campaigns_pkey" PRIMARY KEY ("id");
+ALTER TABLE "public"."fs_users" ADD CONSTRAINT "fs_users_pkey" PRIMARY KEY ("id");
+ALTER TABLE "public"."fs_users" ADD CONSTRAINT "fs_users_fs_campaign_id_user_id_key" UNIQUE ("fs_campaign_id", "user_id");
+
+-- ----------------------------
+-- Foreign Keys structure for tables fs_campaigns and fs_users
+-- ----------------------------
+ALTER TABLE "public"."fs_campaigns"
+    ADD CONSTRAINT "fs_campaigns_created_by_fkey" FOREIGN KEY ("created_by") REFERENCES "public"."accounts" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION,
+    ADD CONSTRAINT "fs_campaigns_integrator_id_fkey" FOREIGN KEY ("integrator_id") REFERENCES "public"."organizations" ("id") ON DELETE CASCADE ON UPDATE NO ACTION,
+    ADD CONSTRAINT "fs_campaigns_game_id_fkey" FOREIGN KEY ("game_id") REFERENCES "public"."games" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
+ALTER TABLE "public"."fs_users"
+    ADD CONSTRAINT "fs_users_fs_campaign_id_fkey" FOREIGN KEY ("fs_campaign_id") REFERENCES "public"."fs_campaigns" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Get free spin campaigns', 'Get free spin campaigns', 'backoffice', '/campaigns', 'VIEW'),
+       ('Create free spin campaign', 'Create free spin campaign', 'backoffice', '/campaigns', 'CREATE'),
+       ('Get free spin campaign', 'Get free spin campaign', 'backoffice', '/campaigns/:id', 'VIEW'),
+       ('Update free spin campaign', 'Update free spin campaign', 'backoffice', '/campaigns/:id', 'EDIT'),
+       ('Delete free spin campaign', 'Delete free spin campaign', 'backoffice', '/campaigns/:id', 'DELETE'),
+       ('Get free spin campaign users', 'Get free spin campaign users', 'backoffice', '/campaigns/:id/users', 'VIEW'),
+       ('Add free spin campaign users', 'Add free spin campaign users', 'backoffice', '/campaigns/:id/users', 'CREATE'),
+       ('Delete free spin campaign user', 'Delete free spin campaign user', 'backoffice', '/campaigns/:id/users/:user_id', 'DELETE') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."fs_users";
+DROP TABLE IF EXISTS "public"."fs_campaigns";
+delete from permissions where endpoint like '/campaigns%';
+call refresh_admin_permissions();
+-- +goose StatementEnd
@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."audit_log";
+CREATE TABLE "public"."audit_log" (
+                                      "created_at" timestamptz(6) DEFAULT now(),
+                                      "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+                                      "account_id" uuid NOT NULL,
+                                      "organization_id" uuid NOT NULL,
+                                      "endpoint" varchar(255) NOT NULL,
+                                      "method" varchar(10) NOT NULL,
+                                      "entity_id" varchar(255),
+                                      "status" int4,
+                                      "request" jsonb,
+                                      "before" jsonb,
+                                      "after" jsonb,
+                                      "diff" jsonb
+)
+;
+
+ALTER TABLE "public"."audit_log" ADD CONSTRAINT "audit_log_pkey" PRIMARY KEY ("id");
+
+CREATE INDEX "audit_log_created_at_idx" ON "public"."audit_log" ("created_at");
+CREATE INDEX "audit_log_account_id_idx" ON "public"."audit_log" ("account_id");
+CREATE INDEX "audit_log_organization_id_idx" ON "public"."audit_log" ("organization_id");
+CREATE INDEX "audit_log_entity_id_idx" ON "public"."audit_log" ("entity_id");
+
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Get audit log', 'Get audit log', 'backoffice', '/audit', 'VIEW') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."audit_log";
+delete from permissions where endpoint = '/audit';
+call refresh_admin_permissions();
+-- +goose StatementEnd
@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."report_schedules";
+CREATE TABLE "public"."report_schedules" (
+                                             "created_at" timestamptz(6) DEFAULT now(),
+                                             "updated_at" timestamptz(6),
+                                             "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+                                             "organization_id" uuid NOT NULL,
+                                             "created_by" uuid NOT NULL,
+                                             "name" varchar(255) NOT NULL,
+                                             "report_type" varchar(40) NOT NULL,
+                                             "payload" jsonb NOT NULL,
+                                             "period" varchar(40),
+                                             "cron" varchar(100) NOT NULL,
+                                             "timezone" varchar(64) NOT NULL DEFAULT 'UTC',
+                                             "recipients" varchar[] NOT NULL,
+                                             "is_active" bool DEFAULT true,
+                                             "next_run_at" timestamptz(6),
+                                             "last_run_at" timestamptz(6),
+                                             "last_error" text
+)
+;
+
+ALTER TABLE "public"."report_schedules" ADD CONSTRAINT "report_schedules_pkey" PRIMARY KEY ("id");
+
+CREATE INDEX "report_schedules_next_run_at_idx" ON "public"."report_schedules" ("next_run_at") WHERE "is_active";
+
+ALTER TABLE "public"."report_schedules"
+    ADD CONSTRAINT "report_schedules_organization_id_fkey" FOREIGN KEY ("organization_id") REFERENCES "public"."organizations" ("id") ON DELETE CASCADE ON UPDATE NO ACTION,
+    ADD CONSTRAINT "report_schedules_created_by_fkey" FOREIGN KEY ("created_by") REFERENCES "public"."accounts" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Get report schedules', 'Get report schedules', 'backoffice', '/report_schedules', 'VIEW'),
+       ('Create report schedule', 'Create report schedule', 'backoffice', '/report_schedules', 'CREATE'),
+       ('Get report schedule', 'Get report schedule', 'backoffice', '/report_schedules/:id', 'VIEW'),
+       ('Update report schedule', 'Update report schedule', 'backoffice', '/report_schedules/:id', 'EDIT'),
+       ('Delete report schedule', 'Delete report schedule', 'backoffice', '/report_schedules/:id', 'DELETE') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."report_schedules";
+delete from permissions where endpoint like '/report_schedules%';
+call refresh_admin_permissions();
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Consolidated financial', 'Get consolidated multi-currency financial report', 'backoffice', '/reports/financial/consolidated', 'VIEW'),
+       ('Consolidated financial CSV', 'Get consolidated multi-currency financial report in csv', 'backoffice', '/reports/financial/consolidated/csv', 'VIEW'),
+       ('Consolidated financial XLSX', 'Get consolidated multi-currency financial report in xlsx', 'backoffice', '/reports/financial/consolidated/xlsx', 'VIEW') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from permissions where endpoint like '/reports/financial/consolidated%';
+call refresh_admin_permissions();
+-- +goose StatementEnd
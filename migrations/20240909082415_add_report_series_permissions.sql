@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Financial series', 'Get financial report time series', 'backoffice', '/reports/financial/series', 'VIEW'),
+       ('Aggregated series', 'Get aggregated report time series', 'backoffice', '/reports/aggregated/series', 'VIEW') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from permissions where endpoint in ('/reports/financial/series', '/reports/aggregated/series');
+call refresh_admin_permissions();
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Ws files', 'Follow status of all session files over websocket', 'backoffice', '/files/ws', 'VIEW'),
+       ('File events', 'Follow status of all session files over server-sent events', 'backoffice', '/files/events', 'VIEW'),
+       ('File event', 'Follow file status over server-sent events', 'backoffice', '/files/events/:id', 'VIEW') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from permissions where endpoint in ('/files/ws', '/files/events', '/files/events/:id');
+call refresh_admin_permissions();
+-- +goose StatementEnd
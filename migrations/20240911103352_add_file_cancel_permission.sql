@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Cancel file', 'Cancel queued or running file generation', 'backoffice', '/files/:id', 'DELETE') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from permissions where name = 'Cancel file';
+call refresh_admin_permissions();
+-- +goose StatementEnd
@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."api_keys";
+CREATE TABLE "public"."api_keys" (
+                                     "created_at" timestamptz(6) DEFAULT now(),
+                                     "updated_at" timestamptz(6),
+                                     "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+                                     "organization_id" uuid NOT NULL,
+                                     "created_by" uuid NOT NULL,
+                                     "name" varchar(255) NOT NULL,
+                                     "description" text,
+                                     "role_id" uuid NOT NULL,
+                                     "prefix" varchar(32) NOT NULL,
+                                     "hash" varchar(64) NOT NULL,
+                                     "ip_allowlist" varchar[],
+                                     "expires_at" timestamptz(6) NOT NULL,
+                                     "last_used_at" timestamptz(6),
+                                     "revoked_at" timestamptz(6)
+)
+;
+
+ALTER TABLE "public"."api_keys" ADD CONSTRAINT "api_keys_pkey" PRIMARY KEY ("id");
+
+CREATE UNIQUE INDEX "api_keys_prefix_idx" ON "public"."api_keys" ("prefix");
+CREATE INDEX "api_keys_organization_id_idx" ON "public"."api_keys" ("organization_id");
+
+ALTER TABLE "public"."api_keys"
+    ADD CONSTRAINT "api_keys_organization_id_fkey" FOREIGN KEY ("organization_id") REFERENCES "public"."organizations" ("id") ON DELETE CASCADE ON UPDATE NO ACTION,
+    ADD CONSTRAINT "api_keys_role_id_fkey" FOREIGN KEY ("role_id") REFERENCES "public"."roles" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Get api keys', 'Get service account api keys', 'backoffice', '/api_keys', 'VIEW'),
+       ('Create api key', 'Create service account api key', 'backoffice', '/api_keys', 'CREATE'),
+       ('Rotate api key', 'Rotate service account api key', 'backoffice', '/api_keys/:id/rotate', 'CREATE'),
+       ('Revoke api key', 'Revoke service account api key', 'backoffice', '/api_keys/:id', 'DELETE') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."api_keys";
+delete from permissions where endpoint like '/api_keys%';
+call refresh_admin_permissions();
+-- +goose StatementEnd
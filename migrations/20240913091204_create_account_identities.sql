@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."account_identities";
+CREATE TABLE "public"."account_identities" (
+                                     "created_at" timestamptz(6) DEFAULT now(),
+                                     "updated_at" timestamptz(6),
+                                     "issuer" varchar(255) NOT NULL,
+                                     "subject" varchar(255) NOT NULL,
+                                     "account_id" uuid NOT NULL,
+                                     "email" varchar(255),
+                                     "last_login_at" timestamptz(6)
+)
+;
+
+ALTER TABLE "public"."account_identities" ADD CONSTRAINT "account_identities_pkey" PRIMARY KEY ("issuer", "subject");
+
+CREATE INDEX "account_identities_account_id_idx" ON "public"."account_identities" ("account_id");
+
+ALTER TABLE "public"."account_identities"
+    ADD CONSTRAINT "account_identities_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."account_identities";
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."tokens"
+    ADD COLUMN "ip" varchar(64),
+    ADD COLUMN "user_agent" text;
+
+CREATE INDEX IF NOT EXISTS "tokens_account_id_idx" ON "public"."tokens" ("account_id");
+
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Get account sessions', 'Get active sessions of account', 'backoffice', '/accounts/:id/sessions', 'VIEW'),
+       ('Revoke account sessions', 'Revoke all sessions of account', 'backoffice', '/accounts/:id/sessions', 'DELETE'),
+       ('Revoke account session', 'Revoke session of account', 'backoffice', '/accounts/:id/sessions/:session_id', 'DELETE') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."tokens"
+    DROP COLUMN IF EXISTS "ip",
+    DROP COLUMN IF EXISTS "user_agent";
+
+DROP INDEX IF EXISTS "tokens_account_id_idx";
+
+delete from permissions where endpoint like '/accounts/:id/sessions%';
+call refresh_admin_permissions();
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."security_events";
+CREATE TABLE "public"."security_events" (
+                                            "created_at" timestamptz(6) DEFAULT now(),
+                                            "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+                                            "type" varchar(32) NOT NULL,
+                                            "login" varchar(255),
+                                            "account_id" uuid,
+                                            "organization_id" uuid,
+                                            "actor_id" uuid,
+                                            "ip" varchar(64),
+                                            "user_agent" text,
+                                            "failures" int8 NOT NULL DEFAULT 0
+)
+;
+
+ALTER TABLE "public"."security_events" ADD CONSTRAINT "security_events_pkey" PRIMARY KEY ("id");
+
+CREATE INDEX "security_events_created_at_idx" ON "public"."security_events" ("created_at");
+CREATE INDEX "security_events_login_idx" ON "public"."security_events" ("login");
+CREATE INDEX "security_events_account_id_idx" ON "public"."security_events" ("account_id");
+CREATE INDEX "security_events_organization_id_idx" ON "public"."security_events" ("organization_id");
+CREATE INDEX "security_events_ip_idx" ON "public"."security_events" ("ip");
+
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Get security events', 'Get failed sign in attempts and account locks', 'backoffice', '/security_events', 'VIEW'),
+       ('Unlock account', 'Unlock account locked after failed sign in attempts', 'backoffice', '/accounts/:id/unlock', 'CREATE') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."security_events";
+
+delete from permissions where endpoint in ('/security_events', '/accounts/:id/unlock');
+call refresh_admin_permissions();
+-- +goose StatementEnd
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."account_recovery_codes";
+CREATE TABLE "public"."account_recovery_codes" (
+                                                   "created_at" timestamptz(6) DEFAULT now(),
+                                                   "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+                                                   "account_id" uuid NOT NULL,
+                                                   "hash" varchar(64) NOT NULL,
+                                                   "used_at" timestamptz(6)
+)
+;
+
+ALTER TABLE "public"."account_recovery_codes" ADD CONSTRAINT "account_recovery_codes_pkey" PRIMARY KEY ("id");
+
+CREATE UNIQUE INDEX "account_recovery_codes_account_id_hash_idx" ON "public"."account_recovery_codes" ("account_id", "hash");
+
+ALTER TABLE "public"."account_recovery_codes"
+    ADD CONSTRAINT "account_recovery_codes_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Reset account two factor', 'Turn off two factor authentication of account', 'backoffice', '/accounts/:id/totp/reset', 'CREATE') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."account_recovery_codes";
+
+delete from permissions where endpoint = '/accounts/:id/totp/reset';
+call refresh_admin_permissions();
+-- +goose StatementEnd
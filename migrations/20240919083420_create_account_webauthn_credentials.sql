@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."accounts"
+    ADD COLUMN "webauthn_enabled" bool NOT NULL DEFAULT false;
+
+DROP TABLE IF EXISTS "public"."account_webauthn_credentials";
+CREATE TABLE "public"."account_webauthn_credentials" (
+                                                         "created_at" timestamptz(6) DEFAULT now(),
+                                                         "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+                                                         "account_id" uuid NOT NULL,
+                                                         "name" varchar(64) NOT NULL,
+                                                         "credential_id" bytea NOT NULL,
+                                                         "public_key" bytea NOT NULL,
+                                                         "sign_count" int8 NOT NULL DEFAULT 0,
+                                                         "aaguid" bytea,
+                                                         "transports" varchar[],
+                                                         "last_used_at" timestamptz(6)
+)
+;
+
+ALTER TABLE "public"."account_webauthn_credentials" ADD CONSTRAINT "account_webauthn_credentials_pkey" PRIMARY KEY ("id");
+
+CREATE UNIQUE INDEX "account_webauthn_credentials_credential_id_idx" ON "public"."account_webauthn_credentials" ("credential_id");
+CREATE INDEX "account_webauthn_credentials_account_id_idx" ON "public"."account_webauthn_credentials" ("account_id");
+
+ALTER TABLE "public"."account_webauthn_credentials"
+    ADD CONSTRAINT "account_webauthn_credentials_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."account_webauthn_credentials";
+
+ALTER TABLE "public"."accounts"
+    DROP COLUMN IF EXISTS "webauthn_enabled";
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS "accounts_reset_password_token_idx" ON "public"."accounts" ("reset_password_token");
+
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Get invites', 'Get pending invites of organization', 'backoffice', '/invites', 'VIEW'),
+       ('Invite account', 'Create pending account and email an invite link', 'backoffice', '/invites', 'CREATE'),
+       ('Resend invite', 'Email a new invite link', 'backoffice', '/invites/:id/resend', 'CREATE'),
+       ('Revoke invite', 'Delete pending account of invite', 'backoffice', '/invites/:id', 'DELETE') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS "public"."accounts_reset_password_token_idx";
+
+delete from permissions where endpoint in ('/invites', '/invites/:id/resend', '/invites/:id');
+call refresh_admin_permissions();
+-- +goose StatementEnd
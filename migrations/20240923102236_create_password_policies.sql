@@ -0,0 +1,67 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."accounts" ADD COLUMN IF NOT EXISTS "password_changed_at" timestamptz(6);
+-- existing passwords start aging from the migration, not from account creation
+UPDATE "public"."accounts" SET "password_changed_at" = now() WHERE "auth_provider_token" <> '';
+
+DROP TABLE IF EXISTS "public"."organization_password_policies";
+CREATE TABLE "public"."organization_password_policies" (
+                                                           "created_at" timestamptz(6) DEFAULT now(),
+                                                           "updated_at" timestamptz(6) DEFAULT now(),
+                                                           "organization_id" uuid NOT NULL,
+                                                           "min_length" int4 NOT NULL DEFAULT 8,
+                                                           "require_upper" bool NOT NULL DEFAULT false,
+                                                           "require_lower" bool NOT NULL DEFAULT false,
+                                                           "require_digit" bool NOT NULL DEFAULT false,
+                                                           "require_symbol" bool NOT NULL DEFAULT false,
+                                                           "check_breached" bool NOT NULL DEFAULT false,
+                                                           "max_age_days" int4 NOT NULL DEFAULT 0,
+                                                           "history" int4 NOT NULL DEFAULT 0
+)
+;
+
+ALTER TABLE "public"."organization_password_policies" ADD CONSTRAINT "organization_password_policies_pkey" PRIMARY KEY ("organization_id");
+
+ALTER TABLE "public"."organization_password_policies"
+    ADD CONSTRAINT "organization_password_policies_organization_id_fkey" FOREIGN KEY ("organization_id") REFERENCES "public"."organizations" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+DROP TABLE IF EXISTS "public"."account_password_history";
+CREATE TABLE "public"."account_password_history" (
+                                                     "created_at" timestamptz(6) DEFAULT now(),
+                                                     "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+                                                     "account_id" uuid NOT NULL,
+                                                     "hash" varchar(255) NOT NULL
+)
+;
+
+ALTER TABLE "public"."account_password_history" ADD CONSTRAINT "account_password_history_pkey" PRIMARY KEY ("id");
+
+CREATE INDEX "account_password_history_account_id_created_at_idx" ON "public"."account_password_history" ("account_id", "created_at");
+
+ALTER TABLE "public"."account_password_history"
+    ADD CONSTRAINT "account_password_history_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Get password policy', 'Get password policy of organization', 'backoffice', '/password_policy', 'VIEW'),
+       ('Update password policy', 'Update password policy of organization', 'backoffice', '/password_policy', 'EDIT') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."account_password_history";
+DROP TABLE IF EXISTS "public"."organization_password_policies";
+ALTER TABLE "public"."accounts" DROP COLUMN IF EXISTS "password_changed_at";
+
+delete from permissions where endpoint = '/password_policy';
+call refresh_admin_permissions();
+-- +goose StatementEnd
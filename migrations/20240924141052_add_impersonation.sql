@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."audit_log" ADD COLUMN IF NOT EXISTS "impersonator_id" uuid;
+CREATE INDEX IF NOT EXISTS "audit_log_impersonator_id_idx" ON "public"."audit_log" ("impersonator_id") WHERE "impersonator_id" IS NOT NULL;
+
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Impersonate account', 'Sign in as account', 'backoffice', '/accounts/:id/impersonate', 'CREATE') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS "public"."audit_log_impersonator_id_idx";
+ALTER TABLE "public"."audit_log" DROP COLUMN IF EXISTS "impersonator_id";
+
+delete from permissions where endpoint = '/accounts/:id/impersonate';
+call refresh_admin_permissions();
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Get permission coverage', 'Get routes and permissions not matching each other', 'backoffice', '/permissions/coverage', 'VIEW'),
+       ('Sync permissions', 'Create permissions of routes without one', 'backoffice', '/permissions/sync', 'CREATE') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from permissions where endpoint in ('/permissions/coverage', '/permissions/sync');
+call refresh_admin_permissions();
+-- +goose StatementEnd
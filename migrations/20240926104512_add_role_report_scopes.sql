@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table roles add column report_scope jsonb;
+
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Set role report scope', 'Limit reports of the role to games, integrators, operators and countries', 'backoffice', '/roles/:id/report_scope', 'EDIT') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from permissions where endpoint = '/roles/:id/report_scope';
+call refresh_admin_permissions();
+
+alter table roles drop column report_scope;
+-- +goose StatementEnd
@@ -0,0 +1,66 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."account_roles" ADD COLUMN "expires_at" timestamptz(6);
+
+CREATE INDEX "account_roles_expires_at_idx" ON "public"."account_roles" ("expires_at") WHERE "expires_at" IS NOT NULL;
+
+DROP TABLE IF EXISTS "public"."role_grants";
+CREATE TABLE "public"."role_grants" (
+                                        "created_at" timestamptz(6) DEFAULT now(),
+                                        "updated_at" timestamptz(6) DEFAULT now(),
+                                        "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+                                        "account_id" uuid NOT NULL,
+                                        "role_id" uuid NOT NULL,
+                                        "organization_id" uuid,
+                                        "status" varchar(16) NOT NULL,
+                                        "expires_at" timestamptz(6),
+                                        "requested_by" uuid,
+                                        "decided_by" uuid,
+                                        "decided_at" timestamptz(6),
+                                        "ended_at" timestamptz(6)
+)
+;
+
+ALTER TABLE "public"."role_grants" ADD CONSTRAINT "role_grants_pkey" PRIMARY KEY ("id");
+ALTER TABLE "public"."role_grants" ADD CONSTRAINT "role_grants_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+ALTER TABLE "public"."role_grants" ADD CONSTRAINT "role_grants_role_id_fkey" FOREIGN KEY ("role_id") REFERENCES "public"."roles" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+CREATE INDEX "role_grants_account_id_idx" ON "public"."role_grants" ("account_id", "status");
+CREATE INDEX "role_grants_role_id_idx" ON "public"."role_grants" ("role_id", "status");
+
+-- roles assigned so far are active permanent grants
+insert into role_grants (created_at, account_id, role_id, organization_id, status)
+select ar.created_at,
+       ar.account_id,
+       ar.role_id,
+       (select ao.organization_id from account_organizations ao where ao.account_id = ar.account_id limit 1),
+       'active'
+from account_roles ar;
+
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Get account role grants', 'Get pending, active and ended role grants of account', 'backoffice', '/accounts/:id/role_grants', 'VIEW'),
+       ('Get role grants', 'Get pending, active and ended grants of role', 'backoffice', '/roles/:id/grants', 'VIEW'),
+       ('Approve role grant', 'Grant a role assignment requested by another administrator', 'backoffice', '/role_grants/:id/approve', 'CREATE'),
+       ('Reject role grant', 'Reject a role assignment requested by another administrator', 'backoffice', '/role_grants/:id/reject', 'CREATE') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."role_grants";
+
+DROP INDEX IF EXISTS "public"."account_roles_expires_at_idx";
+ALTER TABLE "public"."account_roles" DROP COLUMN "expires_at";
+
+delete from permissions where endpoint in ('/accounts/:id/role_grants', '/roles/:id/grants', '/role_grants/:id/approve', '/role_grants/:id/reject');
+call refresh_admin_permissions();
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."organizations" ADD COLUMN "unsigned_public_reports" bool NOT NULL DEFAULT true;
+
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Create public report link', 'Sign a link to the public report of a session or a spin', 'backoffice', '/reports/public_links', 'CREATE'),
+       ('Switch unsigned public reports', 'Allow or refuse public reports of integrator without a signed token', 'backoffice', '/organizations/:id/unsigned_public_reports', 'EDIT') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."organizations" DROP COLUMN "unsigned_public_reports";
+
+delete from permissions where endpoint in ('/reports/public_links', '/organizations/:id/unsigned_public_reports');
+call refresh_admin_permissions();
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."game_versions";
+CREATE TABLE "public"."game_versions" (
+                                          "created_at" timestamptz(6) DEFAULT now(),
+                                          "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+                                          "game_id" uuid NOT NULL,
+                                          "integrator_id" uuid,
+                                          "version" int4 NOT NULL,
+                                          "snapshot" jsonb NOT NULL,
+                                          "author_id" uuid,
+                                          "author" varchar(255),
+                                          "rollback_of" int4
+)
+;
+
+ALTER TABLE "public"."game_versions" ADD CONSTRAINT "game_versions_pkey" PRIMARY KEY ("id");
+ALTER TABLE "public"."game_versions" ADD CONSTRAINT "game_versions_game_id_fkey" FOREIGN KEY ("game_id") REFERENCES "public"."games" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+-- game versions have no integrator, versions of integrator games have one
+CREATE UNIQUE INDEX "game_versions_version_idx" ON "public"."game_versions" ("game_id", coalesce("integrator_id", '00000000-0000-0000-0000-000000000000'), "version");
+
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Get game history', 'Get versions of game and integrator game configurations with changed fields', 'backoffice', '/game/:id/history', 'VIEW'),
+       ('Rollback game', 'Restore a version of game or integrator game configuration', 'backoffice', '/game/:id/rollback', 'CREATE') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."game_versions";
+
+delete from permissions where endpoint in ('/game/:id/history', '/game/:id/rollback');
+call refresh_admin_permissions();
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Download game catalog', 'Download games as an Excel file', 'backoffice', '/game/catalog', 'VIEW'),
+       ('Import game catalog', 'Create and update games from an Excel file', 'backoffice', '/game/catalog', 'CREATE') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from permissions where endpoint = '/game/catalog';
+call refresh_admin_permissions();
+-- +goose StatementEnd
@@ -0,0 +1,66 @@
+-- +goose Up
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."game_wager_constraints";
+CREATE TABLE "public"."game_wager_constraints" (
+                                                   "created_at" timestamptz(6) DEFAULT now(),
+                                                   "updated_at" timestamptz(6) DEFAULT now(),
+                                                   "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+                                                   "game_id" uuid NOT NULL,
+                                                   "currency" varchar(16) NOT NULL DEFAULT '',
+                                                   "divisor" int8,
+                                                   "min_bet" int8,
+                                                   "max_bet" int8,
+                                                   "max_levels" int4,
+                                                   "max_win_multiplier" int8,
+                                                   "max_exposure" int8
+)
+;
+
+ALTER TABLE "public"."game_wager_constraints" ADD CONSTRAINT "game_wager_constraints_pkey" PRIMARY KEY ("id");
+ALTER TABLE "public"."game_wager_constraints" ADD CONSTRAINT "game_wager_constraints_game_id_fkey" FOREIGN KEY ("game_id") REFERENCES "public"."games" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+CREATE UNIQUE INDEX "game_wager_constraints_game_id_currency_idx" ON "public"."game_wager_constraints" ("game_id", "currency");
+
+-- divisors the backoffice used to keep in code
+insert into game_wager_constraints (game_id, divisor)
+select g.id, d.divisor
+from games g
+         inner join (values ('admiral-wilds', 90),
+                            ('frozen-fruits-flexiways', 30),
+                            ('lucky-skulls-bonanza', 200),
+                            ('irish-riches-bonanza', 200),
+                            ('wild-dragon-respin', 100),
+                            ('coral-reef-flexiways', 200),
+                            ('lucky-santa-bonanza', 200),
+                            ('fortune-777-respin', 100),
+                            ('cleos-riches-flexiways', 200),
+                            ('sweet-mystery-flexiways', 100),
+                            ('quest-of-ra', 200),
+                            ('vampire-vault-hold-n-win', 200),
+                            ('yakuza-clash-hold-n-win', 200),
+                            ('double-fortune-panda', 200),
+                            ('squid-gold-x2', 200),
+                            ('brazilian-mask-fire', 200)) as d (name, divisor) on d.name = g.name;
+
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Get game wager constraints', 'Get limits of the wager sets of game', 'backoffice', '/game/:id/wager_constraints', 'VIEW'),
+       ('Set game wager constraints', 'Set divisor, bets, levels and exposure limits of the wager sets of game', 'backoffice', '/game/:id/wager_constraints', 'EDIT') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."game_wager_constraints";
+
+delete from permissions where endpoint = '/game/:id/wager_constraints';
+call refresh_admin_permissions();
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+insert into permissions (name, description, subject, endpoint, action)
+
+values ('Preview currency wager sets', 'Preview wager sets generated from a wager set by currency multipliers', 'backoffice', '/wager_set/:id/currencies/preview', 'CREATE'),
+       ('Save currency wager sets', 'Save approved wager sets generated by currency multipliers for the games of an integrator', 'backoffice', '/wager_set/:id/currencies', 'CREATE') ON CONFLICT (name) DO
+
+UPDATE
+    SET
+    description = EXCLUDED.description,
+    subject = EXCLUDED.subject,
+    endpoint = EXCLUDED.endpoint,
+    action = EXCLUDED.action;
+
+call refresh_admin_permissions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from permissions where endpoint in ('/wager_set/:id/currencies/preview', '/wager_set/:id/currencies');
+call refresh_admin_permissions();
+-- +goose StatementEnd
@@ -4,4 +4,14 @@ import "time"
 
 type Config struct {
 	TTL time.Duration
+	// Dir is where LocalStorage keeps generated files.
+	Dir string
+
+	// Jobs and OrganizationJobs cap how many exports run at once overall and per organization.
+	Jobs             int
+	OrganizationJobs int
+	// Retries is how many times an export is restarted after a transient failure, waiting
+	// RetryBackoff before the first retry and twice as long before every next one.
+	Retries      int
+	RetryBackoff time.Duration
 }
@@ -0,0 +1,104 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const partialSuffix = ".part"
+
+var ErrNotFound = errors.New("file not found in storage")
+
+// Storage keeps generated files outside of memory, content is written and read as a stream.
+type Storage interface {
+	Create(ctx context.Context, key string) (io.WriteCloser, error)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	DeleteExpired(ctx context.Context, before time.Time) error
+}
+
+type LocalStorage struct {
+	dir string
+}
+
+func NewLocalStorage(cfg *Config) (*LocalStorage, error) {
+	dir := cfg.Dir
+	if dir == "" {
+		dir = filepath.Join(os.TempDir(), "backoffice-files")
+	}
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	return &LocalStorage{dir: dir}, nil
+}
+
+// Create returns a writer to a partial file, it becomes visible to Open only after Close.
+func (s *LocalStorage) Create(ctx context.Context, key string) (io.WriteCloser, error) {
+	f, err := os.Create(s.path(key) + partialSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	return &localWriter{File: f, path: s.path(key)}, nil
+}
+
+func (s *LocalStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	f, err := os.Open(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+
+	return f, err
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	err := os.Remove(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+func (s *LocalStorage) DeleteExpired(ctx context.Context, before time.Time) error {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || info.IsDir() || !info.ModTime().Before(before) {
+			continue
+		}
+
+		if err = os.Remove(filepath.Join(s.dir, entry.Name())); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *LocalStorage) path(key string) string {
+	return filepath.Join(s.dir, filepath.Base(key))
+}
+
+type localWriter struct {
+	*os.File
+	path string
+}
+
+func (w *localWriter) Close() error {
+	if err := w.File.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(w.File.Name(), w.path)
+}
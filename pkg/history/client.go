@@ -21,6 +21,8 @@ type Client interface {
 	GetAggregatedReportByGame(ctx context.Context, in *GetAggregatedReportFilters) ([]*GetAggregatedReportByGameItem, error)
 	GetAggregatedReportByCountry(ctx context.Context, in *GetAggregatedReportFilters) ([]*GetAggregatedReportByCountryItem, error)
 	GetFinancialReport(ctx context.Context, in *FinancialBase) (*FinancialReport, error)
+	GetFinancialSeries(ctx context.Context, in *FinancialSeriesIn) ([]*SeriesItem, error)
+	GetAggregatedSeries(ctx context.Context, in *AggregatedSeriesIn) ([]*SeriesItem, error)
 
 	GetSpins(ctx context.Context, in *GetFinancialIn) (*GetSpinsOut, error)
 	GetSessions(ctx context.Context, in *GetFinancialIn) (*GetSessionsOut, error)
@@ -160,3 +162,21 @@ func (c *client) GetFinancialReport(ctx context.Context, in *FinancialBase) (*Fi
 
 	return out.Report, nil
 }
+
+func (c *client) GetFinancialSeries(ctx context.Context, in *FinancialSeriesIn) ([]*SeriesItem, error) {
+	out, err := c.api.GetFinancialSeries(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Items, nil
+}
+
+func (c *client) GetAggregatedSeries(ctx context.Context, in *AggregatedSeriesIn) ([]*SeriesItem, error) {
+	out, err := c.api.GetAggregatedSeries(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Items, nil
+}
@@ -661,6 +661,275 @@ func (x *FinancialReportOut) GetReport() *FinancialReport {
 	return nil
 }
 
+// granularity is one of hour, day, week, month; buckets are aligned to local time of the IANA timezone.
+type FinancialSeriesIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Base        *FinancialBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Granularity string         `protobuf:"bytes,2,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	Timezone    string         `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+}
+
+func (x *FinancialSeriesIn) Reset() {
+	*x = FinancialSeriesIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_history_main_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinancialSeriesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinancialSeriesIn) ProtoMessage() {}
+
+func (x *FinancialSeriesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_history_main_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinancialSeriesIn.ProtoReflect.Descriptor instead.
+func (*FinancialSeriesIn) Descriptor() ([]byte, []int) {
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FinancialSeriesIn) GetBase() *FinancialBase {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *FinancialSeriesIn) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *FinancialSeriesIn) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type AggregatedSeriesIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filters     *GetAggregatedReportFilters `protobuf:"bytes,1,opt,name=filters,proto3" json:"filters,omitempty"`
+	Granularity string                      `protobuf:"bytes,2,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	Timezone    string                      `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+}
+
+func (x *AggregatedSeriesIn) Reset() {
+	*x = AggregatedSeriesIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_history_main_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregatedSeriesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregatedSeriesIn) ProtoMessage() {}
+
+func (x *AggregatedSeriesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_history_main_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregatedSeriesIn.ProtoReflect.Descriptor instead.
+func (*AggregatedSeriesIn) Descriptor() ([]byte, []int) {
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AggregatedSeriesIn) GetFilters() *GetAggregatedReportFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *AggregatedSeriesIn) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *AggregatedSeriesIn) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type SeriesItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bucket          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Wager           uint64                 `protobuf:"varint,2,opt,name=wager,proto3" json:"wager,omitempty"`
+	Award           uint64                 `protobuf:"varint,3,opt,name=award,proto3" json:"award,omitempty"`
+	WagerWithoutPfr uint64                 `protobuf:"varint,4,opt,name=wager_without_pfr,json=wagerWithoutPfr,proto3" json:"wager_without_pfr,omitempty"`
+	AwardWithoutPfr uint64                 `protobuf:"varint,5,opt,name=award_without_pfr,json=awardWithoutPfr,proto3" json:"award_without_pfr,omitempty"`
+	SpinQuantity    int64                  `protobuf:"varint,6,opt,name=spin_quantity,json=spinQuantity,proto3" json:"spin_quantity,omitempty"`
+	UserQuantity    int64                  `protobuf:"varint,7,opt,name=user_quantity,json=userQuantity,proto3" json:"user_quantity,omitempty"`
+}
+
+func (x *SeriesItem) Reset() {
+	*x = SeriesItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_history_main_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeriesItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesItem) ProtoMessage() {}
+
+func (x *SeriesItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_history_main_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesItem.ProtoReflect.Descriptor instead.
+func (*SeriesItem) Descriptor() ([]byte, []int) {
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SeriesItem) GetBucket() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+func (x *SeriesItem) GetWager() uint64 {
+	if x != nil {
+		return x.Wager
+	}
+	return 0
+}
+
+func (x *SeriesItem) GetAward() uint64 {
+	if x != nil {
+		return x.Award
+	}
+	return 0
+}
+
+func (x *SeriesItem) GetWagerWithoutPfr() uint64 {
+	if x != nil {
+		return x.WagerWithoutPfr
+	}
+	return 0
+}
+
+func (x *SeriesItem) GetAwardWithoutPfr() uint64 {
+	if x != nil {
+		return x.AwardWithoutPfr
+	}
+	return 0
+}
+
+func (x *SeriesItem) GetSpinQuantity() int64 {
+	if x != nil {
+		return x.SpinQuantity
+	}
+	return 0
+}
+
+func (x *SeriesItem) GetUserQuantity() int64 {
+	if x != nil {
+		return x.UserQuantity
+	}
+	return 0
+}
+
+type SeriesOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*SeriesItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *SeriesOut) Reset() {
+	*x = SeriesOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_history_main_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeriesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesOut) ProtoMessage() {}
+
+func (x *SeriesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_history_main_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesOut.ProtoReflect.Descriptor instead.
+func (*SeriesOut) Descriptor() ([]byte, []int) {
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SeriesOut) GetItems() []*SeriesItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 type GetAllGameSessionsOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -672,7 +941,7 @@ type GetAllGameSessionsOut struct {
 func (x *GetAllGameSessionsOut) Reset() {
 	*x = GetAllGameSessionsOut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[8]
+		mi := &file_pkg_history_main_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -685,7 +954,7 @@ func (x *GetAllGameSessionsOut) String() string {
 func (*GetAllGameSessionsOut) ProtoMessage() {}
 
 func (x *GetAllGameSessionsOut) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[8]
+	mi := &file_pkg_history_main_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +967,7 @@ func (x *GetAllGameSessionsOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllGameSessionsOut.ProtoReflect.Descriptor instead.
 func (*GetAllGameSessionsOut) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{8}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetAllGameSessionsOut) GetSessions() []*GameSessionOut {
@@ -719,7 +988,7 @@ type GetAllSpinsOut struct {
 func (x *GetAllSpinsOut) Reset() {
 	*x = GetAllSpinsOut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[9]
+		mi := &file_pkg_history_main_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -732,7 +1001,7 @@ func (x *GetAllSpinsOut) String() string {
 func (*GetAllSpinsOut) ProtoMessage() {}
 
 func (x *GetAllSpinsOut) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[9]
+	mi := &file_pkg_history_main_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,7 +1014,7 @@ func (x *GetAllSpinsOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllSpinsOut.ProtoReflect.Descriptor instead.
 func (*GetAllSpinsOut) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{9}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetAllSpinsOut) GetSpins() []*SpinOut {
@@ -770,7 +1039,7 @@ type GetFinancialIn struct {
 func (x *GetFinancialIn) Reset() {
 	*x = GetFinancialIn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[10]
+		mi := &file_pkg_history_main_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -783,7 +1052,7 @@ func (x *GetFinancialIn) String() string {
 func (*GetFinancialIn) ProtoMessage() {}
 
 func (x *GetFinancialIn) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[10]
+	mi := &file_pkg_history_main_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -796,7 +1065,7 @@ func (x *GetFinancialIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFinancialIn.ProtoReflect.Descriptor instead.
 func (*GetFinancialIn) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{10}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetFinancialIn) GetOrder() string {
@@ -847,7 +1116,7 @@ type FinancialBase struct {
 func (x *FinancialBase) Reset() {
 	*x = FinancialBase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[11]
+		mi := &file_pkg_history_main_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -860,7 +1129,7 @@ func (x *FinancialBase) String() string {
 func (*FinancialBase) ProtoMessage() {}
 
 func (x *FinancialBase) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[11]
+	mi := &file_pkg_history_main_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -873,7 +1142,7 @@ func (x *FinancialBase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinancialBase.ProtoReflect.Descriptor instead.
 func (*FinancialBase) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{11}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *FinancialBase) GetConvertCurrency() string {
@@ -918,7 +1187,7 @@ type Filters struct {
 func (x *Filters) Reset() {
 	*x = Filters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[12]
+		mi := &file_pkg_history_main_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -931,7 +1200,7 @@ func (x *Filters) String() string {
 func (*Filters) ProtoMessage() {}
 
 func (x *Filters) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[12]
+	mi := &file_pkg_history_main_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -944,7 +1213,7 @@ func (x *Filters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Filters.ProtoReflect.Descriptor instead.
 func (*Filters) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{12}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Filters) GetIntegrator() string {
@@ -1038,7 +1307,7 @@ type GetSessionsOut struct {
 func (x *GetSessionsOut) Reset() {
 	*x = GetSessionsOut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[13]
+		mi := &file_pkg_history_main_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1051,7 +1320,7 @@ func (x *GetSessionsOut) String() string {
 func (*GetSessionsOut) ProtoMessage() {}
 
 func (x *GetSessionsOut) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[13]
+	mi := &file_pkg_history_main_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1064,7 +1333,7 @@ func (x *GetSessionsOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSessionsOut.ProtoReflect.Descriptor instead.
 func (*GetSessionsOut) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{13}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetSessionsOut) GetItems() []*GameSessionOut {
@@ -1130,7 +1399,7 @@ type GameSessionOut struct {
 func (x *GameSessionOut) Reset() {
 	*x = GameSessionOut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[14]
+		mi := &file_pkg_history_main_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1143,7 +1412,7 @@ func (x *GameSessionOut) String() string {
 func (*GameSessionOut) ProtoMessage() {}
 
 func (x *GameSessionOut) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[14]
+	mi := &file_pkg_history_main_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1156,7 +1425,7 @@ func (x *GameSessionOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameSessionOut.ProtoReflect.Descriptor instead.
 func (*GameSessionOut) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{14}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GameSessionOut) GetCreatedAt() *timestamppb.Timestamp {
@@ -1348,7 +1617,7 @@ type GetSpinsOut struct {
 func (x *GetSpinsOut) Reset() {
 	*x = GetSpinsOut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[15]
+		mi := &file_pkg_history_main_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1361,7 +1630,7 @@ func (x *GetSpinsOut) String() string {
 func (*GetSpinsOut) ProtoMessage() {}
 
 func (x *GetSpinsOut) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[15]
+	mi := &file_pkg_history_main_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1374,7 +1643,7 @@ func (x *GetSpinsOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSpinsOut.ProtoReflect.Descriptor instead.
 func (*GetSpinsOut) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{15}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetSpinsOut) GetItems() []*SpinOut {
@@ -1443,7 +1712,7 @@ type SpinIn struct {
 func (x *SpinIn) Reset() {
 	*x = SpinIn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[16]
+		mi := &file_pkg_history_main_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1456,7 +1725,7 @@ func (x *SpinIn) String() string {
 func (*SpinIn) ProtoMessage() {}
 
 func (x *SpinIn) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[16]
+	mi := &file_pkg_history_main_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1469,7 +1738,7 @@ func (x *SpinIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpinIn.ProtoReflect.Descriptor instead.
 func (*SpinIn) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{16}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SpinIn) GetCreatedAt() *timestamppb.Timestamp {
@@ -1707,7 +1976,7 @@ type SpinOut struct {
 func (x *SpinOut) Reset() {
 	*x = SpinOut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[17]
+		mi := &file_pkg_history_main_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1720,7 +1989,7 @@ func (x *SpinOut) String() string {
 func (*SpinOut) ProtoMessage() {}
 
 func (x *SpinOut) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[17]
+	mi := &file_pkg_history_main_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1733,7 +2002,7 @@ func (x *SpinOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpinOut.ProtoReflect.Descriptor instead.
 func (*SpinOut) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{17}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *SpinOut) GetCreatedAt() *timestamppb.Timestamp {
@@ -1951,7 +2220,7 @@ type GetSpinIn struct {
 func (x *GetSpinIn) Reset() {
 	*x = GetSpinIn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[18]
+		mi := &file_pkg_history_main_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1964,7 +2233,7 @@ func (x *GetSpinIn) String() string {
 func (*GetSpinIn) ProtoMessage() {}
 
 func (x *GetSpinIn) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[18]
+	mi := &file_pkg_history_main_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1977,7 +2246,7 @@ func (x *GetSpinIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSpinIn.ProtoReflect.Descriptor instead.
 func (*GetSpinIn) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{18}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetSpinIn) GetRoundId() string {
@@ -2006,7 +2275,7 @@ type GetLastSpinIn struct {
 func (x *GetLastSpinIn) Reset() {
 	*x = GetLastSpinIn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[19]
+		mi := &file_pkg_history_main_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2019,7 +2288,7 @@ func (x *GetLastSpinIn) String() string {
 func (*GetLastSpinIn) ProtoMessage() {}
 
 func (x *GetLastSpinIn) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[19]
+	mi := &file_pkg_history_main_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2032,7 +2301,7 @@ func (x *GetLastSpinIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLastSpinIn.ProtoReflect.Descriptor instead.
 func (*GetLastSpinIn) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{19}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetLastSpinIn) GetGame() string {
@@ -2062,7 +2331,7 @@ type GetLastSpinByWagerIn struct {
 func (x *GetLastSpinByWagerIn) Reset() {
 	*x = GetLastSpinByWagerIn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[20]
+		mi := &file_pkg_history_main_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2075,7 +2344,7 @@ func (x *GetLastSpinByWagerIn) String() string {
 func (*GetLastSpinByWagerIn) ProtoMessage() {}
 
 func (x *GetLastSpinByWagerIn) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[20]
+	mi := &file_pkg_history_main_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2088,7 +2357,7 @@ func (x *GetLastSpinByWagerIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLastSpinByWagerIn.ProtoReflect.Descriptor instead.
 func (*GetLastSpinByWagerIn) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{20}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetLastSpinByWagerIn) GetGame() string {
@@ -2124,7 +2393,7 @@ type GetSpinOut struct {
 func (x *GetSpinOut) Reset() {
 	*x = GetSpinOut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[21]
+		mi := &file_pkg_history_main_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2137,7 +2406,7 @@ func (x *GetSpinOut) String() string {
 func (*GetSpinOut) ProtoMessage() {}
 
 func (x *GetSpinOut) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[21]
+	mi := &file_pkg_history_main_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2150,7 +2419,7 @@ func (x *GetSpinOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSpinOut.ProtoReflect.Descriptor instead.
 func (*GetSpinOut) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{21}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetSpinOut) GetItem() *SpinOut {
@@ -2178,7 +2447,7 @@ type GetLastSpinsOut struct {
 func (x *GetLastSpinsOut) Reset() {
 	*x = GetLastSpinsOut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[22]
+		mi := &file_pkg_history_main_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2191,7 +2460,7 @@ func (x *GetLastSpinsOut) String() string {
 func (*GetLastSpinsOut) ProtoMessage() {}
 
 func (x *GetLastSpinsOut) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[22]
+	mi := &file_pkg_history_main_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2204,7 +2473,7 @@ func (x *GetLastSpinsOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLastSpinsOut.ProtoReflect.Descriptor instead.
 func (*GetLastSpinsOut) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{22}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetLastSpinsOut) GetItems() []*SpinOut {
@@ -2227,7 +2496,7 @@ type GetSpinPaginationIn struct {
 func (x *GetSpinPaginationIn) Reset() {
 	*x = GetSpinPaginationIn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[23]
+		mi := &file_pkg_history_main_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2240,7 +2509,7 @@ func (x *GetSpinPaginationIn) String() string {
 func (*GetSpinPaginationIn) ProtoMessage() {}
 
 func (x *GetSpinPaginationIn) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[23]
+	mi := &file_pkg_history_main_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2253,7 +2522,7 @@ func (x *GetSpinPaginationIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSpinPaginationIn.ProtoReflect.Descriptor instead.
 func (*GetSpinPaginationIn) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{23}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetSpinPaginationIn) GetFilter() *GetLastSpinIn {
@@ -2291,7 +2560,7 @@ type GetSpinPaginationOut struct {
 func (x *GetSpinPaginationOut) Reset() {
 	*x = GetSpinPaginationOut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[24]
+		mi := &file_pkg_history_main_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2304,7 +2573,7 @@ func (x *GetSpinPaginationOut) String() string {
 func (*GetSpinPaginationOut) ProtoMessage() {}
 
 func (x *GetSpinPaginationOut) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[24]
+	mi := &file_pkg_history_main_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2317,7 +2586,7 @@ func (x *GetSpinPaginationOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSpinPaginationOut.ProtoReflect.Descriptor instead.
 func (*GetSpinPaginationOut) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{24}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetSpinPaginationOut) GetItems() []*SpinOut {
@@ -2359,7 +2628,7 @@ type DictionaryOut struct {
 func (x *DictionaryOut) Reset() {
 	*x = DictionaryOut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[25]
+		mi := &file_pkg_history_main_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2372,7 +2641,7 @@ func (x *DictionaryOut) String() string {
 func (*DictionaryOut) ProtoMessage() {}
 
 func (x *DictionaryOut) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[25]
+	mi := &file_pkg_history_main_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2385,7 +2654,7 @@ func (x *DictionaryOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DictionaryOut.ProtoReflect.Descriptor instead.
 func (*DictionaryOut) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{25}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DictionaryOut) GetItems() []string {
@@ -2406,7 +2675,7 @@ type GamesIn struct {
 func (x *GamesIn) Reset() {
 	*x = GamesIn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[26]
+		mi := &file_pkg_history_main_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2419,7 +2688,7 @@ func (x *GamesIn) String() string {
 func (*GamesIn) ProtoMessage() {}
 
 func (x *GamesIn) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[26]
+	mi := &file_pkg_history_main_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2432,7 +2701,7 @@ func (x *GamesIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GamesIn.ProtoReflect.Descriptor instead.
 func (*GamesIn) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{26}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GamesIn) GetGames() []string {
@@ -2453,7 +2722,7 @@ type IntegratorsOperatorOut struct {
 func (x *IntegratorsOperatorOut) Reset() {
 	*x = IntegratorsOperatorOut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[27]
+		mi := &file_pkg_history_main_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2466,7 +2735,7 @@ func (x *IntegratorsOperatorOut) String() string {
 func (*IntegratorsOperatorOut) ProtoMessage() {}
 
 func (x *IntegratorsOperatorOut) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[27]
+	mi := &file_pkg_history_main_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2479,7 +2748,7 @@ func (x *IntegratorsOperatorOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntegratorsOperatorOut.ProtoReflect.Descriptor instead.
 func (*IntegratorsOperatorOut) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{27}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *IntegratorsOperatorOut) GetMap() map[string]*DictionaryOut {
@@ -2500,7 +2769,7 @@ type Status struct {
 func (x *Status) Reset() {
 	*x = Status{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_history_main_proto_msgTypes[28]
+		mi := &file_pkg_history_main_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2513,7 +2782,7 @@ func (x *Status) String() string {
 func (*Status) ProtoMessage() {}
 
 func (x *Status) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_history_main_proto_msgTypes[28]
+	mi := &file_pkg_history_main_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2526,7 +2795,7 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Status.ProtoReflect.Descriptor instead.
 func (*Status) Descriptor() ([]byte, []int) {
-	return file_pkg_history_main_proto_rawDescGZIP(), []int{28}
+	return file_pkg_history_main_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *Status) GetStatus() string {
@@ -2648,395 +2917,442 @@ var file_pkg_history_main_proto_rawDesc = []byte{
 	0x75, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x69, 0x6e,
 	0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x22, 0x4c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x47, 0x61,
-	0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x33, 0x0a,
-	0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x38, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x70, 0x69, 0x6e,
-	0x73, 0x4f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x70, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x70,
-	0x69, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x05, 0x73, 0x70, 0x69, 0x6e, 0x73, 0x22, 0x97, 0x01, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x12,
-	0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
-	0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x61,
-	0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x61, 0x73, 0x65,
-	0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x22, 0x7b, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63,
-	0x69, 0x61, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65,
-	0x72, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x22, 0xc0, 0x03, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12,
-	0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
-	0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x67,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x12,
-	0x44, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x22, 0x7d, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61,
+	0x6c, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x61, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x52,
+	0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e,
+	0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
+	0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
+	0x6f, 0x6e, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x12, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x3d, 0x0a, 0x07, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72, 0x61,
+	0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0x8e, 0x02, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x72,
-	0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
-	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e,
-	0x64, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e,
-	0x64, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x1c, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6d, 0x6f, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x02, 0x52, 0x06, 0x69, 0x73, 0x44, 0x65, 0x6d, 0x6f, 0x88, 0x01, 0x01, 0x12,
-	0x19, 0x0a, 0x08, 0x72, 0x74, 0x70, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x72, 0x74, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x0c, 0x0a, 0x0a,
-	0x5f, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69,
-	0x73, 0x5f, 0x64, 0x65, 0x6d, 0x6f, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x75,
-	0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xc7, 0x06, 0x0a, 0x0e, 0x47, 0x61, 0x6d, 0x65,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x77, 0x61, 0x67, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x77, 0x61,
-	0x67, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x77, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x74,
-	0x68, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x66, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
-	0x77, 0x61, 0x67, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x50, 0x66, 0x72, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x77, 0x61, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
-	0x61, 0x77, 0x61, 0x72, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x77,
-	0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x66, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x50, 0x66,
-	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e, 0x0a,
-	0x0a, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a,
-	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65,
-	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x70, 0x69, 0x6e, 0x73, 0x18,
-	0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e,
-	0x53, 0x70, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x05, 0x73, 0x70, 0x69, 0x6e, 0x73, 0x12, 0x24,
-	0x0a, 0x0e, 0x77, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x70, 0x66, 0x72,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x77, 0x61, 0x67, 0x65, 0x72, 0x57, 0x69, 0x74,
-	0x68, 0x50, 0x66, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x77, 0x69,
-	0x74, 0x68, 0x5f, 0x70, 0x66, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x77,
-	0x61, 0x72, 0x64, 0x57, 0x69, 0x74, 0x68, 0x50, 0x66, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x74,
-	0x70, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x72, 0x74, 0x70, 0x12, 0x2a, 0x0a, 0x11,
-	0x72, 0x74, 0x70, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65,
-	0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x74, 0x70, 0x57, 0x69, 0x74, 0x68,
-	0x54, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x67,
-	0x69, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e,
-	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x16,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x18, 0x17,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12,
-	0x1f, 0x0a, 0x0b, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x18, 0x18,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x41, 0x77, 0x61, 0x72, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x18,
-	0x19, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x41, 0x77, 0x61, 0x72,
-	0x64, 0x22, 0x84, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x73, 0x4f, 0x75,
-	0x74, 0x12, 0x26, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x70, 0x69, 0x6e, 0x4f,
-	0x75, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x99, 0x07, 0x0a, 0x06, 0x53, 0x70, 0x69,
-	0x6e, 0x49, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39,
-	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x6d, 0x70, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x61,
+	0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x77, 0x61, 0x67, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x61, 0x77, 0x61, 0x72, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x77, 0x61, 0x67, 0x65, 0x72, 0x5f,
+	0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x66, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x77, 0x61, 0x67, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x50,
+	0x66, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68,
+	0x6f, 0x75, 0x74, 0x5f, 0x70, 0x66, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61,
+	0x77, 0x61, 0x72, 0x64, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x50, 0x66, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x70, 0x69, 0x6e, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x70, 0x69, 0x6e, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72,
+	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x36, 0x0a, 0x09, 0x53, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x22, 0x4c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x4f, 0x75, 0x74, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x38,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x70, 0x69, 0x6e, 0x73, 0x4f, 0x75, 0x74,
+	0x12, 0x26, 0x0a, 0x05, 0x73, 0x70, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x70, 0x69, 0x6e, 0x4f, 0x75,
+	0x74, 0x52, 0x05, 0x73, 0x70, 0x69, 0x6e, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x52, 0x04, 0x62, 0x61,
+	0x73, 0x65, 0x22, 0x7b, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x42,
+	0x61, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x67, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22,
+	0xc0, 0x03, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x67, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65,
-	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
-	0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28,
-	0x0a, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x64,
-	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x65, 0x6e, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x61,
-	0x67, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x77, 0x61, 0x67, 0x65, 0x72,
-	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x18, 0x15,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12,
-	0x1f, 0x0a, 0x0b, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x18, 0x16,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x41, 0x77, 0x61, 0x72, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x18,
-	0x17, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x41, 0x77, 0x61, 0x72,
-	0x64, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x72, 0x65,
-	0x73, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x70,
-	0x66, 0x72, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x50, 0x66, 0x72, 0x12,
-	0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x73, 0x68, 0x6f, 0x77, 0x6e, 0x18, 0x1b, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x69, 0x73, 0x53, 0x68, 0x6f, 0x77, 0x6e, 0x12, 0x1c, 0x0a, 0x07, 0x69, 0x73,
-	0x5f, 0x64, 0x65, 0x6d, 0x6f, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x69,
-	0x73, 0x44, 0x65, 0x6d, 0x6f, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x73, 0x5f,
-	0x64, 0x65, 0x6d, 0x6f, 0x22, 0xd6, 0x07, 0x0a, 0x07, 0x53, 0x70, 0x69, 0x6e, 0x4f, 0x75, 0x74,
-	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00,
+	0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x72, 0x6f, 0x6d, 0x88, 0x01,
+	0x01, 0x12, 0x3c, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x74, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x68, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
-	0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61,
-	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d,
-	0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x61, 0x67, 0x65, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x05, 0x77, 0x61, 0x67, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f,
-	0x61, 0x77, 0x61, 0x72, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x61, 0x73,
-	0x65, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f,
-	0x61, 0x77, 0x61, 0x72, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x6f, 0x6e,
-	0x75, 0x73, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x61, 0x6c,
-	0x5f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x69,
-	0x6e, 0x61, 0x6c, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x74,
-	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x19, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x10, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x18, 0x1a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
-	0x1a, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x70, 0x66, 0x72, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x48,
-	0x00, 0x52, 0x05, 0x69, 0x73, 0x50, 0x66, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x69,
-	0x73, 0x5f, 0x73, 0x68, 0x6f, 0x77, 0x6e, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52,
-	0x07, 0x69, 0x73, 0x53, 0x68, 0x6f, 0x77, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x69,
-	0x73, 0x5f, 0x64, 0x65, 0x6d, 0x6f, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x06,
-	0x69, 0x73, 0x44, 0x65, 0x6d, 0x6f, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x69, 0x73,
-	0x5f, 0x70, 0x66, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x73, 0x5f, 0x73, 0x68, 0x6f, 0x77,
-	0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6d, 0x6f, 0x22, 0x50, 0x0a,
-	0x09, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x49, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f,
-	0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f,
-	0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22,
-	0x4d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x49, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x67, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6a,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x42, 0x79, 0x57,
-	0x61, 0x67, 0x65, 0x72, 0x49, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x61, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x05, 0x77, 0x61, 0x67, 0x65, 0x72, 0x22, 0x4c, 0x0a, 0x0a, 0x47, 0x65,
-	0x74, 0x53, 0x70, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x2e, 0x53, 0x70, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x18,
-	0x0a, 0x07, 0x69, 0x73, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x69, 0x73, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x39, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c,
-	0x61, 0x73, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x68, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x70, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x22, 0x6f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x50, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x68, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x70, 0x69, 0x6e,
-	0x49, 0x6e, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x22, 0x7e, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x50,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x68, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x70, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x48, 0x01, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
+	0x6f, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x07, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6d, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02,
+	0x52, 0x06, 0x69, 0x73, 0x44, 0x65, 0x6d, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08, 0x72,
+	0x74, 0x70, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72,
+	0x74, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x73, 0x5f, 0x64, 0x65,
+	0x6d, 0x6f, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x22, 0xc7, 0x06, 0x0a, 0x0e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x28, 0x0a, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x61, 0x67,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x77, 0x61, 0x67, 0x65, 0x72, 0x12,
+	0x2a, 0x0a, 0x11, 0x77, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74,
+	0x5f, 0x70, 0x66, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x77, 0x61, 0x67, 0x65,
+	0x72, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x50, 0x66, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x77, 0x61, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x77, 0x61, 0x72,
+	0x64, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f,
+	0x75, 0x74, 0x5f, 0x70, 0x66, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61, 0x77,
+	0x61, 0x72, 0x64, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x50, 0x66, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74,
+	0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
+	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x61,
+	0x6d, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x70, 0x69, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x70, 0x69, 0x6e,
+	0x4f, 0x75, 0x74, 0x52, 0x05, 0x73, 0x70, 0x69, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x77, 0x61,
+	0x67, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x70, 0x66, 0x72, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x77, 0x61, 0x67, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x50, 0x66, 0x72,
+	0x12, 0x24, 0x0a, 0x0e, 0x61, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x70,
+	0x66, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x77, 0x61, 0x72, 0x64, 0x57,
+	0x69, 0x74, 0x68, 0x50, 0x66, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x74, 0x70, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x72, 0x74, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x74, 0x70, 0x5f,
+	0x77, 0x69, 0x74, 0x68, 0x5f, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x74, 0x70, 0x57, 0x69, 0x74, 0x68, 0x54, 0x75, 0x72, 0x6e,
+	0x6f, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72,
+	0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65,
+	0x6e, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62,
+	0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x41, 0x77, 0x61, 0x72, 0x64, 0x22, 0x84, 0x01,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x26, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x70, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
 	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14,
 	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x22, 0x25, 0x0a, 0x0d, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
-	0x72, 0x79, 0x4f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x1f, 0x0a, 0x07, 0x47,
-	0x61, 0x6d, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xa4, 0x01, 0x0a,
-	0x16, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x49,
-	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x4f, 0x75, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03,
-	0x6d, 0x61, 0x70, 0x1a, 0x4e, 0x0a, 0x08, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x20, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0xd6, 0x0a, 0x0a, 0x0e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53,
-	0x70, 0x69, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47,
-	0x65, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x1a, 0x14, 0x2e,
-	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x73,
-	0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47,
-	0x65, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x1a, 0x17, 0x2e,
-	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x53, 0x70, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x1a,
-	0x17, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
-	0x53, 0x70, 0x69, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x16, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63,
-	0x69, 0x61, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x1a, 0x1e, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x6f, 0x74, 0x61, 0x6c, 0x22, 0x99, 0x07, 0x0a, 0x06, 0x53, 0x70, 0x69, 0x6e, 0x49, 0x6e, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65,
+	0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
+	0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x61, 0x67, 0x65, 0x72, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x77, 0x61, 0x67, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62,
+	0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12, 0x2b, 0x0a,
+	0x11, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x70, 0x66, 0x72, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x50, 0x66, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x69,
+	0x73, 0x5f, 0x73, 0x68, 0x6f, 0x77, 0x6e, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69,
+	0x73, 0x53, 0x68, 0x6f, 0x77, 0x6e, 0x12, 0x1c, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6d,
+	0x6f, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x69, 0x73, 0x44, 0x65, 0x6d,
+	0x6f, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6d, 0x6f,
+	0x22, 0xd6, 0x07, 0x0a, 0x07, 0x53, 0x70, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x70, 0x12, 0x1d, 0x0a,
+	0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67,
+	0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x77, 0x61, 0x67, 0x65, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x77, 0x61,
+	0x67, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x77, 0x61, 0x72,
+	0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x41, 0x77, 0x61,
+	0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x61, 0x77, 0x61, 0x72,
+	0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x41, 0x77,
+	0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x77, 0x61,
+	0x72, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x41,
+	0x77, 0x61, 0x72, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x10, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x06, 0x69,
+	0x73, 0x5f, 0x70, 0x66, 0x72, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x05, 0x69,
+	0x73, 0x50, 0x66, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x73, 0x68,
+	0x6f, 0x77, 0x6e, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x07, 0x69, 0x73, 0x53,
+	0x68, 0x6f, 0x77, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x64, 0x65,
+	0x6d, 0x6f, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x06, 0x69, 0x73, 0x44, 0x65,
+	0x6d, 0x6f, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x69, 0x73, 0x5f, 0x70, 0x66, 0x72,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x73, 0x5f, 0x73, 0x68, 0x6f, 0x77, 0x6e, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6d, 0x6f, 0x22, 0x50, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x53, 0x70, 0x69, 0x6e, 0x49, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49,
+	0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x4d, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x49, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6a, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x42, 0x79, 0x57, 0x61, 0x67, 0x65, 0x72,
+	0x49, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x77, 0x61, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x77, 0x61, 0x67, 0x65, 0x72, 0x22, 0x4c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69,
+	0x6e, 0x4f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x70, 0x69,
+	0x6e, 0x4f, 0x75, 0x74, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73,
+	0x46, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x46,
+	0x6f, 0x75, 0x6e, 0x64, 0x22, 0x39, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53,
+	0x70, 0x69, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x2e, 0x53, 0x70, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
+	0x6f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x49, 0x6e, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x22, 0x7e, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x2e, 0x53, 0x70, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x22, 0x25, 0x0a, 0x0d, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4f, 0x75,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x1f, 0x0a, 0x07, 0x47, 0x61, 0x6d, 0x65, 0x73,
+	0x49, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x16, 0x49, 0x6e, 0x74,
+	0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x4f, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x75,
+	0x74, 0x2e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x1a,
+	0x4e, 0x0a, 0x08, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72,
+	0x79, 0x4f, 0x75, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x20, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x32, 0xe8, 0x0b, 0x0a, 0x0e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x73,
+	0x12, 0x17, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x1a, 0x14, 0x2e, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x22,
+	0x00, 0x12, 0x41, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x17, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x1a, 0x17, 0x2e, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4f,
+	0x75, 0x74, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x70,
+	0x69, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x69,
+	0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x1a, 0x17, 0x2e, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x70, 0x69, 0x6e,
+	0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x42,
+	0x61, 0x73, 0x65, 0x1a, 0x1e, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x61,
+	0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x42,
+	0x61, 0x73, 0x65, 0x1a, 0x1b, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x69,
+	0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x75, 0x74,
+	0x22, 0x00, 0x12, 0x69, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x47, 0x61, 0x6d, 0x65, 0x12,
+	0x23, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x1a, 0x25, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x42, 0x79, 0x47, 0x61, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x6f, 0x0a,
+	0x1c, 0x47, 0x65, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x23, 0x2e,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x1a, 0x28, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x42, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x46,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x53, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e,
+	0x1a, 0x12, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1b, 0x2e,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x1a, 0x12, 0x2e, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00,
+	0x12, 0x3e, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x15,
+	0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x1a, 0x17, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x00,
+	0x12, 0x3c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c,
+	0x42, 0x61, 0x73, 0x65, 0x1a, 0x16, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x44,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x41,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12,
 	0x16, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63,
-	0x69, 0x61, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x1a, 0x1b, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x67, 0x67,
-	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x47,
-	0x61, 0x6d, 0x65, 0x12, 0x23, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x25, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x47, 0x61, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x22,
-	0x00, 0x12, 0x6f, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
-	0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x23, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x41,
-	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x28, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74,
-	0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x15, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x1a, 0x17, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74,
-	0x22, 0x00, 0x12, 0x3c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x16,
-	0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x69,
-	0x61, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x1a, 0x16, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x22, 0x00,
-	0x12, 0x41, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65,
-	0x73, 0x12, 0x16, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x69, 0x6e, 0x61,
-	0x6e, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x1a, 0x16, 0x2e, 0x68, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x79, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4f, 0x75,
-	0x74, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x10, 0x2e,
-	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x49, 0x6e, 0x1a,
-	0x1f, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x75, 0x74,
-	0x22, 0x00, 0x12, 0x30, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x69, 0x6e,
+	0x69, 0x61, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x1a, 0x16, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x22,
+	0x00, 0x12, 0x4d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x49, 0x6e, 0x1a, 0x1f, 0x2e,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x22, 0x00,
+	0x12, 0x30, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x69, 0x6e, 0x12, 0x0f,
+	0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x70, 0x69, 0x6e, 0x49, 0x6e, 0x1a,
+	0x0f, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x00, 0x12, 0x30, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x70, 0x69, 0x6e,
 	0x12, 0x0f, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x70, 0x69, 0x6e, 0x49,
 	0x6e, 0x1a, 0x0f, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x70,
-	0x69, 0x6e, 0x12, 0x0f, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x70, 0x69,
-	0x6e, 0x49, 0x6e, 0x1a, 0x0f, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69,
-	0x6e, 0x12, 0x12, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x70, 0x69, 0x6e, 0x49, 0x6e, 0x1a, 0x13, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0b,
-	0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x12, 0x16, 0x2e, 0x68, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x70, 0x69,
+	0x75, 0x73, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x12,
+	0x12, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69,
 	0x6e, 0x49, 0x6e, 0x1a, 0x13, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x70, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x6e, 0x53, 0x70, 0x69,
-	0x6e, 0x73, 0x12, 0x16, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74,
-	0x4c, 0x61, 0x73, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x49, 0x6e, 0x1a, 0x18, 0x2e, 0x68, 0x69, 0x73,
+	0x74, 0x53, 0x70, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x12, 0x16, 0x2e, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x49,
+	0x6e, 0x1a, 0x13, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x70, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c,
+	0x61, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x6e, 0x53, 0x70, 0x69, 0x6e, 0x73,
+	0x12, 0x16, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61,
+	0x73, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x49, 0x6e, 0x1a, 0x18, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x73, 0x4f,
+	0x75, 0x74, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53,
+	0x70, 0x69, 0x6e, 0x42, 0x79, 0x57, 0x61, 0x67, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x68, 0x69, 0x73,
 	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x70, 0x69, 0x6e,
-	0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73,
-	0x74, 0x53, 0x70, 0x69, 0x6e, 0x42, 0x79, 0x57, 0x61, 0x67, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x68,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x70,
-	0x69, 0x6e, 0x42, 0x79, 0x57, 0x61, 0x67, 0x65, 0x72, 0x49, 0x6e, 0x1a, 0x13, 0x2e, 0x68, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x4f, 0x75, 0x74,
-	0x22, 0x00, 0x12, 0x53, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x73, 0x50, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x1a, 0x1d, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x42, 0x79, 0x57, 0x61, 0x67, 0x65, 0x72, 0x49, 0x6e, 0x1a, 0x13, 0x2e, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x00,
+	0x12, 0x53, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x73, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
 	0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0f, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x0f, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x0b,
-	0x5a, 0x09, 0x2e, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x49, 0x6e, 0x1a, 0x1d, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x70, 0x69, 0x6e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x12, 0x0f, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x0f, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x0b, 0x5a, 0x09,
+	0x2e, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -3051,7 +3367,7 @@ func file_pkg_history_main_proto_rawDescGZIP() []byte {
 	return file_pkg_history_main_proto_rawDescData
 }
 
-var file_pkg_history_main_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_pkg_history_main_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_pkg_history_main_proto_goTypes = []interface{}{
 	(*GetSessionIn)(nil),                     // 0: history.GetSessionIn
 	(*GetAggregatedReportFilters)(nil),       // 1: history.GetAggregatedReportFilters
@@ -3061,99 +3377,111 @@ var file_pkg_history_main_proto_goTypes = []interface{}{
 	(*GetAggregatedReportByCountryItem)(nil), // 5: history.GetAggregatedReportByCountryItem
 	(*FinancialReport)(nil),                  // 6: history.FinancialReport
 	(*FinancialReportOut)(nil),               // 7: history.FinancialReportOut
-	(*GetAllGameSessionsOut)(nil),            // 8: history.GetAllGameSessionsOut
-	(*GetAllSpinsOut)(nil),                   // 9: history.GetAllSpinsOut
-	(*GetFinancialIn)(nil),                   // 10: history.GetFinancialIn
-	(*FinancialBase)(nil),                    // 11: history.FinancialBase
-	(*Filters)(nil),                          // 12: history.Filters
-	(*GetSessionsOut)(nil),                   // 13: history.GetSessionsOut
-	(*GameSessionOut)(nil),                   // 14: history.GameSessionOut
-	(*GetSpinsOut)(nil),                      // 15: history.GetSpinsOut
-	(*SpinIn)(nil),                           // 16: history.SpinIn
-	(*SpinOut)(nil),                          // 17: history.SpinOut
-	(*GetSpinIn)(nil),                        // 18: history.GetSpinIn
-	(*GetLastSpinIn)(nil),                    // 19: history.GetLastSpinIn
-	(*GetLastSpinByWagerIn)(nil),             // 20: history.GetLastSpinByWagerIn
-	(*GetSpinOut)(nil),                       // 21: history.GetSpinOut
-	(*GetLastSpinsOut)(nil),                  // 22: history.GetLastSpinsOut
-	(*GetSpinPaginationIn)(nil),              // 23: history.GetSpinPaginationIn
-	(*GetSpinPaginationOut)(nil),             // 24: history.GetSpinPaginationOut
-	(*DictionaryOut)(nil),                    // 25: history.DictionaryOut
-	(*GamesIn)(nil),                          // 26: history.GamesIn
-	(*IntegratorsOperatorOut)(nil),           // 27: history.IntegratorsOperatorOut
-	(*Status)(nil),                           // 28: history.Status
-	nil,                                      // 29: history.IntegratorsOperatorOut.MapEntry
-	(*timestamppb.Timestamp)(nil),            // 30: google.protobuf.Timestamp
+	(*FinancialSeriesIn)(nil),                // 8: history.FinancialSeriesIn
+	(*AggregatedSeriesIn)(nil),               // 9: history.AggregatedSeriesIn
+	(*SeriesItem)(nil),                       // 10: history.SeriesItem
+	(*SeriesOut)(nil),                        // 11: history.SeriesOut
+	(*GetAllGameSessionsOut)(nil),            // 12: history.GetAllGameSessionsOut
+	(*GetAllSpinsOut)(nil),                   // 13: history.GetAllSpinsOut
+	(*GetFinancialIn)(nil),                   // 14: history.GetFinancialIn
+	(*FinancialBase)(nil),                    // 15: history.FinancialBase
+	(*Filters)(nil),                          // 16: history.Filters
+	(*GetSessionsOut)(nil),                   // 17: history.GetSessionsOut
+	(*GameSessionOut)(nil),                   // 18: history.GameSessionOut
+	(*GetSpinsOut)(nil),                      // 19: history.GetSpinsOut
+	(*SpinIn)(nil),                           // 20: history.SpinIn
+	(*SpinOut)(nil),                          // 21: history.SpinOut
+	(*GetSpinIn)(nil),                        // 22: history.GetSpinIn
+	(*GetLastSpinIn)(nil),                    // 23: history.GetLastSpinIn
+	(*GetLastSpinByWagerIn)(nil),             // 24: history.GetLastSpinByWagerIn
+	(*GetSpinOut)(nil),                       // 25: history.GetSpinOut
+	(*GetLastSpinsOut)(nil),                  // 26: history.GetLastSpinsOut
+	(*GetSpinPaginationIn)(nil),              // 27: history.GetSpinPaginationIn
+	(*GetSpinPaginationOut)(nil),             // 28: history.GetSpinPaginationOut
+	(*DictionaryOut)(nil),                    // 29: history.DictionaryOut
+	(*GamesIn)(nil),                          // 30: history.GamesIn
+	(*IntegratorsOperatorOut)(nil),           // 31: history.IntegratorsOperatorOut
+	(*Status)(nil),                           // 32: history.Status
+	nil,                                      // 33: history.IntegratorsOperatorOut.MapEntry
+	(*timestamppb.Timestamp)(nil),            // 34: google.protobuf.Timestamp
 }
 var file_pkg_history_main_proto_depIdxs = []int32{
-	30, // 0: history.GetAggregatedReportFilters.starting_from:type_name -> google.protobuf.Timestamp
-	30, // 1: history.GetAggregatedReportFilters.ending_at:type_name -> google.protobuf.Timestamp
+	34, // 0: history.GetAggregatedReportFilters.starting_from:type_name -> google.protobuf.Timestamp
+	34, // 1: history.GetAggregatedReportFilters.ending_at:type_name -> google.protobuf.Timestamp
 	4,  // 2: history.GetAggregatedReportByGameOut.items:type_name -> history.GetAggregatedReportByGameItem
 	5,  // 3: history.GetAggregatedReportByCountryOut.items:type_name -> history.GetAggregatedReportByCountryItem
 	6,  // 4: history.FinancialReportOut.report:type_name -> history.FinancialReport
-	14, // 5: history.GetAllGameSessionsOut.sessions:type_name -> history.GameSessionOut
-	17, // 6: history.GetAllSpinsOut.spins:type_name -> history.SpinOut
-	11, // 7: history.GetFinancialIn.base:type_name -> history.FinancialBase
-	12, // 8: history.FinancialBase.filters:type_name -> history.Filters
-	30, // 9: history.Filters.starting_from:type_name -> google.protobuf.Timestamp
-	30, // 10: history.Filters.ending_at:type_name -> google.protobuf.Timestamp
-	14, // 11: history.GetSessionsOut.items:type_name -> history.GameSessionOut
-	30, // 12: history.GameSessionOut.created_at:type_name -> google.protobuf.Timestamp
-	17, // 13: history.GameSessionOut.spins:type_name -> history.SpinOut
-	17, // 14: history.GetSpinsOut.items:type_name -> history.SpinOut
-	30, // 15: history.SpinIn.created_at:type_name -> google.protobuf.Timestamp
-	30, // 16: history.SpinIn.updated_at:type_name -> google.protobuf.Timestamp
-	30, // 17: history.SpinOut.created_at:type_name -> google.protobuf.Timestamp
-	30, // 18: history.SpinOut.updated_at:type_name -> google.protobuf.Timestamp
-	17, // 19: history.GetSpinOut.item:type_name -> history.SpinOut
-	17, // 20: history.GetLastSpinsOut.items:type_name -> history.SpinOut
-	19, // 21: history.GetSpinPaginationIn.filter:type_name -> history.GetLastSpinIn
-	17, // 22: history.GetSpinPaginationOut.items:type_name -> history.SpinOut
-	29, // 23: history.IntegratorsOperatorOut.map:type_name -> history.IntegratorsOperatorOut.MapEntry
-	25, // 24: history.IntegratorsOperatorOut.MapEntry.value:type_name -> history.DictionaryOut
-	10, // 25: history.HistoryService.GetSpins:input_type -> history.GetFinancialIn
-	10, // 26: history.HistoryService.GetSessions:input_type -> history.GetFinancialIn
-	11, // 27: history.HistoryService.GetAllSpins:input_type -> history.FinancialBase
-	11, // 28: history.HistoryService.GetAllGameSession:input_type -> history.FinancialBase
-	11, // 29: history.HistoryService.GetFinancialReport:input_type -> history.FinancialBase
-	1,  // 30: history.HistoryService.GetAggregatedReportByGame:input_type -> history.GetAggregatedReportFilters
-	1,  // 31: history.HistoryService.GetAggregatedReportByCountry:input_type -> history.GetAggregatedReportFilters
-	0,  // 32: history.HistoryService.GetSession:input_type -> history.GetSessionIn
-	11, // 33: history.HistoryService.GetHosts:input_type -> history.FinancialBase
-	11, // 34: history.HistoryService.GetCurrencies:input_type -> history.FinancialBase
-	26, // 35: history.HistoryService.GetIntegratorOperators:input_type -> history.GamesIn
-	16, // 36: history.HistoryService.CreateSpin:input_type -> history.SpinIn
-	16, // 37: history.HistoryService.UpdateSpin:input_type -> history.SpinIn
-	18, // 38: history.HistoryService.GetSpin:input_type -> history.GetSpinIn
-	19, // 39: history.HistoryService.GetLastSpin:input_type -> history.GetLastSpinIn
-	19, // 40: history.HistoryService.GetLastNotShownSpins:input_type -> history.GetLastSpinIn
-	20, // 41: history.HistoryService.GetLastSpinByWager:input_type -> history.GetLastSpinByWagerIn
-	23, // 42: history.HistoryService.GetSpinsPagination:input_type -> history.GetSpinPaginationIn
-	28, // 43: history.HistoryService.HealthCheck:input_type -> history.Status
-	15, // 44: history.HistoryService.GetSpins:output_type -> history.GetSpinsOut
-	13, // 45: history.HistoryService.GetSessions:output_type -> history.GetSessionsOut
-	9,  // 46: history.HistoryService.GetAllSpins:output_type -> history.GetAllSpinsOut
-	8,  // 47: history.HistoryService.GetAllGameSession:output_type -> history.GetAllGameSessionsOut
-	7,  // 48: history.HistoryService.GetFinancialReport:output_type -> history.FinancialReportOut
-	2,  // 49: history.HistoryService.GetAggregatedReportByGame:output_type -> history.GetAggregatedReportByGameOut
-	3,  // 50: history.HistoryService.GetAggregatedReportByCountry:output_type -> history.GetAggregatedReportByCountryOut
-	14, // 51: history.HistoryService.GetSession:output_type -> history.GameSessionOut
-	25, // 52: history.HistoryService.GetHosts:output_type -> history.DictionaryOut
-	25, // 53: history.HistoryService.GetCurrencies:output_type -> history.DictionaryOut
-	27, // 54: history.HistoryService.GetIntegratorOperators:output_type -> history.IntegratorsOperatorOut
-	28, // 55: history.HistoryService.CreateSpin:output_type -> history.Status
-	28, // 56: history.HistoryService.UpdateSpin:output_type -> history.Status
-	21, // 57: history.HistoryService.GetSpin:output_type -> history.GetSpinOut
-	21, // 58: history.HistoryService.GetLastSpin:output_type -> history.GetSpinOut
-	22, // 59: history.HistoryService.GetLastNotShownSpins:output_type -> history.GetLastSpinsOut
-	21, // 60: history.HistoryService.GetLastSpinByWager:output_type -> history.GetSpinOut
-	24, // 61: history.HistoryService.GetSpinsPagination:output_type -> history.GetSpinPaginationOut
-	28, // 62: history.HistoryService.HealthCheck:output_type -> history.Status
-	44, // [44:63] is the sub-list for method output_type
-	25, // [25:44] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	15, // 5: history.FinancialSeriesIn.base:type_name -> history.FinancialBase
+	1,  // 6: history.AggregatedSeriesIn.filters:type_name -> history.GetAggregatedReportFilters
+	34, // 7: history.SeriesItem.bucket:type_name -> google.protobuf.Timestamp
+	10, // 8: history.SeriesOut.items:type_name -> history.SeriesItem
+	18, // 9: history.GetAllGameSessionsOut.sessions:type_name -> history.GameSessionOut
+	21, // 10: history.GetAllSpinsOut.spins:type_name -> history.SpinOut
+	15, // 11: history.GetFinancialIn.base:type_name -> history.FinancialBase
+	16, // 12: history.FinancialBase.filters:type_name -> history.Filters
+	34, // 13: history.Filters.starting_from:type_name -> google.protobuf.Timestamp
+	34, // 14: history.Filters.ending_at:type_name -> google.protobuf.Timestamp
+	18, // 15: history.GetSessionsOut.items:type_name -> history.GameSessionOut
+	34, // 16: history.GameSessionOut.created_at:type_name -> google.protobuf.Timestamp
+	21, // 17: history.GameSessionOut.spins:type_name -> history.SpinOut
+	21, // 18: history.GetSpinsOut.items:type_name -> history.SpinOut
+	34, // 19: history.SpinIn.created_at:type_name -> google.protobuf.Timestamp
+	34, // 20: history.SpinIn.updated_at:type_name -> google.protobuf.Timestamp
+	34, // 21: history.SpinOut.created_at:type_name -> google.protobuf.Timestamp
+	34, // 22: history.SpinOut.updated_at:type_name -> google.protobuf.Timestamp
+	21, // 23: history.GetSpinOut.item:type_name -> history.SpinOut
+	21, // 24: history.GetLastSpinsOut.items:type_name -> history.SpinOut
+	23, // 25: history.GetSpinPaginationIn.filter:type_name -> history.GetLastSpinIn
+	21, // 26: history.GetSpinPaginationOut.items:type_name -> history.SpinOut
+	33, // 27: history.IntegratorsOperatorOut.map:type_name -> history.IntegratorsOperatorOut.MapEntry
+	29, // 28: history.IntegratorsOperatorOut.MapEntry.value:type_name -> history.DictionaryOut
+	14, // 29: history.HistoryService.GetSpins:input_type -> history.GetFinancialIn
+	14, // 30: history.HistoryService.GetSessions:input_type -> history.GetFinancialIn
+	15, // 31: history.HistoryService.GetAllSpins:input_type -> history.FinancialBase
+	15, // 32: history.HistoryService.GetAllGameSession:input_type -> history.FinancialBase
+	15, // 33: history.HistoryService.GetFinancialReport:input_type -> history.FinancialBase
+	1,  // 34: history.HistoryService.GetAggregatedReportByGame:input_type -> history.GetAggregatedReportFilters
+	1,  // 35: history.HistoryService.GetAggregatedReportByCountry:input_type -> history.GetAggregatedReportFilters
+	8,  // 36: history.HistoryService.GetFinancialSeries:input_type -> history.FinancialSeriesIn
+	9,  // 37: history.HistoryService.GetAggregatedSeries:input_type -> history.AggregatedSeriesIn
+	0,  // 38: history.HistoryService.GetSession:input_type -> history.GetSessionIn
+	15, // 39: history.HistoryService.GetHosts:input_type -> history.FinancialBase
+	15, // 40: history.HistoryService.GetCurrencies:input_type -> history.FinancialBase
+	30, // 41: history.HistoryService.GetIntegratorOperators:input_type -> history.GamesIn
+	20, // 42: history.HistoryService.CreateSpin:input_type -> history.SpinIn
+	20, // 43: history.HistoryService.UpdateSpin:input_type -> history.SpinIn
+	22, // 44: history.HistoryService.GetSpin:input_type -> history.GetSpinIn
+	23, // 45: history.HistoryService.GetLastSpin:input_type -> history.GetLastSpinIn
+	23, // 46: history.HistoryService.GetLastNotShownSpins:input_type -> history.GetLastSpinIn
+	24, // 47: history.HistoryService.GetLastSpinByWager:input_type -> history.GetLastSpinByWagerIn
+	27, // 48: history.HistoryService.GetSpinsPagination:input_type -> history.GetSpinPaginationIn
+	32, // 49: history.HistoryService.HealthCheck:input_type -> history.Status
+	19, // 50: history.HistoryService.GetSpins:output_type -> history.GetSpinsOut
+	17, // 51: history.HistoryService.GetSessions:output_type -> history.GetSessionsOut
+	13, // 52: history.HistoryService.GetAllSpins:output_type -> history.GetAllSpinsOut
+	12, // 53: history.HistoryService.GetAllGameSession:output_type -> history.GetAllGameSessionsOut
+	7,  // 54: history.HistoryService.GetFinancialReport:output_type -> history.FinancialReportOut
+	2,  // 55: history.HistoryService.GetAggregatedReportByGame:output_type -> history.GetAggregatedReportByGameOut
+	3,  // 56: history.HistoryService.GetAggregatedReportByCountry:output_type -> history.GetAggregatedReportByCountryOut
+	11, // 57: history.HistoryService.GetFinancialSeries:output_type -> history.SeriesOut
+	11, // 58: history.HistoryService.GetAggregatedSeries:output_type -> history.SeriesOut
+	18, // 59: history.HistoryService.GetSession:output_type -> history.GameSessionOut
+	29, // 60: history.HistoryService.GetHosts:output_type -> history.DictionaryOut
+	29, // 61: history.HistoryService.GetCurrencies:output_type -> history.DictionaryOut
+	31, // 62: history.HistoryService.GetIntegratorOperators:output_type -> history.IntegratorsOperatorOut
+	32, // 63: history.HistoryService.CreateSpin:output_type -> history.Status
+	32, // 64: history.HistoryService.UpdateSpin:output_type -> history.Status
+	25, // 65: history.HistoryService.GetSpin:output_type -> history.GetSpinOut
+	25, // 66: history.HistoryService.GetLastSpin:output_type -> history.GetSpinOut
+	26, // 67: history.HistoryService.GetLastNotShownSpins:output_type -> history.GetLastSpinsOut
+	25, // 68: history.HistoryService.GetLastSpinByWager:output_type -> history.GetSpinOut
+	28, // 69: history.HistoryService.GetSpinsPagination:output_type -> history.GetSpinPaginationOut
+	32, // 70: history.HistoryService.HealthCheck:output_type -> history.Status
+	50, // [50:71] is the sub-list for method output_type
+	29, // [29:50] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_pkg_history_main_proto_init() }
@@ -3259,7 +3587,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllGameSessionsOut); i {
+			switch v := v.(*FinancialSeriesIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3271,7 +3599,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllSpinsOut); i {
+			switch v := v.(*AggregatedSeriesIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3283,7 +3611,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFinancialIn); i {
+			switch v := v.(*SeriesItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3295,7 +3623,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FinancialBase); i {
+			switch v := v.(*SeriesOut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3307,7 +3635,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Filters); i {
+			switch v := v.(*GetAllGameSessionsOut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3319,7 +3647,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSessionsOut); i {
+			switch v := v.(*GetAllSpinsOut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3331,7 +3659,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameSessionOut); i {
+			switch v := v.(*GetFinancialIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3343,7 +3671,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSpinsOut); i {
+			switch v := v.(*FinancialBase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3355,7 +3683,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SpinIn); i {
+			switch v := v.(*Filters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3367,7 +3695,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SpinOut); i {
+			switch v := v.(*GetSessionsOut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3379,7 +3707,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSpinIn); i {
+			switch v := v.(*GameSessionOut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3391,7 +3719,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastSpinIn); i {
+			switch v := v.(*GetSpinsOut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3403,7 +3731,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastSpinByWagerIn); i {
+			switch v := v.(*SpinIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3415,7 +3743,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSpinOut); i {
+			switch v := v.(*SpinOut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3427,7 +3755,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastSpinsOut); i {
+			switch v := v.(*GetSpinIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3439,7 +3767,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSpinPaginationIn); i {
+			switch v := v.(*GetLastSpinIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3451,7 +3779,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSpinPaginationOut); i {
+			switch v := v.(*GetLastSpinByWagerIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3463,7 +3791,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DictionaryOut); i {
+			switch v := v.(*GetSpinOut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3475,7 +3803,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GamesIn); i {
+			switch v := v.(*GetLastSpinsOut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3487,7 +3815,7 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IntegratorsOperatorOut); i {
+			switch v := v.(*GetSpinPaginationIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3499,6 +3827,54 @@ func file_pkg_history_main_proto_init() {
 			}
 		}
 		file_pkg_history_main_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSpinPaginationOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_history_main_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictionaryOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_history_main_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GamesIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_history_main_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntegratorsOperatorOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_history_main_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Status); i {
 			case 0:
 				return &v.state
@@ -3512,16 +3888,16 @@ func file_pkg_history_main_proto_init() {
 		}
 	}
 	file_pkg_history_main_proto_msgTypes[1].OneofWrappers = []interface{}{}
-	file_pkg_history_main_proto_msgTypes[12].OneofWrappers = []interface{}{}
 	file_pkg_history_main_proto_msgTypes[16].OneofWrappers = []interface{}{}
-	file_pkg_history_main_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_pkg_history_main_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_pkg_history_main_proto_msgTypes[21].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_history_main_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   30,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
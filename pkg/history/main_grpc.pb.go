@@ -26,6 +26,8 @@ const (
 	HistoryService_GetFinancialReport_FullMethodName           = "/history.HistoryService/GetFinancialReport"
 	HistoryService_GetAggregatedReportByGame_FullMethodName    = "/history.HistoryService/GetAggregatedReportByGame"
 	HistoryService_GetAggregatedReportByCountry_FullMethodName = "/history.HistoryService/GetAggregatedReportByCountry"
+	HistoryService_GetFinancialSeries_FullMethodName           = "/history.HistoryService/GetFinancialSeries"
+	HistoryService_GetAggregatedSeries_FullMethodName          = "/history.HistoryService/GetAggregatedSeries"
 	HistoryService_GetSession_FullMethodName                   = "/history.HistoryService/GetSession"
 	HistoryService_GetHosts_FullMethodName                     = "/history.HistoryService/GetHosts"
 	HistoryService_GetCurrencies_FullMethodName                = "/history.HistoryService/GetCurrencies"
@@ -51,6 +53,8 @@ type HistoryServiceClient interface {
 	GetFinancialReport(ctx context.Context, in *FinancialBase, opts ...grpc.CallOption) (*FinancialReportOut, error)
 	GetAggregatedReportByGame(ctx context.Context, in *GetAggregatedReportFilters, opts ...grpc.CallOption) (*GetAggregatedReportByGameOut, error)
 	GetAggregatedReportByCountry(ctx context.Context, in *GetAggregatedReportFilters, opts ...grpc.CallOption) (*GetAggregatedReportByCountryOut, error)
+	GetFinancialSeries(ctx context.Context, in *FinancialSeriesIn, opts ...grpc.CallOption) (*SeriesOut, error)
+	GetAggregatedSeries(ctx context.Context, in *AggregatedSeriesIn, opts ...grpc.CallOption) (*SeriesOut, error)
 	GetSession(ctx context.Context, in *GetSessionIn, opts ...grpc.CallOption) (*GameSessionOut, error)
 	GetHosts(ctx context.Context, in *FinancialBase, opts ...grpc.CallOption) (*DictionaryOut, error)
 	GetCurrencies(ctx context.Context, in *FinancialBase, opts ...grpc.CallOption) (*DictionaryOut, error)
@@ -137,6 +141,24 @@ func (c *historyServiceClient) GetAggregatedReportByCountry(ctx context.Context,
 	return out, nil
 }
 
+func (c *historyServiceClient) GetFinancialSeries(ctx context.Context, in *FinancialSeriesIn, opts ...grpc.CallOption) (*SeriesOut, error) {
+	out := new(SeriesOut)
+	err := c.cc.Invoke(ctx, HistoryService_GetFinancialSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) GetAggregatedSeries(ctx context.Context, in *AggregatedSeriesIn, opts ...grpc.CallOption) (*SeriesOut, error) {
+	out := new(SeriesOut)
+	err := c.cc.Invoke(ctx, HistoryService_GetAggregatedSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) GetSession(ctx context.Context, in *GetSessionIn, opts ...grpc.CallOption) (*GameSessionOut, error) {
 	out := new(GameSessionOut)
 	err := c.cc.Invoke(ctx, HistoryService_GetSession_FullMethodName, in, out, opts...)
@@ -278,6 +300,8 @@ type HistoryServiceServer interface {
 	GetFinancialReport(context.Context, *FinancialBase) (*FinancialReportOut, error)
 	GetAggregatedReportByGame(context.Context, *GetAggregatedReportFilters) (*GetAggregatedReportByGameOut, error)
 	GetAggregatedReportByCountry(context.Context, *GetAggregatedReportFilters) (*GetAggregatedReportByCountryOut, error)
+	GetFinancialSeries(context.Context, *FinancialSeriesIn) (*SeriesOut, error)
+	GetAggregatedSeries(context.Context, *AggregatedSeriesIn) (*SeriesOut, error)
 	GetSession(context.Context, *GetSessionIn) (*GameSessionOut, error)
 	GetHosts(context.Context, *FinancialBase) (*DictionaryOut, error)
 	GetCurrencies(context.Context, *FinancialBase) (*DictionaryOut, error)
@@ -319,6 +343,12 @@ func (UnimplementedHistoryServiceServer) GetAggregatedReportByGame(context.Conte
 func (UnimplementedHistoryServiceServer) GetAggregatedReportByCountry(context.Context, *GetAggregatedReportFilters) (*GetAggregatedReportByCountryOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAggregatedReportByCountry not implemented")
 }
+func (UnimplementedHistoryServiceServer) GetFinancialSeries(context.Context, *FinancialSeriesIn) (*SeriesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFinancialSeries not implemented")
+}
+func (UnimplementedHistoryServiceServer) GetAggregatedSeries(context.Context, *AggregatedSeriesIn) (*SeriesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAggregatedSeries not implemented")
+}
 func (UnimplementedHistoryServiceServer) GetSession(context.Context, *GetSessionIn) (*GameSessionOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSession not implemented")
 }
@@ -494,6 +524,42 @@ func _HistoryService_GetAggregatedReportByCountry_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_GetFinancialSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinancialSeriesIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).GetFinancialSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_GetFinancialSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).GetFinancialSeries(ctx, req.(*FinancialSeriesIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_GetAggregatedSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregatedSeriesIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).GetAggregatedSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_GetAggregatedSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).GetAggregatedSeries(ctx, req.(*AggregatedSeriesIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_GetSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSessionIn)
 	if err := dec(in); err != nil {
@@ -753,6 +819,14 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAggregatedReportByCountry",
 			Handler:    _HistoryService_GetAggregatedReportByCountry_Handler,
 		},
+		{
+			MethodName: "GetFinancialSeries",
+			Handler:    _HistoryService_GetFinancialSeries_Handler,
+		},
+		{
+			MethodName: "GetAggregatedSeries",
+			Handler:    _HistoryService_GetAggregatedSeries_Handler,
+		},
 		{
 			MethodName: "GetSession",
 			Handler:    _HistoryService_GetSession_Handler,
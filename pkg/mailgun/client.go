@@ -7,6 +7,12 @@ import (
 	"time"
 )
 
+// Attachment is an in-memory attachment with its own file name.
+type Attachment struct {
+	Name string
+	Data []byte
+}
+
 type Client struct {
 	cfg *Config
 	mg  *mailgun.MailgunImpl
@@ -34,6 +40,10 @@ func (c *Client) Send(subject, to, from, text string, template *string, attachme
 		if _, ok := attachment.([]byte); ok {
 			message.AddBufferAttachment(subject, attachment.([]byte))
 		}
+
+		if a, ok := attachment.(Attachment); ok {
+			message.AddBufferAttachment(a.Name, a.Data)
+		}
 	}
 
 	if template != nil {
@@ -0,0 +1,19 @@
+package oidc
+
+import "time"
+
+type Config struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	// GroupsClaim is the id token claim listing groups of the user, "groups" when empty.
+	GroupsClaim string
+	Timeout     time.Duration
+}
+
+// Enabled reports whether single sign-on is configured at all.
+func (c *Config) Enabled() bool {
+	return c != nil && c.Issuer != ""
+}
@@ -0,0 +1,285 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	clientID     = "backoffice"
+	clientSecret = "secret"
+	redirectURL  = "https://backoffice.test/api/auth/oidc/callback"
+	keyID        = "test-key"
+)
+
+// issuer is a minimal OpenID Connect provider: it remembers the PKCE challenge of every issued code and
+// answers the token request with an id token built by the test case.
+type issuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	mu         sync.Mutex
+	challenges map[string]string
+	idToken    func() string
+}
+
+func newIssuer(t *testing.T) *issuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	i := &issuer{key: key, challenges: map[string]string{}}
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(&Metadata{
+			Issuer:                i.server.URL,
+			AuthorizationEndpoint: i.server.URL + "/authorize",
+			TokenEndpoint:         i.server.URL + "/token",
+			JWKSURI:               i.server.URL + "/jwks",
+			CodeChallengeMethods:  []string{"S256"},
+		})
+	})
+
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(&jwks{Keys: []*jwk{{
+			Kid: keyID,
+			Kty: "RSA",
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		id, secret, _ := r.BasicAuth()
+		if id != clientID || secret != clientSecret {
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(&tokenError{Error: "invalid_client"})
+
+			return
+		}
+
+		i.mu.Lock()
+		challenge, ok := i.challenges[r.PostFormValue("code")]
+		delete(i.challenges, r.PostFormValue("code"))
+		i.mu.Unlock()
+
+		if !ok || r.PostFormValue("redirect_uri") != redirectURL || CodeChallenge(r.PostFormValue("code_verifier")) != challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(&tokenError{Error: "invalid_grant"})
+
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(&Token{AccessToken: "access", TokenType: "Bearer", IDToken: i.idToken()})
+	})
+
+	i.server = httptest.NewServer(mux)
+	t.Cleanup(i.server.Close)
+
+	return i
+}
+
+// authorize stands in for the user logging in at the issuer, it returns the code sent to the callback.
+func (i *issuer) authorize(t *testing.T, authURL string) string {
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if u.Query().Get("code_challenge_method") != "S256" {
+		t.Fatalf("unexpected code challenge method %q", u.Query().Get("code_challenge_method"))
+	}
+
+	code := "code-" + u.Query().Get("state")
+
+	i.mu.Lock()
+	i.challenges[code] = u.Query().Get("code_challenge")
+	i.mu.Unlock()
+
+	return code
+}
+
+func (i *issuer) sign(t *testing.T, key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = keyID
+
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signed
+}
+
+func (i *issuer) claims(nonce string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":            i.server.URL,
+		"aud":            clientID,
+		"sub":            "user-1",
+		"exp":            time.Now().Add(time.Minute).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          nonce,
+		"email":          "user@example.com",
+		"email_verified": true,
+		"groups":         []string{"finance", "support"},
+	}
+}
+
+func TestProvider(t *testing.T) {
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		token   func(i *issuer, claims jwt.MapClaims) string
+		wantErr error
+	}{
+		{
+			name: "success",
+		},
+		{
+			name: "nonce mismatch",
+			token: func(i *issuer, claims jwt.MapClaims) string {
+				claims["nonce"] = "another"
+
+				return i.sign(t, i.key, claims)
+			},
+			wantErr: ErrNonceMismatch,
+		},
+		{
+			name: "wrong audience",
+			token: func(i *issuer, claims jwt.MapClaims) string {
+				claims["aud"] = "someone-else"
+
+				return i.sign(t, i.key, claims)
+			},
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name: "wrong issuer",
+			token: func(i *issuer, claims jwt.MapClaims) string {
+				claims["iss"] = "https://evil.test"
+
+				return i.sign(t, i.key, claims)
+			},
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name: "expired",
+			token: func(i *issuer, claims jwt.MapClaims) string {
+				claims["exp"] = time.Now().Add(-time.Minute).Unix()
+
+				return i.sign(t, i.key, claims)
+			},
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name: "foreign signature",
+			token: func(i *issuer, claims jwt.MapClaims) string {
+				return i.sign(t, other, claims)
+			},
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name: "unsigned",
+			token: func(i *issuer, claims jwt.MapClaims) string {
+				signed, _ := jwt.NewWithClaims(jwt.SigningMethodNone, claims).SignedString(jwt.UnsafeAllowNoneSignatureType)
+
+				return signed
+			},
+			wantErr: ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := newIssuer(t)
+			provider := New(&Config{
+				Issuer:       i.server.URL,
+				ClientID:     clientID,
+				ClientSecret: clientSecret,
+				RedirectURL:  redirectURL,
+				Scopes:       []string{"email", "profile"},
+			})
+
+			state, _ := RandomString(16)
+			nonce, _ := RandomString(16)
+			verifier, _ := RandomString(32)
+
+			i.idToken = func() string {
+				if tt.token != nil {
+					return tt.token(i, i.claims(nonce))
+				}
+
+				return i.sign(t, i.key, i.claims(nonce))
+			}
+
+			authURL, err := provider.AuthCodeURL(context.Background(), state, nonce, verifier)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			token, err := provider.Exchange(context.Background(), i.authorize(t, authURL), verifier)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			claims, err := provider.Verify(context.Background(), token.IDToken, nonce)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if claims.Subject != "user-1" || claims.Email != "user@example.com" || claims.EmailVerified == nil || !*claims.EmailVerified {
+				t.Fatalf("unexpected claims %+v", claims)
+			}
+
+			if len(claims.Groups) != 2 || claims.Groups[0] != "finance" || claims.Groups[1] != "support" {
+				t.Fatalf("unexpected groups %v", claims.Groups)
+			}
+		})
+	}
+}
+
+func TestProviderPKCE(t *testing.T) {
+	i := newIssuer(t)
+	provider := New(&Config{Issuer: i.server.URL, ClientID: clientID, ClientSecret: clientSecret, RedirectURL: redirectURL})
+
+	verifier, _ := RandomString(32)
+	authURL, err := provider.AuthCodeURL(context.Background(), "state", "nonce", verifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code := i.authorize(t, authURL)
+
+	other, _ := RandomString(32)
+	if _, err = provider.Exchange(context.Background(), code, other); err == nil {
+		t.Fatal("expected the exchange to fail with another code verifier")
+	}
+}
@@ -0,0 +1,220 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTimeout     = 10 * time.Second
+	defaultGroupsClaim = "groups"
+	metadataLifetime   = time.Hour
+)
+
+var (
+	ErrIssuerMismatch = errors.New("discovery document is issued for another issuer")
+	ErrNoIDToken      = errors.New("token response does not contain an id token")
+)
+
+// Metadata is the part of the discovery document the authorization code flow needs.
+type Metadata struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint"`
+	TokenEndpoint         string   `json:"token_endpoint"`
+	JWKSURI               string   `json:"jwks_uri"`
+	CodeChallengeMethods  []string `json:"code_challenge_methods_supported"`
+}
+
+type Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IDToken     string `json:"id_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+type tokenError struct {
+	Error       string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+// Provider talks to one OpenID Connect issuer, the discovery document and the signing keys are fetched
+// lazily and cached.
+type Provider struct {
+	cfg    *Config
+	client *http.Client
+
+	mu         sync.Mutex
+	metadata   *Metadata
+	fetchedAt  time.Time
+	keys       map[string]interface{}
+	keysLoaded time.Time
+}
+
+func New(cfg *Config) *Provider {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	return &Provider{
+		cfg:    cfg,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Metadata returns the discovery document of the issuer.
+func (p *Provider) Metadata(ctx context.Context) (*Metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.metadata != nil && time.Since(p.fetchedAt) < metadataLifetime {
+		return p.metadata, nil
+	}
+
+	metadata := &Metadata{}
+	if err := p.get(ctx, strings.TrimSuffix(p.cfg.Issuer, "/")+"/.well-known/openid-configuration", metadata); err != nil {
+		return nil, fmt.Errorf("oidc discovery: %w", err)
+	}
+
+	if strings.TrimSuffix(metadata.Issuer, "/") != strings.TrimSuffix(p.cfg.Issuer, "/") {
+		return nil, ErrIssuerMismatch
+	}
+
+	p.metadata, p.fetchedAt = metadata, time.Now()
+
+	return metadata, nil
+}
+
+// AuthCodeURL is the authorization endpoint url the user agent is redirected to.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	metadata, err := p.Metadata(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(metadata.AuthorizationEndpoint)
+	if err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.cfg.ClientID)
+	query.Set("redirect_uri", p.cfg.RedirectURL)
+	query.Set("scope", strings.Join(p.scopes(), " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", CodeChallenge(verifier))
+	query.Set("code_challenge_method", "S256")
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// Exchange redeems the authorization code at the token endpoint.
+func (p *Provider) Exchange(ctx context.Context, code, verifier string) (*Token, error) {
+	metadata, err := p.Metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.cfg.RedirectURL},
+		"client_id":     {p.cfg.ClientID},
+		"code_verifier": {verifier},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	if p.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		te := &tokenError{}
+		if json.Unmarshal(body, te) == nil && te.Error != "" {
+			return nil, fmt.Errorf("oidc token exchange: %s %s", te.Error, te.Description)
+		}
+
+		return nil, fmt.Errorf("oidc token exchange: unexpected status %d", res.StatusCode)
+	}
+
+	token := &Token{}
+	if err = json.Unmarshal(body, token); err != nil {
+		return nil, err
+	}
+
+	if token.IDToken == "" {
+		return nil, ErrNoIDToken
+	}
+
+	return token, nil
+}
+
+func (p *Provider) scopes() []string {
+	scopes := []string{"openid"}
+
+	for _, scope := range p.cfg.Scopes {
+		if scope != "openid" {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	return scopes
+}
+
+func (p *Provider) groupsClaim() string {
+	if p.cfg.GroupsClaim == "" {
+		return defaultGroupsClaim
+	}
+
+	return p.cfg.GroupsClaim
+}
+
+func (p *Provider) get(ctx context.Context, u string, to interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", res.StatusCode, u)
+	}
+
+	return json.NewDecoder(io.LimitReader(res.Body, 1<<20)).Decode(to)
+}
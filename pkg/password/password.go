@@ -0,0 +1,68 @@
+// Package password holds the breached password wordlist checked by password policies.
+package password
+
+import (
+	"bufio"
+	_ "embed"
+	"io"
+	"os"
+	"strings"
+)
+
+//go:embed breached.txt
+var breached string
+
+// Wordlist is a set of breached passwords, lookups ignore case and surrounding spaces.
+type Wordlist struct {
+	words map[string]struct{}
+}
+
+// Builtin returns the short list of the most common breached passwords shipped with the service.
+func Builtin() *Wordlist {
+	w, _ := read(strings.NewReader(breached))
+
+	return w
+}
+
+// LoadWordlist reads a file with one password per line, the builtin list is used when path is empty.
+func LoadWordlist(path string) (*Wordlist, error) {
+	if path == "" {
+		return Builtin(), nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return read(f)
+}
+
+func (w *Wordlist) Contains(password string) bool {
+	_, ok := w.words[normalize(password)]
+
+	return ok
+}
+
+func read(r io.Reader) (*Wordlist, error) {
+	w := &Wordlist{words: map[string]struct{}{}}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if word := normalize(scanner.Text()); word != "" {
+			w.words[word] = struct{}{}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func normalize(password string) string {
+	return strings.ToLower(strings.TrimSpace(password))
+}
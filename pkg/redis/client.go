@@ -36,6 +36,11 @@ func (c *Client) Get(ctx context.Context, key string) ([]byte, error) {
 	return c.redis.WithContext(ctx).Get(ctx, c.PrepareKey(c.cfg.Prefix, key)).Bytes()
 }
 
+// GetDel returns the value and removes the key in one step, so the value is read at most once.
+func (c *Client) GetDel(ctx context.Context, key string) ([]byte, error) {
+	return c.redis.WithContext(ctx).GetDel(ctx, c.PrepareKey(c.cfg.Prefix, key)).Bytes()
+}
+
 func (c *Client) Del(ctx context.Context, keys ...string) error {
 	for i, v := range keys {
 		keys[i] = c.PrepareKey(c.cfg.Prefix, v)
@@ -43,6 +48,26 @@ func (c *Client) Del(ctx context.Context, keys ...string) error {
 	return c.redis.WithContext(ctx).Del(ctx, keys...).Err()
 }
 
+// Incr increments the counter and moves its expiry, so it lives expiration after the last increment.
+func (c *Client) Incr(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	key = c.PrepareKey(c.cfg.Prefix, key)
+
+	pipe := c.redis.WithContext(ctx).TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, expiration)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	return incr.Val(), nil
+}
+
+// TTL is the remaining lifetime of the key, it is negative when the key does not exist or never expires.
+func (c *Client) TTL(ctx context.Context, key string) (time.Duration, error) {
+	return c.redis.WithContext(ctx).TTL(ctx, c.PrepareKey(c.cfg.Prefix, key)).Result()
+}
+
 func (c *Client) PrepareKey(prefix, key string) string {
 	return fmt.Sprintf("%s:%s", prefix, key)
 }
@@ -58,3 +83,20 @@ func (c *Client) HRange(ctx context.Context, key string) ([]string, error) {
 func (c *Client) HDelete(ctx context.Context, key, field string) error {
 	return c.redis.WithContext(ctx).HDel(ctx, c.PrepareKey(c.cfg.Prefix, key), field).Err()
 }
+
+func (c *Client) Publish(ctx context.Context, channel string, message interface{}) error {
+	return c.redis.WithContext(ctx).Publish(ctx, c.PrepareKey(c.cfg.Prefix, channel), message).Err()
+}
+
+// Subscribe waits for the subscription to be confirmed, so nothing published afterwards is missed.
+func (c *Client) Subscribe(ctx context.Context, channel string) (*redis.PubSub, error) {
+	sub := c.redis.WithContext(ctx).Subscribe(ctx, c.PrepareKey(c.cfg.Prefix, channel))
+
+	if _, err := sub.Receive(ctx); err != nil {
+		_ = sub.Close()
+
+		return nil, err
+	}
+
+	return sub, nil
+}
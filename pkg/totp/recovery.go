@@ -0,0 +1,45 @@
+package totp
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+)
+
+// recoveryCodeAlphabet leaves out characters that are easily confused when typed by hand.
+const (
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+	recoveryCodeLength   = 10
+)
+
+// RecoveryCode generates a one-time code formatted as xxxxx-xxxxx.
+func RecoveryCode() (string, error) {
+	size := big.NewInt(int64(len(recoveryCodeAlphabet)))
+	code := make([]byte, 0, recoveryCodeLength+1)
+
+	for i := 0; i < recoveryCodeLength; i++ {
+		if i == recoveryCodeLength/2 {
+			code = append(code, '-')
+		}
+
+		n, err := rand.Int(rand.Reader, size)
+		if err != nil {
+			return "", err
+		}
+
+		code = append(code, recoveryCodeAlphabet[n.Int64()])
+	}
+
+	return string(code), nil
+}
+
+// NormalizeRecoveryCode drops separators and case, so codes typed by hand match the generated ones.
+func NormalizeRecoveryCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+
+		return r
+	}, strings.ToLower(strings.TrimSpace(code)))
+}
@@ -18,6 +18,7 @@ func (v *Validator) generateErrorMessage() {
 			"oneof":            "field %s must have value one of allowed list: %s",
 			"gte":              "field %s must be greater or equal than %s",
 			"gt":               "field %s must be greater than %s",
+			"lte":              "field %s must be less or equal than %s",
 			"url":              "field %s must be an url",
 			"min":              "field %s must have at least %s characters",
 			CustomDateTimeRule: "field %s must have datetime format: " + constants.TimeLayout,
@@ -62,6 +62,15 @@ func CheckValidationErrors(err error) (e []TaggedError) {
 		e = append(e, TaggedError{Tag: InvalidTag, Err: err})
 	}
 
+	// joined errors, e.g. every broken rule of a password policy, are reported one by one
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, item := range joined.Unwrap() {
+			e = append(e, CheckValidationErrors(item)...)
+		}
+
+		return
+	}
+
 	errs, ok := err.(validator.ValidationErrors)
 
 	if !ok {
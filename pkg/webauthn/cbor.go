@@ -0,0 +1,160 @@
+package webauthn
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// cborMaxDepth bounds nesting, authenticator data never nests deeper than a few levels.
+const cborMaxDepth = 16
+
+var errCBOR = errors.New("malformed cbor")
+
+// decodeCBOR decodes the first item of data and returns it with the count of bytes it took. Only the
+// definite length subset authenticators emit is supported: integers come back as int64, byte strings as
+// []byte, text as string, arrays as []interface{} and maps as map[interface{}]interface{}.
+func decodeCBOR(data []byte) (interface{}, int, error) {
+	return decodeCBORItem(data, 0)
+}
+
+func decodeCBORItem(data []byte, depth int) (interface{}, int, error) {
+	if depth > cborMaxDepth {
+		return nil, 0, errCBOR
+	}
+
+	major, arg, n, err := cborHead(data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch major {
+	case 0:
+		if arg > math.MaxInt64 {
+			return nil, 0, errCBOR
+		}
+
+		return int64(arg), n, nil
+	case 1:
+		if arg > math.MaxInt64 {
+			return nil, 0, errCBOR
+		}
+
+		return -1 - int64(arg), n, nil
+	case 2, 3:
+		if arg > uint64(len(data)-n) {
+			return nil, 0, errCBOR
+		}
+
+		end := n + int(arg)
+		if major == 3 {
+			return string(data[n:end]), end, nil
+		}
+
+		return append([]byte(nil), data[n:end]...), end, nil
+	case 4:
+		if arg > uint64(len(data)-n) {
+			return nil, 0, errCBOR
+		}
+
+		items := make([]interface{}, 0, arg)
+		for i := uint64(0); i < arg; i++ {
+			item, size, err := decodeCBORItem(data[n:], depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			items, n = append(items, item), n+size
+		}
+
+		return items, n, nil
+	case 5:
+		if arg > uint64(len(data)-n)/2 {
+			return nil, 0, errCBOR
+		}
+
+		items := make(map[interface{}]interface{}, arg)
+		for i := uint64(0); i < arg; i++ {
+			key, size, err := decodeCBORItem(data[n:], depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			n += size
+
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, 0, errCBOR
+			}
+
+			value, size, err := decodeCBORItem(data[n:], depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			items[key], n = value, n+size
+		}
+
+		return items, n, nil
+	case 6:
+		item, size, err := decodeCBORItem(data[n:], depth+1)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return item, n + size, nil
+	default:
+		switch {
+		case data[0] == 0xf4:
+			return false, n, nil
+		case data[0] == 0xf5:
+			return true, n, nil
+		case data[0] == 0xf6 || data[0] == 0xf7:
+			return nil, n, nil
+		case data[0] == 0xfa:
+			return float64(math.Float32frombits(uint32(arg))), n, nil
+		case data[0] == 0xfb:
+			return math.Float64frombits(arg), n, nil
+		}
+
+		return nil, 0, errCBOR
+	}
+}
+
+// cborHead reads the major type and argument of the item head and returns the head size.
+func cborHead(data []byte) (byte, uint64, int, error) {
+	if len(data) == 0 {
+		return 0, 0, 0, errCBOR
+	}
+
+	major, info := data[0]>>5, data[0]&0x1f
+
+	switch {
+	case info < 24:
+		return major, uint64(info), 1, nil
+	case info <= 27:
+		size := 1 << (info - 24)
+		if len(data) < 1+size {
+			return 0, 0, 0, errCBOR
+		}
+
+		var arg uint64
+
+		switch size {
+		case 1:
+			arg = uint64(data[1])
+		case 2:
+			arg = uint64(binary.BigEndian.Uint16(data[1:]))
+		case 4:
+			arg = uint64(binary.BigEndian.Uint32(data[1:]))
+		default:
+			arg = binary.BigEndian.Uint64(data[1:])
+		}
+
+		return major, arg, 1 + size, nil
+	default:
+		// indefinite lengths and reserved values
+		return 0, 0, 0, errCBOR
+	}
+}
@@ -0,0 +1,23 @@
+package webauthn
+
+import "time"
+
+const (
+	UserVerificationRequired    = "required"
+	UserVerificationPreferred   = "preferred"
+	UserVerificationDiscouraged = "discouraged"
+)
+
+type Config struct {
+	// RPID is the domain the credentials are scoped to, e.g. backoffice.example.com.
+	RPID   string
+	RPName string
+	// Origins the browser may report in client data, e.g. https://backoffice.example.com.
+	Origins          []string
+	Timeout          time.Duration
+	UserVerification string
+}
+
+func (c *Config) Enabled() bool {
+	return c != nil && c.RPID != "" && len(c.Origins) > 0
+}
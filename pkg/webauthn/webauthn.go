@@ -0,0 +1,305 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const (
+	challengeLength = 32
+	defaultTimeout  = 5 * time.Minute
+
+	flagUserPresent  = 0x01
+	flagUserVerified = 0x04
+	flagAttestedData = 0x40
+
+	// authDataLength is the size of rp id hash, flags and sign count.
+	authDataLength = 37
+)
+
+var (
+	ErrInvalidResponse = errors.New("webauthn response is invalid")
+	ErrUnsupportedKey  = errors.New("webauthn public key is not supported")
+	ErrSessionExpired  = fmt.Errorf("%w: ceremony expired", ErrInvalidResponse)
+	// ErrSignCount means the authenticator counter went backwards, the credential may have been cloned.
+	ErrSignCount = fmt.Errorf("%w: signature counter did not increase", ErrInvalidResponse)
+)
+
+type WebAuthn struct {
+	cfg *Config
+}
+
+func New(cfg *Config) *WebAuthn {
+	return &WebAuthn{cfg: cfg}
+}
+
+// BeginRegistration returns the options for navigator.credentials.create, registered credentials of the
+// user are excluded so an authenticator is not registered twice.
+func (w *WebAuthn) BeginRegistration(user User, exclude []Descriptor) (*CreationOptions, *SessionData, error) {
+	challenge, err := newChallenge()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	options := &CreationOptions{
+		RP:        RelyingParty{ID: w.cfg.RPID, Name: w.rpName()},
+		User:      user,
+		Challenge: challenge,
+		PubKeyCredParams: []Parameter{
+			{Type: publicKeyType, Alg: AlgES256},
+			{Type: publicKeyType, Alg: AlgEdDSA},
+			{Type: publicKeyType, Alg: AlgRS256},
+		},
+		Timeout:            w.timeout().Milliseconds(),
+		ExcludeCredentials: exclude,
+		AuthenticatorSelection: AuthenticatorSelection{
+			ResidentKey:      "discouraged",
+			UserVerification: w.userVerification(),
+		},
+		Attestation: attestationNone,
+	}
+
+	return options, w.session(challenge, user.ID, nil), nil
+}
+
+// FinishRegistration verifies the response of navigator.credentials.create. Attestation is not requested,
+// so the attestation statement is not verified and the credential is trusted as the ceremony was.
+func (w *WebAuthn) FinishRegistration(session *SessionData, resp *AttestationResponse) (*Credential, error) {
+	if err := w.verifyClientData(session, resp.Response.ClientDataJSON, clientDataCreate); err != nil {
+		return nil, err
+	}
+
+	item, _, err := decodeCBOR(resp.Response.AttestationObject)
+	if err != nil {
+		return nil, fmt.Errorf("%w: attestation object: %v", ErrInvalidResponse, err)
+	}
+
+	object, ok := item.(map[interface{}]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: attestation object is not a map", ErrInvalidResponse)
+	}
+
+	raw, _ := object["authData"].([]byte)
+
+	data, err := w.parseAuthData(session, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.flags&flagAttestedData == 0 {
+		return nil, fmt.Errorf("%w: attested credential data is missing", ErrInvalidResponse)
+	}
+
+	if _, err = parsePublicKey(data.publicKey); err != nil {
+		return nil, err
+	}
+
+	if len(resp.RawID) > 0 && !bytes.Equal(resp.RawID, data.credentialID) {
+		return nil, fmt.Errorf("%w: credential id mismatch", ErrInvalidResponse)
+	}
+
+	return &Credential{
+		ID:         data.credentialID,
+		PublicKey:  data.publicKey,
+		SignCount:  data.signCount,
+		AAGUID:     data.aaguid,
+		Transports: resp.Response.Transports,
+	}, nil
+}
+
+// BeginLogin returns the options for navigator.credentials.get limited to the credentials of the user.
+func (w *WebAuthn) BeginLogin(allowed []Descriptor) (*RequestOptions, *SessionData, error) {
+	challenge, err := newChallenge()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	options := &RequestOptions{
+		Challenge:        challenge,
+		Timeout:          w.timeout().Milliseconds(),
+		RPID:             w.cfg.RPID,
+		AllowCredentials: allowed,
+		UserVerification: w.userVerification(),
+	}
+
+	ids := lo.Map(allowed, func(item Descriptor, index int) Bytes {
+		return item.ID
+	})
+
+	return options, w.session(challenge, nil, ids), nil
+}
+
+// FinishLogin verifies the response of navigator.credentials.get against the credential it names and
+// returns the new signature counter to store.
+func (w *WebAuthn) FinishLogin(session *SessionData, credential *Credential, resp *AssertionResponse) (uint32, error) {
+	allowed := lo.ContainsBy(session.AllowedCredentials, func(id Bytes) bool {
+		return bytes.Equal(id, credential.ID)
+	})
+	if !allowed || !bytes.Equal(resp.RawID, credential.ID) {
+		return 0, fmt.Errorf("%w: credential is not allowed", ErrInvalidResponse)
+	}
+
+	if err := w.verifyClientData(session, resp.Response.ClientDataJSON, clientDataGet); err != nil {
+		return 0, err
+	}
+
+	data, err := w.parseAuthData(session, resp.Response.AuthenticatorData)
+	if err != nil {
+		return 0, err
+	}
+
+	key, err := parsePublicKey(credential.PublicKey)
+	if err != nil {
+		return 0, err
+	}
+
+	clientDataHash := sha256.Sum256(resp.Response.ClientDataJSON)
+	signed := append(append([]byte(nil), resp.Response.AuthenticatorData...), clientDataHash[:]...)
+
+	if !key.verify(signed, resp.Response.Signature) {
+		return 0, fmt.Errorf("%w: signature mismatch", ErrInvalidResponse)
+	}
+
+	// authenticators without a counter always report zero
+	if (data.signCount != 0 || credential.SignCount != 0) && data.signCount <= credential.SignCount {
+		return 0, ErrSignCount
+	}
+
+	return data.signCount, nil
+}
+
+func (w *WebAuthn) verifyClientData(session *SessionData, raw []byte, ceremony string) error {
+	if time.Now().After(session.Expires) {
+		return ErrSessionExpired
+	}
+
+	data := &clientData{}
+	if err := json.Unmarshal(raw, data); err != nil {
+		return fmt.Errorf("%w: client data: %v", ErrInvalidResponse, err)
+	}
+
+	if data.Type != ceremony {
+		return fmt.Errorf("%w: unexpected ceremony %q", ErrInvalidResponse, data.Type)
+	}
+
+	challenge, err := base64.RawURLEncoding.DecodeString(data.Challenge)
+	if err != nil || subtle.ConstantTimeCompare(challenge, session.Challenge) != 1 {
+		return fmt.Errorf("%w: challenge mismatch", ErrInvalidResponse)
+	}
+
+	if !lo.Contains(w.cfg.Origins, data.Origin) {
+		return fmt.Errorf("%w: origin %q is not allowed", ErrInvalidResponse, data.Origin)
+	}
+
+	return nil
+}
+
+type authData struct {
+	flags        byte
+	signCount    uint32
+	aaguid       []byte
+	credentialID []byte
+	publicKey    []byte
+}
+
+func (w *WebAuthn) parseAuthData(session *SessionData, raw []byte) (*authData, error) {
+	if len(raw) < authDataLength {
+		return nil, fmt.Errorf("%w: authenticator data is too short", ErrInvalidResponse)
+	}
+
+	rpIDHash := sha256.Sum256([]byte(w.cfg.RPID))
+	if subtle.ConstantTimeCompare(raw[:32], rpIDHash[:]) != 1 {
+		return nil, fmt.Errorf("%w: relying party id mismatch", ErrInvalidResponse)
+	}
+
+	data := &authData{flags: raw[32], signCount: binary.BigEndian.Uint32(raw[33:37])}
+
+	if data.flags&flagUserPresent == 0 {
+		return nil, fmt.Errorf("%w: user is not present", ErrInvalidResponse)
+	}
+
+	if session.UserVerification == UserVerificationRequired && data.flags&flagUserVerified == 0 {
+		return nil, fmt.Errorf("%w: user is not verified", ErrInvalidResponse)
+	}
+
+	if data.flags&flagAttestedData == 0 {
+		return data, nil
+	}
+
+	rest := raw[authDataLength:]
+	if len(rest) < 18 {
+		return nil, fmt.Errorf("%w: attested credential data is too short", ErrInvalidResponse)
+	}
+
+	data.aaguid = rest[:16]
+	idLength := int(binary.BigEndian.Uint16(rest[16:18]))
+	rest = rest[18:]
+
+	if idLength == 0 || len(rest) < idLength {
+		return nil, fmt.Errorf("%w: credential id is truncated", ErrInvalidResponse)
+	}
+
+	data.credentialID, rest = rest[:idLength], rest[idLength:]
+
+	_, n, err := decodeCBOR(rest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: credential public key: %v", ErrInvalidResponse, err)
+	}
+
+	data.publicKey = rest[:n]
+
+	return data, nil
+}
+
+func (w *WebAuthn) session(challenge []byte, userID []byte, allowed []Bytes) *SessionData {
+	return &SessionData{
+		Challenge:          challenge,
+		UserID:             userID,
+		AllowedCredentials: allowed,
+		UserVerification:   w.userVerification(),
+		Expires:            time.Now().Add(w.timeout()),
+	}
+}
+
+func (w *WebAuthn) rpName() string {
+	if w.cfg.RPName == "" {
+		return w.cfg.RPID
+	}
+
+	return w.cfg.RPName
+}
+
+func (w *WebAuthn) timeout() time.Duration {
+	if w.cfg.Timeout <= 0 {
+		return defaultTimeout
+	}
+
+	return w.cfg.Timeout
+}
+
+func (w *WebAuthn) userVerification() string {
+	if w.cfg.UserVerification == "" {
+		return UserVerificationPreferred
+	}
+
+	return w.cfg.UserVerification
+}
+
+func newChallenge() (Bytes, error) {
+	challenge := make([]byte, challengeLength)
+	if _, err := rand.Read(challenge); err != nil {
+		return nil, err
+	}
+
+	return challenge, nil
+}
@@ -0,0 +1,442 @@
+package webauthn
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+)
+
+const (
+	rpID   = "backoffice.test"
+	origin = "https://backoffice.test"
+)
+
+// authenticator is a software authenticator holding a single credential, it signs whatever the test
+// hands it and lets the test tamper with the produced responses.
+type authenticator struct {
+	id        []byte
+	ec        *ecdsa.PrivateKey
+	ed        ed25519.PrivateKey
+	signCount uint32
+	flags     byte
+}
+
+func newAuthenticator(t *testing.T, alg int) *authenticator {
+	a := &authenticator{id: make([]byte, 16), flags: flagUserPresent | flagUserVerified}
+	if _, err := rand.Read(a.id); err != nil {
+		t.Fatal(err)
+	}
+
+	var err error
+
+	switch alg {
+	case AlgES256:
+		a.ec, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case AlgEdDSA:
+		_, a.ed, err = ed25519.GenerateKey(rand.Reader)
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return a
+}
+
+func (a *authenticator) coseKey() []byte {
+	if a.ed != nil {
+		return encodeCBOR(map[interface{}]interface{}{
+			int64(coseKeyType): int64(coseKeyTypeOKP),
+			int64(coseAlg):     int64(AlgEdDSA),
+			int64(coseCurve):   int64(coseCurveEd25519),
+			int64(coseX):       []byte(a.ed.Public().(ed25519.PublicKey)),
+		})
+	}
+
+	return encodeCBOR(map[interface{}]interface{}{
+		int64(coseKeyType): int64(coseKeyTypeEC2),
+		int64(coseAlg):     int64(AlgES256),
+		int64(coseCurve):   int64(coseCurveP256),
+		int64(coseX):       a.ec.X.FillBytes(make([]byte, 32)),
+		int64(coseY):       a.ec.Y.FillBytes(make([]byte, 32)),
+	})
+}
+
+func (a *authenticator) authData(rp string, attested bool) []byte {
+	hash := sha256.Sum256([]byte(rp))
+	data := append(hash[:], a.flags, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(data[33:], a.signCount)
+
+	if attested {
+		data[32] |= flagAttestedData
+		data = append(data, make([]byte, 16)...)
+		data = binary.BigEndian.AppendUint16(data, uint16(len(a.id)))
+		data = append(append(data, a.id...), a.coseKey()...)
+	}
+
+	return data
+}
+
+func (a *authenticator) create(options *CreationOptions, ceremony, from string) *AttestationResponse {
+	resp := &AttestationResponse{ID: base64.RawURLEncoding.EncodeToString(a.id), RawID: a.id, Type: publicKeyType}
+	resp.Response.ClientDataJSON = clientDataJSON(ceremony, options.Challenge, from)
+	resp.Response.AttestationObject = encodeCBOR(map[interface{}]interface{}{
+		"fmt":      attestationNone,
+		"attStmt":  map[interface{}]interface{}{},
+		"authData": a.authData(options.RP.ID, true),
+	})
+
+	return resp
+}
+
+func (a *authenticator) get(options *RequestOptions, from string) *AssertionResponse {
+	a.signCount++
+
+	resp := &AssertionResponse{ID: base64.RawURLEncoding.EncodeToString(a.id), RawID: a.id, Type: publicKeyType}
+	resp.Response.ClientDataJSON = clientDataJSON(clientDataGet, options.Challenge, from)
+	resp.Response.AuthenticatorData = a.authData(options.RPID, false)
+
+	hash := sha256.Sum256(resp.Response.ClientDataJSON)
+	signed := append(append([]byte(nil), resp.Response.AuthenticatorData...), hash[:]...)
+
+	if a.ed != nil {
+		resp.Response.Signature = ed25519.Sign(a.ed, signed)
+	} else {
+		digest := sha256.Sum256(signed)
+		resp.Response.Signature, _ = ecdsa.SignASN1(rand.Reader, a.ec, digest[:])
+	}
+
+	return resp
+}
+
+func clientDataJSON(ceremony string, challenge []byte, from string) []byte {
+	data, _ := json.Marshal(&clientData{
+		Type:      ceremony,
+		Challenge: base64.RawURLEncoding.EncodeToString(challenge),
+		Origin:    from,
+	})
+
+	return data
+}
+
+// encodeCBOR covers the types the authenticator needs, map keys are sorted for a deterministic output.
+func encodeCBOR(item interface{}) []byte {
+	head := func(major byte, n uint64) []byte {
+		switch {
+		case n < 24:
+			return []byte{major<<5 | byte(n)}
+		case n <= 0xff:
+			return []byte{major<<5 | 24, byte(n)}
+		case n <= 0xffff:
+			return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(n))
+		default:
+			return binary.BigEndian.AppendUint32([]byte{major<<5 | 26}, uint32(n))
+		}
+	}
+
+	switch v := item.(type) {
+	case int64:
+		if v < 0 {
+			return head(1, uint64(-1-v))
+		}
+
+		return head(0, uint64(v))
+	case []byte:
+		return append(head(2, uint64(len(v))), v...)
+	case string:
+		return append(head(3, uint64(len(v))), v...)
+	case map[interface{}]interface{}:
+		keys := make([][]byte, 0, len(v))
+		values := map[string][]byte{}
+
+		for key, value := range v {
+			encoded := encodeCBOR(key)
+			keys, values[string(encoded)] = append(keys, encoded), encodeCBOR(value)
+		}
+
+		sort.Slice(keys, func(i, j int) bool {
+			return string(keys[i]) < string(keys[j])
+		})
+
+		out := head(5, uint64(len(v)))
+		for _, key := range keys {
+			out = append(append(out, key...), values[string(key)]...)
+		}
+
+		return out
+	}
+
+	panic("unsupported cbor type")
+}
+
+func newWebAuthn() *WebAuthn {
+	return New(&Config{RPID: rpID, RPName: "Backoffice", Origins: []string{origin}, UserVerification: UserVerificationRequired})
+}
+
+func register(t *testing.T, w *WebAuthn, a *authenticator) *Credential {
+	options, session, err := w.BeginRegistration(User{ID: []byte("user-1"), Name: "user@example.com"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	credential, err := w.FinishRegistration(session, a.create(options, clientDataCreate, origin))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return credential
+}
+
+func TestRegistration(t *testing.T) {
+	tests := []struct {
+		name    string
+		tamper  func(a *authenticator, options *CreationOptions, session *SessionData) *AttestationResponse
+		wantErr error
+	}{
+		{
+			name: "success",
+		},
+		{
+			name: "eddsa key",
+			tamper: func(a *authenticator, options *CreationOptions, session *SessionData) *AttestationResponse {
+				a.ec, a.ed = nil, ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+
+				return a.create(options, clientDataCreate, origin)
+			},
+		},
+		{
+			name: "foreign challenge",
+			tamper: func(a *authenticator, options *CreationOptions, session *SessionData) *AttestationResponse {
+				options.Challenge = []byte("another challenge")
+
+				return a.create(options, clientDataCreate, origin)
+			},
+			wantErr: ErrInvalidResponse,
+		},
+		{
+			name: "foreign origin",
+			tamper: func(a *authenticator, options *CreationOptions, session *SessionData) *AttestationResponse {
+				return a.create(options, clientDataCreate, "https://evil.test")
+			},
+			wantErr: ErrInvalidResponse,
+		},
+		{
+			name: "foreign relying party",
+			tamper: func(a *authenticator, options *CreationOptions, session *SessionData) *AttestationResponse {
+				options.RP.ID = "evil.test"
+
+				return a.create(options, clientDataCreate, origin)
+			},
+			wantErr: ErrInvalidResponse,
+		},
+		{
+			name: "assertion instead of attestation",
+			tamper: func(a *authenticator, options *CreationOptions, session *SessionData) *AttestationResponse {
+				return a.create(options, clientDataGet, origin)
+			},
+			wantErr: ErrInvalidResponse,
+		},
+		{
+			name: "user not verified",
+			tamper: func(a *authenticator, options *CreationOptions, session *SessionData) *AttestationResponse {
+				a.flags = flagUserPresent
+
+				return a.create(options, clientDataCreate, origin)
+			},
+			wantErr: ErrInvalidResponse,
+		},
+		{
+			name: "expired",
+			tamper: func(a *authenticator, options *CreationOptions, session *SessionData) *AttestationResponse {
+				session.Expires = time.Now().Add(-time.Second)
+
+				return a.create(options, clientDataCreate, origin)
+			},
+			wantErr: ErrSessionExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := newWebAuthn()
+			a := newAuthenticator(t, AlgES256)
+
+			options, session, err := w.BeginRegistration(User{ID: []byte("user-1"), Name: "user@example.com"}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp := a.create(options, clientDataCreate, origin)
+			if tt.tamper != nil {
+				resp = tt.tamper(a, options, session)
+			}
+
+			credential, err := w.FinishRegistration(session, resp)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(credential.ID) != string(a.id) || len(credential.PublicKey) == 0 {
+				t.Fatalf("unexpected credential %+v", credential)
+			}
+		})
+	}
+}
+
+func TestLogin(t *testing.T) {
+	tests := []struct {
+		name    string
+		alg     int
+		tamper  func(a *authenticator, options *RequestOptions, credential *Credential) *AssertionResponse
+		wantErr error
+	}{
+		{
+			name: "success",
+			alg:  AlgES256,
+		},
+		{
+			name: "eddsa success",
+			alg:  AlgEdDSA,
+		},
+		{
+			name: "signature of another key",
+			alg:  AlgES256,
+			tamper: func(a *authenticator, options *RequestOptions, credential *Credential) *AssertionResponse {
+				a.ec, _ = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+				return a.get(options, origin)
+			},
+			wantErr: ErrInvalidResponse,
+		},
+		{
+			name: "tampered authenticator data",
+			alg:  AlgES256,
+			tamper: func(a *authenticator, options *RequestOptions, credential *Credential) *AssertionResponse {
+				resp := a.get(options, origin)
+				resp.Response.AuthenticatorData[36]++
+
+				return resp
+			},
+			wantErr: ErrInvalidResponse,
+		},
+		{
+			name: "foreign challenge",
+			alg:  AlgES256,
+			tamper: func(a *authenticator, options *RequestOptions, credential *Credential) *AssertionResponse {
+				options.Challenge = []byte("another challenge")
+
+				return a.get(options, origin)
+			},
+			wantErr: ErrInvalidResponse,
+		},
+		{
+			name: "foreign origin",
+			alg:  AlgES256,
+			tamper: func(a *authenticator, options *RequestOptions, credential *Credential) *AssertionResponse {
+				return a.get(options, "https://evil.test")
+			},
+			wantErr: ErrInvalidResponse,
+		},
+		{
+			name: "cloned authenticator",
+			alg:  AlgES256,
+			tamper: func(a *authenticator, options *RequestOptions, credential *Credential) *AssertionResponse {
+				credential.SignCount = a.signCount + 5
+
+				return a.get(options, origin)
+			},
+			wantErr: ErrSignCount,
+		},
+		{
+			name: "user not present",
+			alg:  AlgES256,
+			tamper: func(a *authenticator, options *RequestOptions, credential *Credential) *AssertionResponse {
+				a.flags = 0
+
+				return a.get(options, origin)
+			},
+			wantErr: ErrInvalidResponse,
+		},
+		{
+			name: "credential not allowed",
+			alg:  AlgES256,
+			tamper: func(a *authenticator, options *RequestOptions, credential *Credential) *AssertionResponse {
+				other := newAuthenticator(t, AlgES256)
+				credential.ID, credential.PublicKey = other.id, other.coseKey()
+				other.signCount = a.signCount
+
+				return other.get(options, origin)
+			},
+			wantErr: ErrInvalidResponse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := newWebAuthn()
+			a := newAuthenticator(t, tt.alg)
+			credential := register(t, w, a)
+
+			options, session, err := w.BeginLogin([]Descriptor{{Type: publicKeyType, ID: credential.ID}})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var resp *AssertionResponse
+			if tt.tamper != nil {
+				resp = tt.tamper(a, options, credential)
+			} else {
+				resp = a.get(options, origin)
+			}
+
+			signCount, err := w.FinishLogin(session, credential, resp)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if signCount != a.signCount {
+				t.Fatalf("expected sign count %d, got %d", a.signCount, signCount)
+			}
+		})
+	}
+}
+
+func TestDecodeCBORRejectsMalformed(t *testing.T) {
+	for name, data := range map[string][]byte{
+		"empty":              {},
+		"truncated bytes":    {0x45, 0x01},
+		"indefinite length":  {0x5f, 0x41, 0x01, 0xff},
+		"huge array":         {0x9b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		"array map key":      {0xa1, 0x80, 0x01},
+		"truncated argument": {0x19, 0x01},
+	} {
+		if _, _, err := decodeCBOR(data); err == nil {
+			t.Fatalf("%s: expected an error", name)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/samber/lo"
 	"github.com/xuri/excelize/v2"
+	"io"
 )
 
 const xlsxSheetName = "Sheet1"
@@ -84,6 +85,20 @@ func ImportDataXLSX(filePath string) ([][]string, error) {
 	}
 	defer file.Close()
 
+	return getRows(file)
+}
+
+func ReadDataXLSX(r io.Reader) ([][]string, error) {
+	file, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %s", err.Error())
+	}
+	defer file.Close()
+
+	return getRows(file)
+}
+
+func getRows(file *excelize.File) ([][]string, error) {
 	rows, err := file.GetRows(xlsxSheetName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get rows: %s", err.Error())
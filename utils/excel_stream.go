@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/samber/lo"
+	"github.com/xuri/excelize/v2"
+)
+
+// XLSXStream writes sheets row by row, excelize spills streamed rows to temporary files instead of memory.
+// A sheet that reaches the excel row limit is continued on a new sheet with the same header.
+type XLSXStream struct {
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	style  int
+
+	name   string
+	part   int
+	row    int
+	header []string
+
+	defaultUsed bool
+}
+
+func NewXLSXStream() (*XLSXStream, error) {
+	file := excelize.NewFile()
+
+	style, err := file.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}, Alignment: &excelize.Alignment{Horizontal: "center"}})
+	if err != nil {
+		return nil, err
+	}
+
+	return &XLSXStream{file: file, style: style}, nil
+}
+
+// NewSheet flushes the current sheet and starts a new one.
+func (x *XLSXStream) NewSheet(name string) error {
+	x.name, x.part, x.header = truncateSheetName(name), 1, nil
+	x.defaultUsed = x.defaultUsed || x.name == xlsxSheetName
+
+	return x.openSheet(x.name)
+}
+
+// WriteHeader writes a bold row that is repeated when the sheet is continued.
+func (x *XLSXStream) WriteHeader(header []string) error {
+	x.header = header
+
+	return x.writeRow(header, x.style)
+}
+
+func (x *XLSXStream) WriteRow(row []string) error {
+	if x.row >= excelize.TotalRows {
+		x.part++
+
+		if err := x.openSheet(truncateSheetName(fmt.Sprintf("%s %d", x.name, x.part))); err != nil {
+			return err
+		}
+
+		if x.header != nil {
+			if err := x.writeRow(x.header, x.style); err != nil {
+				return err
+			}
+		}
+	}
+
+	return x.writeRow(row, 0)
+}
+
+// WriteTable writes the first table row as a header and the rest as plain rows.
+func (x *XLSXStream) WriteTable(table [][]string) error {
+	if len(table) == 0 {
+		return nil
+	}
+
+	if err := x.WriteHeader(table[0]); err != nil {
+		return err
+	}
+
+	for _, row := range table[1:] {
+		if err := x.WriteRow(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteTo flushes the last sheet, writes the workbook into w and releases temporary files.
+func (x *XLSXStream) WriteTo(w io.Writer) (int64, error) {
+	defer x.Close()
+
+	if err := x.flush(); err != nil {
+		return 0, err
+	}
+
+	if !x.defaultUsed && len(x.file.GetSheetList()) > 1 {
+		if err := x.file.DeleteSheet(xlsxSheetName); err != nil {
+			return 0, err
+		}
+	}
+
+	x.file.SetActiveSheet(0)
+
+	return x.file.WriteTo(w)
+}
+
+func (x *XLSXStream) Close() error {
+	return x.file.Close()
+}
+
+func (x *XLSXStream) openSheet(name string) error {
+	if err := x.flush(); err != nil {
+		return err
+	}
+
+	if _, err := x.file.NewSheet(name); err != nil {
+		return err
+	}
+
+	stream, err := x.file.NewStreamWriter(name)
+	if err != nil {
+		return err
+	}
+
+	x.stream, x.row = stream, 0
+
+	return nil
+}
+
+func (x *XLSXStream) writeRow(row []string, style int) error {
+	if x.stream == nil {
+		if err := x.NewSheet(xlsxSheetName); err != nil {
+			return err
+		}
+	}
+
+	x.row++
+
+	cell, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		return err
+	}
+
+	values := lo.Map(row, func(item string, index int) interface{} {
+		return item
+	})
+
+	return x.stream.SetRow(cell, values, excelize.RowOpts{StyleID: style})
+}
+
+func (x *XLSXStream) flush() error {
+	if x.stream == nil {
+		return nil
+	}
+
+	err := x.stream.Flush()
+	x.stream = nil
+
+	return err
+}